@@ -14,15 +14,22 @@ import (
 	"github.com/render-oss/render-mcp-server/pkg/cfg"
 	"github.com/render-oss/render-mcp-server/pkg/client"
 	"github.com/render-oss/render-mcp-server/pkg/config"
+	"github.com/render-oss/render-mcp-server/pkg/customdomain"
 	"github.com/render-oss/render-mcp-server/pkg/deploy"
+	"github.com/render-oss/render-mcp-server/pkg/disk"
+	"github.com/render-oss/render-mcp-server/pkg/envgroup"
+	"github.com/render-oss/render-mcp-server/pkg/environment"
+	"github.com/render-oss/render-mcp-server/pkg/job"
 	"github.com/render-oss/render-mcp-server/pkg/keyvalue"
 	"github.com/render-oss/render-mcp-server/pkg/logs"
 	"github.com/render-oss/render-mcp-server/pkg/metrics"
 	"github.com/render-oss/render-mcp-server/pkg/multicontext"
 	"github.com/render-oss/render-mcp-server/pkg/owner"
 	"github.com/render-oss/render-mcp-server/pkg/postgres"
+	"github.com/render-oss/render-mcp-server/pkg/registrycredential"
 	"github.com/render-oss/render-mcp-server/pkg/service"
 	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/staticsite"
 )
 
 func Serve(transport string) *server.MCPServer {
@@ -30,6 +37,7 @@ func Serve(transport string) *server.MCPServer {
 	s := server.NewMCPServer(
 		"render-mcp-server",
 		cfg.Version,
+		server.WithLogging(),
 	)
 
 	c, err := client.NewDefaultClient()
@@ -44,7 +52,16 @@ func Serve(transport string) *server.MCPServer {
 		owner.AddTools(s, c)
 		service.AddTools(s, c)
 		deploy.AddTools(s, c)
-		postgres.AddTools(s, c)
+		job.AddTools(s, c)
+		customdomain.AddTools(s, c)
+		staticsite.AddTools(s, c)
+		disk.AddTools(s, c)
+		registrycredential.AddTools(s, c)
+		envgroup.AddTools(s, c)
+		environment.AddTools(s, c)
+		pools := postgres.NewPoolCache()
+		defer pools.Close()
+		postgres.AddTools(s, c, pools)
 		keyvalue.AddTools(s, c)
 		logs.AddTools(s, c)
 		metrics.AddTools(s, c)
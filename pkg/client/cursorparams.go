@@ -83,3 +83,24 @@ func (p *ListSecretFilesForServiceParams) SetCursor(c *Cursor) {
 func (p *ListSecretFilesForServiceParams) SetLimit(l int) {
 	p.Limit = &l
 }
+
+func (p *ListDisksParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListDisksParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListEnvGroupsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListEnvGroupsParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListRegistryCredentialsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListRegistryCredentialsParams) SetLimit(l int) {
+	p.Limit = &l
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+// SubscribeLogsStream opens a WebSocket connection that streams logs matching params as they are
+// emitted. The generated SubscribeLogs method can't be used for this because it sends a plain GET
+// request, and the endpoint only streams logs once the connection is upgraded to a WebSocket.
+func (c *ClientWithResponses) SubscribeLogsStream(ctx context.Context, params *SubscribeLogsParams, reqEditors ...RequestEditorFn) (*websocket.Conn, error) {
+	apiClient, ok := c.ClientInterface.(*Client)
+	if !ok {
+		return nil, errors.New("log subscriptions are not supported by this client")
+	}
+
+	req, err := NewSubscribeLogsRequest(apiClient.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := apiClient.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+
+	switch req.URL.Scheme {
+	case "https":
+		req.URL.Scheme = "wss"
+	case "http":
+		req.URL.Scheme = "ws"
+	}
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, req.URL.String(), req.Header)
+	if err != nil {
+		if resp != nil {
+			return nil, errorFromHandshake(resp)
+		}
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func errorFromHandshake(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	return fmt.Errorf("received response code %d when subscribing to logs: %s", resp.StatusCode, string(body))
+}
@@ -0,0 +1,133 @@
+package customdomain
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+// renderApexIP is the address apex domains point their A record at. See
+// https://render.com/docs/configure-other-dns.
+const renderApexIP = "216.24.57.1"
+
+// Resolver looks up DNS records. *net.Resolver implements it, and tests swap in a stub.
+type Resolver interface {
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DNSRecord is a record a custom domain needs at its DNS provider. Propagated and Found are only
+// set when the record was looked up: Found lists what the lookup returned.
+type DNSRecord struct {
+	Type        string   `json:"type"`
+	Name        string   `json:"name"`
+	Value       string   `json:"value"`
+	Propagated  *bool    `json:"propagated,omitempty"`
+	Found       []string `json:"found,omitempty"`
+	LookupError string   `json:"lookupError,omitempty"`
+}
+
+// CustomDomainStatus is a custom domain along with the DNS records it needs and what's left to do
+// before it serves traffic.
+type CustomDomainStatus struct {
+	Id                 string      `json:"id"`
+	Name               string      `json:"name"`
+	DomainType         string      `json:"domainType"`
+	VerificationStatus string      `json:"verificationStatus"`
+	RedirectForName    string      `json:"redirectForName,omitempty"`
+	RequiredRecords    []DNSRecord `json:"requiredRecords"`
+	NextSteps          string      `json:"nextSteps"`
+}
+
+// serviceHostname returns the onrender.com hostname that subdomains point their CNAME record at.
+// Only web services and static sites have one.
+func serviceHostname(service *client.Service) (string, error) {
+	var serviceURL string
+	switch service.Type {
+	case client.WebService:
+		details, err := service.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return "", err
+		}
+		serviceURL = details.Url
+	case client.StaticSite:
+		details, err := service.ServiceDetails.AsStaticSiteDetails()
+		if err != nil {
+			return "", err
+		}
+		serviceURL = details.Url
+	default:
+		return "", fmt.Errorf("custom domains are only available for web services and static sites, not %s", service.Type)
+	}
+
+	parsed, err := url.Parse(serviceURL)
+	if err != nil || parsed.Hostname() == "" {
+		return "", fmt.Errorf("service %s has no onrender.com URL", service.Id)
+	}
+	return parsed.Hostname(), nil
+}
+
+// domainStatus describes what a custom domain needs. If resolver is set, the records are looked up
+// to see whether they have propagated.
+func domainStatus(ctx context.Context, domain client.CustomDomain, hostname string, resolver Resolver) CustomDomainStatus {
+	status := CustomDomainStatus{
+		Id:                 domain.Id,
+		Name:               domain.Name,
+		DomainType:         string(domain.DomainType),
+		VerificationStatus: string(domain.VerificationStatus),
+		RedirectForName:    domain.RedirectForName,
+	}
+
+	record := DNSRecord{Type: "CNAME", Name: domain.Name, Value: hostname}
+	if domain.DomainType == client.CustomDomainDomainTypeApex {
+		record = DNSRecord{Type: "A", Name: domain.Name, Value: renderApexIP}
+	}
+	if resolver != nil {
+		checkRecord(ctx, resolver, &record)
+	}
+	status.RequiredRecords = []DNSRecord{record}
+
+	switch {
+	case domain.VerificationStatus == client.CustomDomainVerificationStatusVerified:
+		status.NextSteps = "The domain is verified. Render issues a TLS certificate for it automatically. Keep the DNS record in place."
+	case record.Propagated != nil && *record.Propagated:
+		status.NextSteps = "The DNS record has propagated. Refresh the domain with refresh_custom_domain so that Render verifies it."
+	default:
+		status.NextSteps = fmt.Sprintf("Add the %s record for %s at the domain's DNS provider, and remove any AAAA records for it. "+
+			"Once the record has propagated, refresh the domain with refresh_custom_domain so that Render verifies it.", record.Type, domain.Name)
+	}
+
+	return status
+}
+
+// checkRecord looks up a record and sets whether its expected value has propagated.
+func checkRecord(ctx context.Context, resolver Resolver, record *DNSRecord) {
+	var found []string
+	var err error
+	switch record.Type {
+	case "CNAME":
+		var cname string
+		cname, err = resolver.LookupCNAME(ctx, record.Name)
+		if err == nil {
+			found = []string{strings.TrimSuffix(cname, ".")}
+		}
+	default:
+		found, err = resolver.LookupHost(ctx, record.Name)
+	}
+
+	propagated := false
+	record.Propagated = &propagated
+	if err != nil {
+		record.LookupError = err.Error()
+		return
+	}
+
+	record.Found = found
+	propagated = slices.ContainsFunc(found, func(value string) bool {
+		return strings.EqualFold(value, record.Value)
+	})
+}
@@ -0,0 +1,138 @@
+package customdomain
+
+import (
+	"context"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+//go:generate go tool counterfeiter -o ../fakes/fakecustomdomainrepoclient_gen.go . customDomainRepoClient
+type customDomainRepoClient interface {
+	ListCustomDomainsWithResponse(ctx context.Context, serviceId string, params *client.ListCustomDomainsParams, reqEditors ...client.RequestEditorFn) (*client.ListCustomDomainsResponse, error)
+	CreateCustomDomainWithResponse(ctx context.Context, serviceId string, body client.CreateCustomDomainJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateCustomDomainResponse, error)
+	RetrieveCustomDomainWithResponse(ctx context.Context, serviceId string, customDomainIdOrName string, reqEditors ...client.RequestEditorFn) (*client.RetrieveCustomDomainResponse, error)
+	RefreshCustomDomainWithResponse(ctx context.Context, serviceId string, customDomainIdOrName string, reqEditors ...client.RequestEditorFn) (*client.RefreshCustomDomainResponse, error)
+	DeleteCustomDomainWithResponse(ctx context.Context, serviceId string, customDomainIdOrName string, reqEditors ...client.RequestEditorFn) (*client.DeleteCustomDomainResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
+// Repo calls the custom domain API. Methods that take a service ID don't check that the service
+// belongs to the current workspace, so callers must look it up with GetService first.
+type Repo struct {
+	client customDomainRepoClient
+}
+
+func NewRepo(c customDomainRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+// GetService returns the service that custom domains are added to, or an error if it isn't in the
+// current workspace.
+func (r *Repo) GetService(ctx context.Context, serviceId string) (*client.Service, error) {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+type ListCustomDomainsParams struct {
+	*client.ListCustomDomainsParams
+	serviceId string
+}
+
+func (r *Repo) ListCustomDomains(ctx context.Context, serviceId string, params *client.ListCustomDomainsParams) ([]*client.CustomDomain, error) {
+	listParams := &ListCustomDomainsParams{
+		ListCustomDomainsParams: params,
+		serviceId:               serviceId,
+	}
+	return client.ListAll(ctx, listParams, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *ListCustomDomainsParams) ([]*client.CustomDomain, *client.Cursor, error) {
+	resp, err := r.client.ListCustomDomainsWithResponse(ctx, params.serviceId, params.ListCustomDomainsParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	domains := make([]*client.CustomDomain, 0, len(res))
+	for _, domainWithCursor := range res {
+		domains = append(domains, &domainWithCursor.CustomDomain)
+	}
+
+	return domains, &res[len(res)-1].Cursor, nil
+}
+
+// CreateCustomDomain adds a custom domain to a service. Render also adds the www subdomain of an
+// apex domain, or the apex domain of a www subdomain, as a redirect, so more than one domain can be
+// returned.
+func (r *Repo) CreateCustomDomain(ctx context.Context, serviceId string, name string) ([]client.CustomDomain, error) {
+	resp, err := r.client.CreateCustomDomainWithResponse(ctx, serviceId, client.CreateCustomDomainJSONRequestBody{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON201, nil
+}
+
+func (r *Repo) GetCustomDomain(ctx context.Context, serviceId string, idOrName string) (*client.CustomDomain, error) {
+	resp, err := r.client.RetrieveCustomDomainWithResponse(ctx, serviceId, idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// RefreshCustomDomain asks Render to check the DNS records of a custom domain again. The check runs
+// in the background, so the verification status may not have changed by the time this returns.
+func (r *Repo) RefreshCustomDomain(ctx context.Context, serviceId string, idOrName string) error {
+	resp, err := r.client.RefreshCustomDomainWithResponse(ctx, serviceId, idOrName)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) DeleteCustomDomain(ctx context.Context, serviceId string, idOrName string) error {
+	resp, err := r.client.DeleteCustomDomainWithResponse(ctx, serviceId, idOrName)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,338 @@
+package customdomain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	customDomainRepo := NewRepo(c)
+
+	tool, handler := listCustomDomains(customDomainRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getCustomDomain(customDomainRepo, net.DefaultResolver)
+	s.AddTool(*tool, handler)
+	tool, handler = createCustomDomain(customDomainRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = refreshCustomDomain(customDomainRepo, net.DefaultResolver)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteCustomDomain(customDomainRepo)
+	s.AddTool(*tool, handler)
+}
+
+func withCheckDNS() mcp.ToolOption {
+	return mcp.WithBoolean("checkDns",
+		mcp.Description("Whether to look up the domain's DNS records from the server to see whether they have propagated. Defaults to false."),
+		mcp.DefaultBool(false),
+	)
+}
+
+// resolverFor returns resolver if the request asks for a DNS check, and nil otherwise.
+func resolverFor(request mcp.CallToolRequest, resolver Resolver) (Resolver, error) {
+	checkDNS, _, err := validate.OptionalToolParam[bool](request, "checkDns")
+	if err != nil || !checkDNS {
+		return nil, err
+	}
+	return resolver, nil
+}
+
+func listCustomDomains(customDomainRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_custom_domains",
+		mcp.WithDescription("List the custom domains of a web service or static site, with their verification status and the DNS records they need."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List custom domains",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("verificationStatus",
+			mcp.Description("Only list domains with this verification status"),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(
+				client.ListCustomDomainsParamsVerificationStatusVerified,
+				client.ListCustomDomainsParamsVerificationStatusUnverified,
+			)...),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			params := &client.ListCustomDomainsParams{}
+			if status, ok, err := validate.OptionalToolParam[string](request, "verificationStatus"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				params.VerificationStatus = pointers.From(client.ListCustomDomainsParamsVerificationStatus(status))
+			}
+
+			service, err := customDomainRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hostname, err := serviceHostname(service)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			domains, err := customDomainRepo.ListCustomDomains(ctx, serviceId, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			statuses := make([]CustomDomainStatus, 0, len(domains))
+			for _, domain := range domains {
+				statuses = append(statuses, domainStatus(ctx, *domain, hostname, nil))
+			}
+
+			respJSON, err := json.Marshal(statuses)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func getCustomDomain(customDomainRepo *Repo, resolver Resolver) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_custom_domain",
+		mcp.WithDescription("Get a custom domain of a service, with its verification status, the DNS record it needs and what's left to do before it serves traffic."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get custom domain",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("customDomain",
+			mcp.Required(),
+			mcp.Description("The ID or name of the custom domain"),
+		),
+		withCheckDNS(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			customDomain, err := validate.RequiredToolParam[string](request, "customDomain")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			resolver, err := resolverFor(request, resolver)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := customDomainRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hostname, err := serviceHostname(service)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			domain, err := customDomainRepo.GetCustomDomain(ctx, serviceId, customDomain)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(domainStatus(ctx, *domain, hostname, resolver))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createCustomDomain(customDomainRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_custom_domain",
+		mcp.WithDescription("Add a custom domain to a web service or static site. "+
+			"Adding an apex domain such as example.com also adds www.example.com as a redirect, and the other way around. "+
+			"Returns each added domain with the DNS record the user needs to create at their DNS provider."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Create custom domain",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The domain name to add, for example www.example.com"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := customDomainRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hostname, err := serviceHostname(service)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			domains, err := customDomainRepo.CreateCustomDomain(ctx, serviceId, name)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			statuses := make([]CustomDomainStatus, 0, len(domains))
+			for _, domain := range domains {
+				statuses = append(statuses, domainStatus(ctx, domain, hostname, nil))
+			}
+
+			respJSON, err := json.Marshal(statuses)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func refreshCustomDomain(customDomainRepo *Repo, resolver Resolver) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("refresh_custom_domain",
+		mcp.WithDescription("Ask Render to verify the DNS records of a custom domain again, after the user has created or changed them. "+
+			"Verification runs in the background, so the returned status may not reflect it yet; check again with get_custom_domain in a minute."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Refresh custom domain",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("customDomain",
+			mcp.Required(),
+			mcp.Description("The ID or name of the custom domain"),
+		),
+		withCheckDNS(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			customDomain, err := validate.RequiredToolParam[string](request, "customDomain")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			resolver, err := resolverFor(request, resolver)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := customDomainRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hostname, err := serviceHostname(service)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := customDomainRepo.RefreshCustomDomain(ctx, serviceId, customDomain); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			domain, err := customDomainRepo.GetCustomDomain(ctx, serviceId, customDomain)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(domainStatus(ctx, *domain, hostname, resolver))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteCustomDomain(customDomainRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_custom_domain",
+		mcp.WithDescription("Remove a custom domain from a service. The service stops serving traffic for the domain. "+
+			"Always ask the user to confirm before removing a custom domain."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete custom domain",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("customDomain",
+			mcp.Required(),
+			mcp.Description("The ID or name of the custom domain to remove"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			customDomain, err := validate.RequiredToolParam[string](request, "customDomain")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := customDomainRepo.GetService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := customDomainRepo.DeleteCustomDomain(ctx, serviceId, customDomain); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Custom domain %s has been removed from service %s", customDomain, serviceId)), nil
+		}
+}
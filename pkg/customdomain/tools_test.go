@@ -0,0 +1,225 @@
+package customdomain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubResolver struct {
+	cnames map[string]string
+	hosts  map[string][]string
+}
+
+func (r *stubResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	if cname, ok := r.cnames[host]; ok {
+		return cname, nil
+	}
+	return "", errors.New("no such host")
+}
+
+func (r *stubResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestCreateCustomDomainTool(t *testing.T) {
+	fakeClient := fakeClientWithService(t, "own-123")
+	fakeClient.CreateCustomDomainWithResponseReturns(&client.CreateCustomDomainResponse{
+		JSON201: &[]client.CustomDomain{
+			{
+				Id:                 "cdm-1",
+				Name:               "example.com",
+				DomainType:         client.CustomDomainDomainTypeApex,
+				VerificationStatus: client.CustomDomainVerificationStatusUnverified,
+			},
+			{
+				Id:                 "cdm-2",
+				Name:               "www.example.com",
+				DomainType:         client.CustomDomainDomainTypeSubdomain,
+				VerificationStatus: client.CustomDomainVerificationStatusUnverified,
+				RedirectForName:    "example.com",
+			},
+		},
+		HTTPResponse: &http.Response{StatusCode: 201},
+	}, nil)
+
+	_, handler := createCustomDomain(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"serviceId": "srv-123", "name": "example.com"}
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	_, serviceId, body, _ := fakeClient.CreateCustomDomainWithResponseArgsForCall(0)
+	assert.Equal(t, "srv-123", serviceId)
+	assert.Equal(t, "example.com", body.Name)
+
+	var statuses []CustomDomainStatus
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &statuses))
+	require.Len(t, statuses, 2)
+	assert.Equal(t, []DNSRecord{{Type: "A", Name: "example.com", Value: renderApexIP}}, statuses[0].RequiredRecords)
+	assert.Equal(t, []DNSRecord{{Type: "CNAME", Name: "www.example.com", Value: "my-site.onrender.com"}}, statuses[1].RequiredRecords)
+	assert.Equal(t, "unverified", statuses[1].VerificationStatus)
+	assert.Contains(t, statuses[1].NextSteps, "Add the CNAME record for www.example.com")
+}
+
+func TestGetCustomDomainToolChecksDNS(t *testing.T) {
+	tests := []struct {
+		name               string
+		domain             client.CustomDomain
+		resolver           *stubResolver
+		checkDNS           bool
+		expectedPropagated *bool
+		expectedFound      []string
+		expectedNextSteps  string
+	}{
+		{
+			name:              "Doesn't look up records unless asked",
+			domain:            subdomain(client.CustomDomainVerificationStatusUnverified),
+			resolver:          &stubResolver{},
+			expectedNextSteps: "Add the CNAME record",
+		},
+		{
+			name:               "Reports a propagated CNAME record",
+			domain:             subdomain(client.CustomDomainVerificationStatusUnverified),
+			resolver:           &stubResolver{cnames: map[string]string{"www.example.com": "My-Site.onrender.com."}},
+			checkDNS:           true,
+			expectedPropagated: pointers.From(true),
+			expectedFound:      []string{"My-Site.onrender.com"},
+			expectedNextSteps:  "The DNS record has propagated",
+		},
+		{
+			name:               "Reports a CNAME record pointing elsewhere",
+			domain:             subdomain(client.CustomDomainVerificationStatusUnverified),
+			resolver:           &stubResolver{cnames: map[string]string{"www.example.com": "old-host.example.net."}},
+			checkDNS:           true,
+			expectedPropagated: pointers.From(false),
+			expectedFound:      []string{"old-host.example.net"},
+			expectedNextSteps:  "Add the CNAME record",
+		},
+		{
+			name: "Reports a propagated A record",
+			domain: client.CustomDomain{
+				Id:                 "cdm-1",
+				Name:               "example.com",
+				DomainType:         client.CustomDomainDomainTypeApex,
+				VerificationStatus: client.CustomDomainVerificationStatusVerified,
+			},
+			resolver:           &stubResolver{hosts: map[string][]string{"example.com": {renderApexIP}}},
+			checkDNS:           true,
+			expectedPropagated: pointers.From(true),
+			expectedFound:      []string{renderApexIP},
+			expectedNextSteps:  "The domain is verified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fakeClientWithService(t, "own-123")
+			fakeClient.RetrieveCustomDomainWithResponseReturns(&client.RetrieveCustomDomainResponse{
+				JSON200:      &tt.domain,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			_, handler := getCustomDomain(NewRepo(fakeClient), tt.resolver)
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"serviceId":    "srv-123",
+				"customDomain": tt.domain.Name,
+				"checkDns":     tt.checkDNS,
+			}
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+			var status CustomDomainStatus
+			require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &status))
+			require.Len(t, status.RequiredRecords, 1)
+			assert.Equal(t, tt.expectedPropagated, status.RequiredRecords[0].Propagated)
+			assert.Equal(t, tt.expectedFound, status.RequiredRecords[0].Found)
+			assert.Contains(t, status.NextSteps, tt.expectedNextSteps)
+		})
+	}
+}
+
+func TestRefreshCustomDomainTool(t *testing.T) {
+	fakeClient := fakeClientWithService(t, "own-123")
+	fakeClient.RefreshCustomDomainWithResponseReturns(&client.RefreshCustomDomainResponse{
+		HTTPResponse: &http.Response{StatusCode: 202},
+	}, nil)
+	domain := subdomain(client.CustomDomainVerificationStatusVerified)
+	fakeClient.RetrieveCustomDomainWithResponseReturns(&client.RetrieveCustomDomainResponse{
+		JSON200:      &domain,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := refreshCustomDomain(NewRepo(fakeClient), &stubResolver{})
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"serviceId": "srv-123", "customDomain": "www.example.com"}
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	assert.Equal(t, 1, fakeClient.RefreshCustomDomainWithResponseCallCount())
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"verificationStatus":"verified"`)
+}
+
+func TestCustomDomainToolsCheckWorkspace(t *testing.T) {
+	fakeClient := fakeClientWithService(t, "own-other")
+
+	_, handler := deleteCustomDomain(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"serviceId": "srv-123", "customDomain": "www.example.com"}
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "resource in workspace does not match")
+	assert.Equal(t, 0, fakeClient.DeleteCustomDomainWithResponseCallCount())
+}
+
+func TestServiceHostname(t *testing.T) {
+	_, err := serviceHostname(&client.Service{Id: "srv-123", Type: client.BackgroundWorker})
+	assert.EqualError(t, err, "custom domains are only available for web services and static sites, not background_worker")
+}
+
+func subdomain(status client.CustomDomainVerificationStatus) client.CustomDomain {
+	return client.CustomDomain{
+		Id:                 "cdm-2",
+		Name:               "www.example.com",
+		DomainType:         client.CustomDomainDomainTypeSubdomain,
+		VerificationStatus: status,
+	}
+}
+
+func fakeClientWithService(t *testing.T, ownerId string) *fakes.FakeCustomDomainRepoClient {
+	var details client.Service_ServiceDetails
+	require.NoError(t, details.FromStaticSiteDetails(client.StaticSiteDetails{
+		Url: "https://my-site.onrender.com",
+	}))
+
+	fakeClient := &fakes.FakeCustomDomainRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200: &client.Service{
+			Id:             "srv-123",
+			OwnerId:        ownerId,
+			Type:           client.StaticSite,
+			ServiceDetails: details,
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
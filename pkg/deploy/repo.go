@@ -2,15 +2,27 @@ package deploy
 
 import (
 	"context"
+	"time"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
 )
 
+//go:generate go tool counterfeiter -o ../fakes/fakedeployrepoclient_gen.go . deployRepoClient
+type deployRepoClient interface {
+	ListDeploysWithResponse(ctx context.Context, serviceId string, params *client.ListDeploysParams, reqEditors ...client.RequestEditorFn) (*client.ListDeploysResponse, error)
+	RetrieveDeployWithResponse(ctx context.Context, serviceId string, deployId string, reqEditors ...client.RequestEditorFn) (*client.RetrieveDeployResponse, error)
+	CreateDeployWithResponse(ctx context.Context, serviceId string, body client.CreateDeployJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateDeployResponse, error)
+	CancelDeployWithResponse(ctx context.Context, serviceId string, deployId string, reqEditors ...client.RequestEditorFn) (*client.CancelDeployResponse, error)
+	RollbackDeployWithResponse(ctx context.Context, serviceId string, body client.RollbackDeployJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.RollbackDeployResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
 type Repo struct {
-	client *client.ClientWithResponses
+	client deployRepoClient
 }
 
-func NewRepo(c *client.ClientWithResponses) *Repo {
+func NewRepo(c deployRepoClient) *Repo {
 	return &Repo{
 		client: c,
 	}
@@ -50,3 +62,122 @@ func (r *Repo) GetDeploy(ctx context.Context, serviceId string, deployId string)
 
 	return resp.JSON200, nil
 }
+
+// TriggerDeploy starts a new deploy of the service. The returned deploy is nil if the API queued the
+// deploy behind one that is already in progress.
+func (r *Repo) TriggerDeploy(ctx context.Context, serviceId string, body client.CreateDeployJSONRequestBody) (*client.Deploy, error) {
+	if err := r.validateServiceWorkspace(ctx, serviceId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.CreateDeployWithResponse(ctx, serviceId, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (r *Repo) CancelDeploy(ctx context.Context, serviceId string, deployId string) (*client.Deploy, error) {
+	if err := r.validateServiceWorkspace(ctx, serviceId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.CancelDeployWithResponse(ctx, serviceId, deployId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) RollbackDeploy(ctx context.Context, serviceId string, deployId string) (*client.Deploy, error) {
+	if err := r.validateServiceWorkspace(ctx, serviceId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.RollbackDeployWithResponse(ctx, serviceId, client.RollbackDeployJSONRequestBody{
+		DeployId: deployId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+// WaitForDeploy polls the deploy every interval until it reaches a terminal status or the context is
+// done. onPoll is called with the deploy after every poll. When the context is done, the last deploy
+// that was retrieved is returned along with the context's error.
+func (r *Repo) WaitForDeploy(ctx context.Context, serviceId string, deployId string, interval time.Duration, onPoll func(*client.Deploy)) (*client.Deploy, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var deploy *client.Deploy
+	for {
+		latest, err := r.GetDeploy(ctx, serviceId, deployId)
+		if err != nil {
+			if ctx.Err() != nil {
+				return deploy, ctx.Err()
+			}
+			return nil, err
+		}
+		deploy = latest
+
+		onPoll(deploy)
+		if IsTerminalStatus(deploy.Status) {
+			return deploy, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return deploy, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsTerminalStatus reports whether a deploy with the given status is finished and will not change
+// status again without further action.
+func IsTerminalStatus(status *client.DeployStatus) bool {
+	if status == nil {
+		return false
+	}
+
+	switch *status {
+	case client.DeployStatusLive,
+		client.DeployStatusDeactivated,
+		client.DeployStatusCanceled,
+		client.DeployStatusBuildFailed,
+		client.DeployStatusUpdateFailed,
+		client.DeployStatusPreDeployFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Repo) validateServiceWorkspace(ctx context.Context, serviceId string) error {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return err
+	}
+
+	return validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId)
+}
@@ -3,10 +3,14 @@ package deploy
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
 	"github.com/render-oss/render-mcp-server/pkg/validate"
 )
@@ -18,8 +22,29 @@ func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
 	s.AddTool(*tool, handler)
 	tool, handler = getDeploy(deployRepo)
 	s.AddTool(*tool, handler)
+	tool, handler = triggerDeploy(deployRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = cancelDeploy(deployRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = rollbackDeploy(deployRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = waitForDeploy(deployRepo)
+	s.AddTool(*tool, handler)
 }
 
+const (
+	minWaitForDeployTimeoutSeconds = 10
+	maxWaitForDeployTimeoutSeconds = 1800
+)
+
+var (
+	// waitForDeployPollInterval is how often wait_for_deploy checks the status of the deploy.
+	waitForDeployPollInterval = 10 * time.Second
+	// waitForDeployTimeoutUnit is the length of one unit of timeoutSeconds. It's a variable so tests
+	// can time out without waiting the minimum of 10 seconds.
+	waitForDeployTimeoutUnit = time.Second
+)
+
 func listDeploys(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("list_deploys",
 		mcp.WithDescription("List deploys matching the provided filters. If no filters are provided, all deploys for the service are returned."),
@@ -131,4 +156,271 @@ func getDeploy(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 
 			return mcp.NewToolResultText(string(respJSON)), nil
 		}
-}
\ No newline at end of file
+}
+
+func triggerDeploy(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("trigger_deploy",
+		mcp.WithDescription("Trigger a new deploy for a service. "+
+			"By default, the latest commit on the service's connected branch is deployed. "+
+			"Services that auto-deploy do not need a manual deploy after a push. "+
+			"Use the wait_for_deploy tool to wait for the deploy to finish."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Trigger deploy",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to deploy"),
+		),
+		mcp.WithString("commitId",
+			mcp.Description("The SHA of a specific Git commit to deploy. Defaults to the latest commit on "+
+				"the service's connected branch. Not supported for cron jobs or image-backed services."),
+		),
+		mcp.WithString("imageUrl",
+			mcp.Description("The URL of the image to deploy for an image-backed service. The host, "+
+				"repository, and image name must match the image currently configured for the service."),
+		),
+		mcp.WithBoolean("clearCache",
+			mcp.Description("Whether to clear the service's build cache before deploying. This can help "+
+				"when a build is failing because of stale cached dependencies. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			body := client.CreateDeployJSONRequestBody{}
+
+			commitId, hasCommitId, err := validate.OptionalToolParam[string](request, "commitId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			imageUrl, hasImageUrl, err := validate.OptionalToolParam[string](request, "imageUrl")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if hasCommitId && hasImageUrl {
+				return mcp.NewToolResultError("only one of commitId and imageUrl can be provided"), nil
+			}
+			if hasCommitId {
+				body.CommitId = &commitId
+			}
+			if hasImageUrl {
+				body.ImageUrl = &imageUrl
+			}
+
+			if clearCache, ok, err := validate.OptionalToolParam[bool](request, "clearCache"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && clearCache {
+				body.ClearCache = pointers.From(client.Clear)
+			}
+
+			deploy, err := deployRepo.TriggerDeploy(ctx, serviceId, body)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if deploy == nil {
+				return mcp.NewToolResultText("Deploy queued. It will start once the deploy that is " +
+					"currently in progress for this service finishes."), nil
+			}
+
+			respJSON, err := json.Marshal(deploy)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func cancelDeploy(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("cancel_deploy",
+		mcp.WithDescription("Cancel a deploy that is in progress. "+
+			"The service keeps running the most recent live deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Cancel deploy",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service the deploy belongs to"),
+		),
+		mcp.WithString("deployId",
+			mcp.Required(),
+			mcp.Description("The ID of the deploy to cancel"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deployId, err := validate.RequiredToolParam[string](request, "deployId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deploy, err := deployRepo.CancelDeploy(ctx, serviceId, deployId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(deploy)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func rollbackDeploy(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("rollback_deploy",
+		mcp.WithDescription("Roll a service back to a previous deploy. This creates a new deploy that "+
+			"uses the build of the given deploy. Rolling back disables auto-deploy for the service, so "+
+			"the user should be told to re-enable it once the underlying issue is fixed."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Roll back deploy",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to roll back"),
+		),
+		mcp.WithString("deployId",
+			mcp.Required(),
+			mcp.Description("The ID of the previous deploy to roll back to"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deployId, err := validate.RequiredToolParam[string](request, "deployId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deploy, err := deployRepo.RollbackDeploy(ctx, serviceId, deployId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(deploy)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+type WaitForDeployResult struct {
+	Deploy         *client.Deploy `json:"deploy"`
+	Finished       bool           `json:"finished"`
+	TimedOut       bool           `json:"timedOut"`
+	ElapsedSeconds int            `json:"elapsedSeconds"`
+}
+
+func waitForDeploy(deployRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("wait_for_deploy",
+		mcp.WithDescription("Wait for a deploy to finish by polling its status until it reaches a "+
+			"terminal status (live, deactivated, canceled, build_failed, update_failed or "+
+			"pre_deploy_failed) or the timeout is reached. Progress notifications are sent while "+
+			"waiting if the client requested them. If the deploy failed, use the list_logs tool with "+
+			"the service ID to find out why."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Wait for deploy",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service the deploy belongs to"),
+		),
+		mcp.WithString("deployId",
+			mcp.Required(),
+			mcp.Description("The ID of the deploy to wait for"),
+		),
+		mcp.WithNumber("timeoutSeconds",
+			mcp.Description("The maximum number of seconds to wait. If the deploy hasn't finished by "+
+				"then, its current status is returned and this tool can be called again to keep waiting."),
+			mcp.DefaultNumber(600),
+			mcp.Min(minWaitForDeployTimeoutSeconds),
+			mcp.Max(maxWaitForDeployTimeoutSeconds),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deployId, err := validate.RequiredToolParam[string](request, "deployId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			timeout := 600 * waitForDeployTimeoutUnit
+			if timeoutSeconds, ok, err := validate.OptionalToolParam[float64](request, "timeoutSeconds"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if timeoutSeconds < minWaitForDeployTimeoutSeconds || timeoutSeconds > maxWaitForDeployTimeoutSeconds {
+					return mcp.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between %d and %d", minWaitForDeployTimeoutSeconds, maxWaitForDeployTimeoutSeconds)), nil
+				}
+				timeout = time.Duration(timeoutSeconds * float64(waitForDeployTimeoutUnit))
+			}
+
+			start := time.Now()
+			waitCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			polls := 0
+			deploy, err := deployRepo.WaitForDeploy(waitCtx, serviceId, deployId, waitForDeployPollInterval, func(deploy *client.Deploy) {
+				polls++
+				status := "unknown"
+				if deploy.Status != nil {
+					status = string(*deploy.Status)
+				}
+				mcpserver.SendProgress(ctx, request, float64(polls), nil,
+					fmt.Sprintf("Deploy %s is %s after %s", deployId, status, time.Since(start).Round(time.Second)))
+			})
+			timedOut := errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil
+			if err != nil && !timedOut {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(WaitForDeployResult{
+				Deploy:         deploy,
+				Finished:       !timedOut,
+				TimedOut:       timedOut,
+				ElapsedSeconds: int(time.Since(start).Seconds()),
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
@@ -0,0 +1,226 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForDeployTool(t *testing.T) {
+	waitForDeployPollInterval = time.Millisecond
+	t.Cleanup(func() { waitForDeployPollInterval = 10 * time.Second })
+
+	tests := []struct {
+		name             string
+		statuses         []client.DeployStatus
+		timeoutSeconds   float64
+		expectedStatus   client.DeployStatus
+		expectedTimedOut bool
+	}{
+		{
+			name: "Polls until the deploy is live",
+			statuses: []client.DeployStatus{
+				client.DeployStatusCreated,
+				client.DeployStatusBuildInProgress,
+				client.DeployStatusUpdateInProgress,
+				client.DeployStatusLive,
+			},
+			timeoutSeconds: 10,
+			expectedStatus: client.DeployStatusLive,
+		},
+		{
+			name: "Stops polling when the build fails",
+			statuses: []client.DeployStatus{
+				client.DeployStatusBuildInProgress,
+				client.DeployStatusBuildFailed,
+			},
+			timeoutSeconds: 10,
+			expectedStatus: client.DeployStatusBuildFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeDeployRepoClient{}
+			for i, status := range tt.statuses {
+				fakeClient.RetrieveDeployWithResponseReturnsOnCall(i, deployResponse("dep-123", status), nil)
+			}
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"serviceId":      "srv-123",
+				"deployId":       "dep-123",
+				"timeoutSeconds": tt.timeoutSeconds,
+			}
+
+			_, handler := waitForDeploy(NewRepo(fakeClient))
+			result, err := handler(context.Background(), request)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+
+			var waitResult WaitForDeployResult
+			assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &waitResult))
+			assert.Equal(t, tt.expectedStatus, *waitResult.Deploy.Status)
+			assert.True(t, waitResult.Finished)
+			assert.False(t, waitResult.TimedOut)
+			assert.Equal(t, len(tt.statuses), fakeClient.RetrieveDeployWithResponseCallCount())
+		})
+	}
+}
+
+func TestWaitForDeployToolTimesOut(t *testing.T) {
+	waitForDeployPollInterval = time.Hour
+	waitForDeployTimeoutUnit = time.Millisecond
+	t.Cleanup(func() {
+		waitForDeployPollInterval = 10 * time.Second
+		waitForDeployTimeoutUnit = time.Second
+	})
+
+	fakeClient := &fakes.FakeDeployRepoClient{}
+	fakeClient.RetrieveDeployWithResponseReturns(deployResponse("dep-123", client.DeployStatusBuildInProgress), nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId":      "srv-123",
+		"deployId":       "dep-123",
+		"timeoutSeconds": float64(10),
+	}
+
+	_, handler := waitForDeploy(NewRepo(fakeClient))
+	result, err := handler(context.Background(), request)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var waitResult WaitForDeployResult
+	assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &waitResult))
+	assert.Equal(t, client.DeployStatusBuildInProgress, *waitResult.Deploy.Status)
+	assert.False(t, waitResult.Finished)
+	assert.True(t, waitResult.TimedOut)
+}
+
+func TestWaitForDeployToolRejectsTimeoutOutOfRange(t *testing.T) {
+	for _, timeoutSeconds := range []float64{5, 86400} {
+		fakeClient := &fakes.FakeDeployRepoClient{}
+
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{
+			"serviceId":      "srv-123",
+			"deployId":       "dep-123",
+			"timeoutSeconds": timeoutSeconds,
+		}
+
+		_, handler := waitForDeploy(NewRepo(fakeClient))
+		result, err := handler(context.Background(), request)
+		assert.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, "timeoutSeconds must be between 10 and 1800", result.Content[0].(mcp.TextContent).Text)
+		assert.Equal(t, 0, fakeClient.RetrieveDeployWithResponseCallCount())
+	}
+}
+
+func TestTriggerDeployTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		serviceOwner  string
+		expectedBody  client.CreateDeployJSONRequestBody
+		expectedError string
+	}{
+		{
+			name: "Deploys a specific commit and clears the cache",
+			arguments: map[string]interface{}{
+				"commitId":   "abc123",
+				"clearCache": true,
+			},
+			serviceOwner: "own-123",
+			expectedBody: client.CreateDeployJSONRequestBody{
+				CommitId:   pointers.From("abc123"),
+				ClearCache: pointers.From(client.Clear),
+			},
+		},
+		{
+			name:         "Deploys the latest commit by default",
+			arguments:    map[string]interface{}{},
+			serviceOwner: "own-123",
+			expectedBody: client.CreateDeployJSONRequestBody{},
+		},
+		{
+			name: "Rejects both a commit and an image",
+			arguments: map[string]interface{}{
+				"commitId": "abc123",
+				"imageUrl": "docker.io/library/nginx:latest",
+			},
+			serviceOwner:  "own-123",
+			expectedError: "only one of commitId and imageUrl can be provided",
+		},
+		{
+			name:          "Rejects services outside the current workspace",
+			arguments:     map[string]interface{}{},
+			serviceOwner:  "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := session.ContextWithTestWorkspace(t, "own-123")
+			fakeClient := &fakes.FakeDeployRepoClient{}
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      &client.Service{Id: "srv-123", OwnerId: tt.serviceOwner},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.CreateDeployWithResponseReturns(&client.CreateDeployResponse{
+				JSON201:      &client.Deploy{Id: "dep-123", Status: pointers.From(client.DeployStatusCreated)},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"serviceId": "srv-123"}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			_, handler := triggerDeploy(NewRepo(fakeClient))
+			result, err := handler(ctx, request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.CreateDeployWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "dep-123")
+			_, serviceId, body, _ := fakeClient.CreateDeployWithResponseArgsForCall(0)
+			assert.Equal(t, "srv-123", serviceId)
+			assert.Equal(t, tt.expectedBody, body)
+		})
+	}
+}
+
+func TestIsTerminalStatus(t *testing.T) {
+	assert.False(t, IsTerminalStatus(nil))
+	assert.False(t, IsTerminalStatus(pointers.From(client.DeployStatusCreated)))
+	assert.False(t, IsTerminalStatus(pointers.From(client.DeployStatusPreDeployInProgress)))
+	assert.True(t, IsTerminalStatus(pointers.From(client.DeployStatusLive)))
+	assert.True(t, IsTerminalStatus(pointers.From(client.DeployStatusCanceled)))
+	assert.True(t, IsTerminalStatus(pointers.From(client.DeployStatusPreDeployFailed)))
+}
+
+func deployResponse(id string, status client.DeployStatus) *client.RetrieveDeployResponse {
+	return &client.RetrieveDeployResponse{
+		JSON200:      &client.Deploy{Id: id, Status: pointers.From(status)},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}
+}
@@ -0,0 +1,30 @@
+package diff
+
+// FieldChange describes a single field that an update changes, so the MCP host can show the user
+// exactly what is about to happen before it happens.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AppendChange records a change for field if a new value was provided and it differs from the
+// current value.
+func AppendChange(changes []FieldChange, field string, before string, after *string) []FieldChange {
+	if after == nil || *after == before {
+		return changes
+	}
+	return append(changes, FieldChange{
+		Field:  field,
+		Before: before,
+		After:  *after,
+	})
+}
+
+// DerefString returns the value of s, or "" if s is nil, for fields whose current value is optional.
+func DerefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,175 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	disktypes "github.com/render-oss/render-mcp-server/pkg/client/disks"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+//go:generate go tool counterfeiter -o ../fakes/fakediskrepoclient_gen.go . diskRepoClient
+type diskRepoClient interface {
+	ListDisksWithResponse(ctx context.Context, params *client.ListDisksParams, reqEditors ...client.RequestEditorFn) (*client.ListDisksResponse, error)
+	AddDiskWithResponse(ctx context.Context, body client.AddDiskJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AddDiskResponse, error)
+	RetrieveDiskWithResponse(ctx context.Context, diskId disktypes.DiskId, reqEditors ...client.RequestEditorFn) (*client.RetrieveDiskResponse, error)
+	UpdateDiskWithResponse(ctx context.Context, diskId disktypes.DiskId, body client.UpdateDiskJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateDiskResponse, error)
+	DeleteDiskWithResponse(ctx context.Context, diskId disktypes.DiskId, reqEditors ...client.RequestEditorFn) (*client.DeleteDiskResponse, error)
+	ListSnapshotsWithResponse(ctx context.Context, diskId string, reqEditors ...client.RequestEditorFn) (*client.ListSnapshotsResponse, error)
+	RestoreSnapshotWithResponse(ctx context.Context, diskId string, body client.RestoreSnapshotJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.RestoreSnapshotResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
+// Repo calls the disk API. Methods that add, change or restore a disk, or list its snapshots, don't
+// check that it belongs to the current workspace, so callers must look the disk up with GetDisk, or
+// the service with GetService, first.
+type Repo struct {
+	client diskRepoClient
+}
+
+func NewRepo(c diskRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+// GetService returns a service after checking that it belongs to the current workspace.
+func (r *Repo) GetService(ctx context.Context, serviceId string) (*client.Service, error) {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) ListDisks(ctx context.Context, params *client.ListDisksParams) ([]*disktypes.DiskDetails, error) {
+	workspace, err := session.FromContext(ctx).GetWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params.OwnerId = &client.OwnerIdParam{workspace}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *client.ListDisksParams) ([]*disktypes.DiskDetails, *client.Cursor, error) {
+	resp, err := r.client.ListDisksWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	disks := make([]*disktypes.DiskDetails, 0, len(res))
+	for _, diskWithCursor := range res {
+		disks = append(disks, &diskWithCursor.Disk)
+	}
+
+	return disks, &res[len(res)-1].Cursor, nil
+}
+
+// GetDisk returns a disk after checking that the service it's attached to belongs to the current
+// workspace. Disks don't have an owner of their own.
+func (r *Repo) GetDisk(ctx context.Context, diskId string) (*disktypes.DiskDetails, error) {
+	resp, err := r.client.RetrieveDiskWithResponse(ctx, diskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	disk := resp.JSON200
+	if disk.ServiceId == nil {
+		return nil, fmt.Errorf("disk %s is not attached to a service", diskId)
+	}
+
+	if _, err := r.GetService(ctx, *disk.ServiceId); err != nil {
+		return nil, err
+	}
+
+	return disk, nil
+}
+
+func (r *Repo) AddDisk(ctx context.Context, input disktypes.DiskPOST) (*disktypes.DiskDetails, error) {
+	resp, err := r.client.AddDiskWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (r *Repo) UpdateDisk(ctx context.Context, diskId string, input disktypes.DiskPATCH) (*disktypes.DiskDetails, error) {
+	resp, err := r.client.UpdateDiskWithResponse(ctx, diskId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteDisk(ctx context.Context, diskId string) error {
+	resp, err := r.client.DeleteDiskWithResponse(ctx, diskId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) ListSnapshots(ctx context.Context, diskId string) ([]client.DiskSnapshot, error) {
+	resp, err := r.client.ListSnapshotsWithResponse(ctx, diskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return []client.DiskSnapshot{}, nil
+	}
+
+	return *resp.JSON201, nil
+}
+
+func (r *Repo) RestoreSnapshot(ctx context.Context, diskId string, input client.SnapshotRestorePOST) (*disktypes.DiskDetails, error) {
+	resp, err := r.client.RestoreSnapshotWithResponse(ctx, diskId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,443 @@
+package disk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	disktypes "github.com/render-oss/render-mcp-server/pkg/client/disks"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	diskRepo := NewRepo(c)
+	metricsRepo := metrics.NewRepo(c)
+
+	tool, handler := listDisks(diskRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getDisk(diskRepo, metricsRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = addDisk(diskRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateDisk(diskRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteDisk(diskRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listSnapshots(diskRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = restoreSnapshot(diskRepo)
+	s.AddTool(*tool, handler)
+}
+
+func withDiskId() mcp.ToolOption {
+	return mcp.WithString("diskId",
+		mcp.Required(),
+		mcp.Description("The ID of the disk"),
+	)
+}
+
+// sizeGBParam reads a disk size, which must be a whole number of gigabytes of at least 1.
+func sizeGBParam(request mcp.CallToolRequest) (int, bool, error) {
+	sizeGB, ok, err := validate.OptionalToolParam[float64](request, "sizeGB")
+	if err != nil || !ok {
+		return 0, false, err
+	}
+
+	if sizeGB < 1 || sizeGB != math.Trunc(sizeGB) {
+		return 0, false, fmt.Errorf("sizeGB must be a whole number of at least 1, got %v", sizeGB)
+	}
+	return int(sizeGB), true, nil
+}
+
+func validateMountPath(mountPath string) error {
+	if !strings.HasPrefix(mountPath, "/") {
+		return fmt.Errorf("mount path %q must be an absolute path", mountPath)
+	}
+	return nil
+}
+
+func listDisks(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_disks",
+		mcp.WithDescription("List the persistent disks in your Render workspace."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List disks",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Description("Only list the disk attached to this service"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			params := &client.ListDisksParams{}
+			if serviceId, ok, err := validate.OptionalToolParam[string](request, "serviceId"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				params.ServiceId = &client.ServiceIdsParam{serviceId}
+			}
+
+			disks, err := diskRepo.ListDisks(ctx, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(disks)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func getDisk(diskRepo *Repo, metricsRepo *metrics.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_disk",
+		mcp.WithDescription("Get a persistent disk's configuration along with how full it is. "+
+			"Usage is the most recent reading from the last hour. A service with a disk runs one instance, "+
+			"but if readings were reported for more than one, such as when a deploy replaced the instance, the fullest is reported."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get disk",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		withDiskId(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			diskId, err := validate.RequiredToolParam[string](request, "diskId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			disk, err := diskRepo.GetDisk(ctx, diskId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(diskStatus(ctx, metricsRepo, disk))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func addDisk(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("add_disk",
+		mcp.WithDescription("Attach a new persistent disk to a web service, private service or background worker. "+
+			"Files written under the mount path survive deploys and restarts. "+
+			"A service with a disk can't scale to more than one instance and has no zero-downtime deploys. "+
+			"Adding a disk triggers a new deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Add disk",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to attach the disk to"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("A name for the disk"),
+		),
+		mcp.WithString("mountPath",
+			mcp.Required(),
+			mcp.Description("The absolute path to mount the disk at, for example /var/data"),
+		),
+		mcp.WithNumber("sizeGB",
+			mcp.Required(),
+			mcp.Description("The size of the disk in GB. Disks can be grown later but never shrunk."),
+			mcp.Min(1),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			mountPath, err := validate.RequiredToolParam[string](request, "mountPath")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateMountPath(mountPath); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			sizeGB, ok, err := sizeGBParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("sizeGB parameter is required"), nil
+			}
+
+			service, err := diskRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			switch service.Type {
+			case client.WebService, client.PrivateService, client.BackgroundWorker:
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("service %s is a %s, disks can only be attached to web services, private services and background workers", serviceId, service.Type)), nil
+			}
+
+			disk, err := diskRepo.AddDisk(ctx, disktypes.DiskPOST{
+				ServiceId: serviceId,
+				Name:      name,
+				MountPath: mountPath,
+				SizeGB:    sizeGB,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(disk)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func updateDisk(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_disk",
+		mcp.WithDescription("Rename, move or resize a persistent disk. Disks can be grown but never shrunk."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update disk",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withDiskId(),
+		mcp.WithString("name",
+			mcp.Description("A new name for the disk"),
+		),
+		mcp.WithString("mountPath",
+			mcp.Description("A new absolute path to mount the disk at"),
+		),
+		mcp.WithNumber("sizeGB",
+			mcp.Description("A new size for the disk in GB, which must not be smaller than the current size"),
+			mcp.Min(1),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			diskId, err := validate.RequiredToolParam[string](request, "diskId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := disktypes.DiskPATCH{}
+			if name, ok, err := validate.OptionalToolParam[string](request, "name"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				input.Name = &name
+			}
+
+			if mountPath, ok, err := validate.OptionalToolParam[string](request, "mountPath"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if err := validateMountPath(mountPath); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.MountPath = &mountPath
+			}
+
+			if sizeGB, ok, err := sizeGBParam(request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				input.SizeGB = &sizeGB
+			}
+
+			if input.Name == nil && input.MountPath == nil && input.SizeGB == nil {
+				return mcp.NewToolResultError("at least one of name, mountPath or sizeGB is required"), nil
+			}
+
+			disk, err := diskRepo.GetDisk(ctx, diskId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if input.SizeGB != nil && *input.SizeGB < disk.SizeGB {
+				return mcp.NewToolResultError(fmt.Sprintf("disk %s is %d GB and can't be shrunk to %d GB", diskId, disk.SizeGB, *input.SizeGB)), nil
+			}
+
+			updated, err := diskRepo.UpdateDisk(ctx, diskId, input)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(updated)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteDisk(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_disk",
+		mcp.WithDescription("Permanently delete a persistent disk along with its data and snapshots. This cannot be undone. "+
+			"To confirm the deletion, confirmName must exactly match the name of the disk, which you can look up with the get_disk tool. "+
+			"Always ask the user to confirm before deleting a disk."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete disk",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withDiskId(),
+		mcp.WithString("confirmName",
+			mcp.Required(),
+			mcp.Description("The name of the disk, to confirm that it is the one to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			diskId, err := validate.RequiredToolParam[string](request, "diskId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			confirmName, err := validate.RequiredToolParam[string](request, "confirmName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			disk, err := diskRepo.GetDisk(ctx, diskId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if confirmName != disk.Name {
+				return mcp.NewToolResultError(fmt.Sprintf("confirmation name %q does not match the name of disk %s", confirmName, diskId)), nil
+			}
+
+			if err := diskRepo.DeleteDisk(ctx, diskId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Disk %s has been deleted", diskId)), nil
+		}
+}
+
+func listSnapshots(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_disk_snapshots",
+		mcp.WithDescription("List the snapshots of a persistent disk. Render takes a snapshot of each disk daily. "+
+			"Each snapshot has a key to pass to the restore_disk_snapshot tool."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List disk snapshots",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		withDiskId(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			diskId, err := validate.RequiredToolParam[string](request, "diskId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := diskRepo.GetDisk(ctx, diskId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			snapshots, err := diskRepo.ListSnapshots(ctx, diskId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(snapshots)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func restoreSnapshot(diskRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("restore_disk_snapshot",
+		mcp.WithDescription("Restore a persistent disk from a snapshot. Everything written to the disk since the snapshot "+
+			"was taken is lost, and the service is restarted. "+
+			"Always ask the user to confirm before restoring a snapshot."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Restore disk snapshot",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withDiskId(),
+		mcp.WithString("snapshotKey",
+			mcp.Required(),
+			mcp.Description("The key of the snapshot to restore, from the list_disk_snapshots tool"),
+		),
+		mcp.WithString("instanceId",
+			mcp.Description("The instance whose disk to restore. A service with a disk runs one instance, "+
+				"so this can usually be left out."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			diskId, err := validate.RequiredToolParam[string](request, "diskId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			snapshotKey, err := validate.RequiredToolParam[string](request, "snapshotKey")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := client.SnapshotRestorePOST{SnapshotKey: snapshotKey}
+			if instanceId, ok, err := validate.OptionalToolParam[string](request, "instanceId"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				input.InstanceId = &instanceId
+			}
+
+			if _, err := diskRepo.GetDisk(ctx, diskId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			disk, err := diskRepo.RestoreSnapshot(ctx, diskId, input)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(disk)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
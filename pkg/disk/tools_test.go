@@ -0,0 +1,246 @@
+package disk
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	disktypes "github.com/render-oss/render-mcp-server/pkg/client/disks"
+	metricstypes "github.com/render-oss/render-mcp-server/pkg/client/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDiskTool(t *testing.T) {
+	fakeClient := fakeClientWithDisk("own-123", client.WebService)
+
+	mockMetrics := &metrics.MockClientWithResponses{}
+	mockMetrics.On("GetDiskUsageWithResponse", mock.Anything, mock.Anything, mock.Anything).
+		Return(metrics.NewMockDiskUsageResponse(7516192768), nil)
+	mockMetrics.On("GetDiskCapacityWithResponse", mock.Anything, mock.Anything, mock.Anything).
+		Return(metrics.NewMockDiskCapacityResponse(10737418240), nil)
+
+	_, handler := getDisk(NewRepo(fakeClient), metrics.NewRepo(mockMetrics))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"diskId": "dsk-123",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var status DiskStatus
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &status))
+	assert.Equal(t, "dsk-123", status.Id)
+	assert.Equal(t, 10, status.SizeGB)
+	require.NotNil(t, status.Usage)
+	assert.Equal(t, 70.0, *status.Usage.FillPercentage)
+	assert.Equal(t, "bytes", status.Usage.Unit)
+	assert.Empty(t, status.UsageError)
+}
+
+func TestGetDiskToolReportsMetricsErrors(t *testing.T) {
+	fakeClient := fakeClientWithDisk("own-123", client.WebService)
+
+	mockMetrics := &metrics.MockClientWithResponses{}
+	mockMetrics.On("GetDiskUsageWithResponse", mock.Anything, mock.Anything, mock.Anything).
+		Return(&client.GetDiskUsageResponse{HTTPResponse: metrics.NewMockErrorResponse(500)}, nil)
+
+	_, handler := getDisk(NewRepo(fakeClient), metrics.NewRepo(mockMetrics))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"diskId": "dsk-123",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var status DiskStatus
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &status))
+	assert.Nil(t, status.Usage)
+	assert.Contains(t, status.UsageError, "disk usage")
+}
+
+func TestDiskUsageReportsFullestInstance(t *testing.T) {
+	now := time.Now()
+	series := func(instance string, values ...float32) metricstypes.TimeSeries {
+		s := metricstypes.TimeSeries{
+			Unit:   "bytes",
+			Labels: []metricstypes.Label{{Field: "instance", Value: instance}},
+		}
+		for i, v := range values {
+			s.Values = append(s.Values, metricstypes.TimeSeriesValue{Timestamp: now.Add(time.Duration(i) * time.Minute), Value: v})
+		}
+		return s
+	}
+
+	usage := diskUsage(
+		metricstypes.TimeSeriesCollection{series("a", 90, 20), series("b", 40, 50)},
+		metricstypes.TimeSeriesCollection{series("a", 100), series("b", 100)},
+	)
+	require.NotNil(t, usage)
+	assert.Equal(t, "b", usage.Instance)
+	assert.Equal(t, 50.0, *usage.FillPercentage)
+
+	assert.Nil(t, diskUsage(nil, metricstypes.TimeSeriesCollection{series("a", 100)}))
+}
+
+func TestAddDiskTool(t *testing.T) {
+	tests := []struct {
+		name        string
+		serviceType client.ServiceType
+		args        map[string]interface{}
+		errContains string
+	}{
+		{
+			name:        "web service",
+			serviceType: client.WebService,
+			args:        map[string]interface{}{"mountPath": "/var/data", "sizeGB": float64(10)},
+		},
+		{
+			name:        "static site",
+			serviceType: client.StaticSite,
+			args:        map[string]interface{}{"mountPath": "/var/data", "sizeGB": float64(10)},
+			errContains: "disks can only be attached to",
+		},
+		{
+			name:        "relative mount path",
+			serviceType: client.WebService,
+			args:        map[string]interface{}{"mountPath": "var/data", "sizeGB": float64(10)},
+			errContains: "must be an absolute path",
+		},
+		{
+			name:        "fractional size",
+			serviceType: client.WebService,
+			args:        map[string]interface{}{"mountPath": "/var/data", "sizeGB": 1.5},
+			errContains: "whole number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fakeClientWithDisk("own-123", tt.serviceType)
+			fakeClient.AddDiskWithResponseReturns(&client.AddDiskResponse{
+				JSON201:      &disktypes.DiskDetails{Id: "dsk-456", Name: "data", MountPath: "/var/data", SizeGB: 10},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+
+			_, handler := addDisk(NewRepo(fakeClient))
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"serviceId": "srv-123",
+				"name":      "data",
+			}
+			for k, v := range tt.args {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.errContains != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.errContains)
+				assert.Equal(t, 0, fakeClient.AddDiskWithResponseCallCount())
+				return
+			}
+
+			require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+			require.Equal(t, 1, fakeClient.AddDiskWithResponseCallCount())
+			_, body, _ := fakeClient.AddDiskWithResponseArgsForCall(0)
+			assert.Equal(t, disktypes.DiskPOST{ServiceId: "srv-123", Name: "data", MountPath: "/var/data", SizeGB: 10}, body)
+		})
+	}
+}
+
+func TestUpdateDiskToolRejectsShrinking(t *testing.T) {
+	fakeClient := fakeClientWithDisk("own-123", client.WebService)
+
+	_, handler := updateDisk(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"diskId": "dsk-123",
+		"sizeGB": float64(5),
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "can't be shrunk")
+	assert.Equal(t, 0, fakeClient.UpdateDiskWithResponseCallCount())
+}
+
+func TestRestoreSnapshotTool(t *testing.T) {
+	fakeClient := fakeClientWithDisk("own-123", client.WebService)
+	fakeClient.RestoreSnapshotWithResponseReturns(&client.RestoreSnapshotResponse{
+		JSON200:      &disktypes.DiskDetails{Id: "dsk-123"},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := restoreSnapshot(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"diskId":      "dsk-123",
+		"snapshotKey": "snap-1",
+		"instanceId":  "srv-123-abc",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	require.Equal(t, 1, fakeClient.RestoreSnapshotWithResponseCallCount())
+	_, diskId, body, _ := fakeClient.RestoreSnapshotWithResponseArgsForCall(0)
+	assert.Equal(t, "dsk-123", diskId)
+	assert.Equal(t, client.SnapshotRestorePOST{SnapshotKey: "snap-1", InstanceId: pointers.From("srv-123-abc")}, body)
+}
+
+func TestDiskToolsCheckWorkspace(t *testing.T) {
+	fakeClient := fakeClientWithDisk("own-456", client.WebService)
+
+	_, handler := deleteDisk(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"diskId":      "dsk-123",
+		"confirmName": "data",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "resource in workspace does not match")
+	assert.Equal(t, 0, fakeClient.DeleteDiskWithResponseCallCount())
+}
+
+func fakeClientWithDisk(ownerId string, serviceType client.ServiceType) *fakes.FakeDiskRepoClient {
+	fakeClient := &fakes.FakeDiskRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200: &client.Service{
+			Id:      "srv-123",
+			OwnerId: ownerId,
+			Type:    serviceType,
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.RetrieveDiskWithResponseReturns(&client.RetrieveDiskResponse{
+		JSON200: &disktypes.DiskDetails{
+			Id:        "dsk-123",
+			Name:      "data",
+			MountPath: "/var/data",
+			SizeGB:    10,
+			ServiceId: pointers.From("srv-123"),
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
@@ -0,0 +1,117 @@
+package disk
+
+import (
+	"context"
+	"time"
+
+	disktypes "github.com/render-oss/render-mcp-server/pkg/client/disks"
+	metricstypes "github.com/render-oss/render-mcp-server/pkg/client/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
+)
+
+// usageWindow is how far back get_disk looks for the latest disk usage and capacity.
+const usageWindow = time.Hour
+
+// DiskStatus is a disk's configuration along with how full it is. Metrics are best effort, so
+// UsageError is set instead of failing the tool when they can't be fetched.
+type DiskStatus struct {
+	*disktypes.DiskDetails
+	Usage      *DiskUsage `json:"usage,omitempty"`
+	UsageError string     `json:"usageError,omitempty"`
+}
+
+// DiskUsage is the most recent usage and capacity of a disk in Unit, usually bytes. A service with a
+// disk runs one instance, but readings can be reported for more than one, for example when a deploy
+// replaces the instance, so the fullest is reported.
+type DiskUsage struct {
+	Used           *float64 `json:"used"`
+	Capacity       *float64 `json:"capacity"`
+	Unit           string   `json:"unit,omitempty"`
+	FillPercentage *float64 `json:"fillPercentage"`
+	Instance       string   `json:"instance,omitempty"`
+}
+
+func diskStatus(ctx context.Context, metricsRepo *metrics.Repo, disk *disktypes.DiskDetails) *DiskStatus {
+	status := &DiskStatus{DiskDetails: disk}
+
+	end := time.Now()
+	start := end.Add(-usageWindow)
+	resp, err := metricsRepo.GetMetrics(ctx, metrics.MetricsRequest{
+		ResourceID:  *disk.ServiceId,
+		MetricTypes: []metrics.MetricType{metrics.MetricTypeDiskUsage, metrics.MetricTypeDiskCapacity},
+		StartTime:   &start,
+		EndTime:     &end,
+	})
+	if err != nil {
+		status.UsageError = err.Error()
+		return status
+	}
+
+	series := make(map[metrics.MetricType]metricstypes.TimeSeriesCollection, len(resp.Metrics))
+	for _, metric := range resp.Metrics {
+		series[metric.Type] = metric.Data
+	}
+
+	status.Usage = diskUsage(series[metrics.MetricTypeDiskUsage], series[metrics.MetricTypeDiskCapacity])
+	return status
+}
+
+// diskUsage pairs the latest usage and capacity of each instance and returns the fullest one, or nil
+// if there's no usage data.
+func diskUsage(usage, capacity metricstypes.TimeSeriesCollection) *DiskUsage {
+	capacities := latestByInstance(capacity)
+
+	var fullest *DiskUsage
+	for instance, used := range latestByInstance(usage) {
+		current := &DiskUsage{
+			Used:     metrics.RoundTo(used.value, 0),
+			Unit:     used.unit,
+			Instance: instance,
+		}
+		if c, ok := capacities[instance]; ok {
+			current.Capacity = metrics.RoundTo(c.value, 0)
+			if c.value > 0 {
+				current.FillPercentage = metrics.RoundTo(used.value/c.value*100, 1)
+			}
+		}
+
+		if fullest == nil || fillOrder(current) > fillOrder(fullest) ||
+			(fillOrder(current) == fillOrder(fullest) && current.Instance < fullest.Instance) {
+			fullest = current
+		}
+	}
+	return fullest
+}
+
+func fillOrder(usage *DiskUsage) float64 {
+	if usage.FillPercentage == nil {
+		return -1
+	}
+	return *usage.FillPercentage
+}
+
+type latest struct {
+	value     float64
+	unit      string
+	timestamp time.Time
+}
+
+// latestByInstance returns the most recent value of each series, keyed by its instance label.
+func latestByInstance(collection metricstypes.TimeSeriesCollection) map[string]latest {
+	values := make(map[string]latest, len(collection))
+	for _, s := range collection {
+		instance := ""
+		for _, label := range s.Labels {
+			if label.Field == "instance" {
+				instance = label.Value
+			}
+		}
+
+		for _, v := range s.Values {
+			if current, ok := values[instance]; !ok || v.Timestamp.After(current.timestamp) {
+				values[instance] = latest{value: float64(v.Value), unit: s.Unit, timestamp: v.Timestamp}
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,110 @@
+package envgroup
+
+import (
+	"sort"
+	"time"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+// EnvGroupSummary describes an env group without the values of its environment variables or the
+// contents of its secret files, so that secrets aren't pulled into the MCP host's context.
+type EnvGroupSummary struct {
+	Id              string                `json:"id"`
+	Name            string                `json:"name"`
+	OwnerId         string                `json:"ownerId"`
+	EnvironmentId   *string               `json:"environmentId,omitempty"`
+	EnvVarKeys      []string              `json:"envVarKeys"`
+	SecretFileNames []string              `json:"secretFileNames"`
+	LinkedServices  []client.EnvGroupLink `json:"linkedServices"`
+	CreatedAt       time.Time             `json:"createdAt"`
+	UpdatedAt       time.Time             `json:"updatedAt"`
+}
+
+func summarize(envGroup *client.EnvGroup) EnvGroupSummary {
+	summary := EnvGroupSummary{
+		Id:              envGroup.Id,
+		Name:            envGroup.Name,
+		OwnerId:         envGroup.OwnerId,
+		EnvironmentId:   envGroup.EnvironmentId,
+		EnvVarKeys:      make([]string, 0, len(envGroup.EnvVars)),
+		SecretFileNames: make([]string, 0, len(envGroup.SecretFiles)),
+		LinkedServices:  envGroup.ServiceLinks,
+		CreatedAt:       envGroup.CreatedAt,
+		UpdatedAt:       envGroup.UpdatedAt,
+	}
+	if summary.LinkedServices == nil {
+		summary.LinkedServices = []client.EnvGroupLink{}
+	}
+
+	for _, envVar := range envGroup.EnvVars {
+		summary.EnvVarKeys = append(summary.EnvVarKeys, envVar.Key)
+	}
+	for _, secretFile := range envGroup.SecretFiles {
+		summary.SecretFileNames = append(summary.SecretFileNames, secretFile.Name)
+	}
+	sort.Strings(summary.EnvVarKeys)
+	sort.Strings(summary.SecretFileNames)
+
+	return summary
+}
+
+// EnvVarUpdate lists the keys an update adds, changes and deletes. Values are left out so that
+// secrets aren't echoed back to the MCP host.
+type EnvVarUpdate struct {
+	Added          []string              `json:"added"`
+	Updated        []string              `json:"updated"`
+	Deleted        []string              `json:"deleted"`
+	LinkedServices []client.EnvGroupLink `json:"linkedServices"`
+}
+
+// planEnvVarUpdate works out which environment variables to set and delete. Like
+// update_environment_variables for services, the provided variables are merged with the existing
+// ones, unless replace is set, in which case existing variables that aren't provided are deleted.
+// Variables whose value doesn't change are left alone.
+func planEnvVarUpdate(current []client.EnvVar, envVarInputs []client.EnvVarInput, replace bool) (map[string]string, *EnvVarUpdate, error) {
+	currentValues := make(map[string]string, len(current))
+	for _, envVar := range current {
+		currentValues[envVar.Key] = envVar.Value
+	}
+
+	update := &EnvVarUpdate{
+		Added:   []string{},
+		Updated: []string{},
+		Deleted: []string{},
+	}
+	toSet := make(map[string]string)
+	provided := make(map[string]bool, len(envVarInputs))
+	for _, envVarInput := range envVarInputs {
+		envVar, err := envVarInput.AsEnvVarKeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		provided[envVar.Key] = true
+
+		currentValue, exists := currentValues[envVar.Key]
+		switch {
+		case !exists:
+			update.Added = append(update.Added, envVar.Key)
+		case currentValue != envVar.Value:
+			update.Updated = append(update.Updated, envVar.Key)
+		default:
+			continue
+		}
+		toSet[envVar.Key] = envVar.Value
+	}
+
+	if replace {
+		for key := range currentValues {
+			if !provided[key] {
+				update.Deleted = append(update.Deleted, key)
+			}
+		}
+	}
+
+	sort.Strings(update.Added)
+	sort.Strings(update.Updated)
+	sort.Strings(update.Deleted)
+
+	return toSet, update, nil
+}
@@ -0,0 +1,192 @@
+package envgroup
+
+import (
+	"context"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+//go:generate go tool counterfeiter -o ../fakes/fakeenvgrouprepoclient_gen.go . envGroupRepoClient
+type envGroupRepoClient interface {
+	ListEnvGroupsWithResponse(ctx context.Context, params *client.ListEnvGroupsParams, reqEditors ...client.RequestEditorFn) (*client.ListEnvGroupsResponse, error)
+	RetrieveEnvGroupWithResponse(ctx context.Context, envGroupId client.EnvGroupIdParam, reqEditors ...client.RequestEditorFn) (*client.RetrieveEnvGroupResponse, error)
+	CreateEnvGroupWithResponse(ctx context.Context, body client.CreateEnvGroupJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateEnvGroupResponse, error)
+	UpdateEnvGroupEnvVarWithResponse(ctx context.Context, envGroupId client.EnvGroupIdParam, envVarKey client.EnvVarKeyParam, body client.UpdateEnvGroupEnvVarJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateEnvGroupEnvVarResponse, error)
+	DeleteEnvGroupEnvVarWithResponse(ctx context.Context, envGroupId string, envVarKey client.EnvVarKeyParam, reqEditors ...client.RequestEditorFn) (*client.DeleteEnvGroupEnvVarResponse, error)
+	UpdateEnvGroupSecretFileWithResponse(ctx context.Context, envGroupId string, secretFileName string, body client.UpdateEnvGroupSecretFileJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateEnvGroupSecretFileResponse, error)
+	DeleteEnvGroupSecretFileWithResponse(ctx context.Context, envGroupId client.EnvGroupIdParam, secretFileName client.SecretFileNameParam, reqEditors ...client.RequestEditorFn) (*client.DeleteEnvGroupSecretFileResponse, error)
+	LinkServiceToEnvGroupWithResponse(ctx context.Context, envGroupId client.EnvGroupIdParam, serviceId client.ServiceIdParam, reqEditors ...client.RequestEditorFn) (*client.LinkServiceToEnvGroupResponse, error)
+	UnlinkServiceFromEnvGroupWithResponse(ctx context.Context, envGroupId string, serviceId string, reqEditors ...client.RequestEditorFn) (*client.UnlinkServiceFromEnvGroupResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
+type Repo struct {
+	client envGroupRepoClient
+}
+
+func NewRepo(c envGroupRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+func (r *Repo) ListEnvGroups(ctx context.Context, params *client.ListEnvGroupsParams) ([]client.EnvGroupMeta, error) {
+	workspace, err := session.FromContext(ctx).GetWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params.OwnerId = &client.OwnerIdParam{workspace}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+// listPage lists a page of env groups. The endpoint doesn't return cursors, so the ID of the last env
+// group on a page is the cursor for the next page.
+func (r *Repo) listPage(ctx context.Context, params *client.ListEnvGroupsParams) ([]client.EnvGroupMeta, *client.Cursor, error) {
+	resp, err := r.client.ListEnvGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	envGroups := *resp.JSON200
+	cursor := envGroups[len(envGroups)-1].Id
+	// Stop rather than list the same page forever if the API doesn't move past the cursor.
+	if params.Cursor != nil && *params.Cursor == cursor {
+		return nil, nil, nil
+	}
+
+	return envGroups, &cursor, nil
+}
+
+// GetEnvGroup returns an env group, or an error if it isn't in the current workspace.
+func (r *Repo) GetEnvGroup(ctx context.Context, id string) (*client.EnvGroup, error) {
+	resp, err := r.client.RetrieveEnvGroupWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// GetService returns a service, or an error if it isn't in the current workspace. Services are looked
+// up before they are linked to or unlinked from an env group.
+func (r *Repo) GetService(ctx context.Context, serviceId string) (*client.Service, error) {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) CreateEnvGroup(ctx context.Context, input client.EnvGroupPOSTInput) (*client.EnvGroup, error) {
+	if err := validate.WorkspaceMatches(ctx, input.OwnerId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.CreateEnvGroupWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+// The methods below change an env group without checking its workspace, which should be done by
+// looking it up with GetEnvGroup first. Services being linked or unlinked should likewise be looked up
+// with GetService first.
+
+func (r *Repo) SetEnvVar(ctx context.Context, envGroupId string, key string, value string) error {
+	var input client.UpdateEnvGroupEnvVarJSONRequestBody
+	if err := input.FromEnvVarValue(client.EnvVarValue{Value: value}); err != nil {
+		return err
+	}
+
+	resp, err := r.client.UpdateEnvGroupEnvVarWithResponse(ctx, envGroupId, key, input)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) DeleteEnvVar(ctx context.Context, envGroupId string, key string) error {
+	resp, err := r.client.DeleteEnvGroupEnvVarWithResponse(ctx, envGroupId, key)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) SetSecretFile(ctx context.Context, envGroupId string, name string, content string) error {
+	resp, err := r.client.UpdateEnvGroupSecretFileWithResponse(ctx, envGroupId, name, client.UpdateEnvGroupSecretFileJSONRequestBody{
+		Content: &content,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) DeleteSecretFile(ctx context.Context, envGroupId string, name string) error {
+	resp, err := r.client.DeleteEnvGroupSecretFileWithResponse(ctx, envGroupId, name)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) LinkService(ctx context.Context, envGroupId string, serviceId string) (*client.EnvGroup, error) {
+	resp, err := r.client.LinkServiceToEnvGroupWithResponse(ctx, envGroupId, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UnlinkService(ctx context.Context, envGroupId string, serviceId string) error {
+	resp, err := r.client.UnlinkServiceFromEnvGroupWithResponse(ctx, envGroupId, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
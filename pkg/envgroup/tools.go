@@ -0,0 +1,583 @@
+package envgroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	envGroupRepo := NewRepo(c)
+
+	tool, handler := listEnvGroups(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getEnvGroup(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createEnvGroup(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateEnvGroupEnvVars(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteEnvGroupEnvVar(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateEnvGroupSecretFile(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteEnvGroupSecretFile(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = linkServiceToEnvGroup(envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = unlinkServiceFromEnvGroup(envGroupRepo)
+	s.AddTool(*tool, handler)
+}
+
+func envVarsItems() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []string{"key", "value"},
+		"properties": map[string]interface{}{
+			"key": map[string]interface{}{
+				"type":        "string",
+				"description": "The name of the environment variable",
+			},
+			"value": map[string]interface{}{
+				"type":        "string",
+				"description": "The value of the environment variable",
+			},
+		},
+	}
+}
+
+func listEnvGroups(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_env_groups",
+		mcp.WithDescription("List the environment groups in your Render account, along with the services each group is linked to. "+
+			"Environment variable values and secret file contents are not included."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List environment groups",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Description("Only list environment groups with this name"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			params := &client.ListEnvGroupsParams{}
+			if name, ok, err := validate.OptionalToolParam[string](request, "name"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				params.Name = &client.NameParam{name}
+			}
+
+			envGroups, err := envGroupRepo.ListEnvGroups(ctx, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(envGroups) == 0 {
+				return mcp.NewToolResultText("No environment groups found"), nil
+			}
+
+			respJSON, err := json.Marshal(envGroups)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func getEnvGroup(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_env_group",
+		mcp.WithDescription("Retrieve an environment group by ID. Returns the keys of its environment variables, "+
+			"the names of its secret files, and the services it is linked to. Values and file contents are not included."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get environment group details",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group to retrieve"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			envGroup, err := envGroupRepo.GetEnvGroup(ctx, envGroupId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(summarize(envGroup))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createEnvGroup(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_env_group",
+		mcp.WithDescription("Create a new environment group in your Render account. "+
+			"Environment groups share environment variables and secret files across the services they are linked to."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Create environment group",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the environment group"),
+		),
+		mcp.WithArray("envVars",
+			mcp.Description("The environment variables of the group."),
+			mcp.Items(envVarsItems()),
+		),
+		mcp.WithArray("secretFiles",
+			mcp.Description("The secret files of the group. Secret files are available to linked services at runtime in /etc/secrets/<name>."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"name", "content"},
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "The name of the secret file",
+						},
+						"content": map[string]interface{}{
+							"type":        "string",
+							"description": "The contents of the secret file",
+						},
+					},
+				},
+			),
+		),
+		mcp.WithArray("serviceIds",
+			mcp.Description("The IDs of the services to link the group to."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			ownerId, err := session.FromContext(ctx).GetWorkspace(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			createParams := client.EnvGroupPOSTInput{
+				Name:    name,
+				OwnerId: ownerId,
+				EnvVars: client.EnvVarInputArray{},
+			}
+
+			if envVars, ok, err := validate.EnvVars(request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				createParams.EnvVars = envVars
+			}
+
+			if secretFiles, ok, err := validate.SecretFiles(request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				createParams.SecretFiles = &secretFiles
+			}
+
+			if serviceIds, ok, err := validate.OptionalToolArrayParam[string](request, "serviceIds"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				for _, serviceId := range serviceIds {
+					if _, err := envGroupRepo.GetService(ctx, serviceId); err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				}
+				createParams.ServiceIds = &serviceIds
+			}
+
+			envGroup, err := envGroupRepo.CreateEnvGroup(ctx, createParams)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(summarize(envGroup))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func updateEnvGroupEnvVars(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_env_group_env_vars",
+		mcp.WithDescription("Update the environment variables of an environment group. "+
+			"By default, environment variables passed in will be merged with the group's "+
+			"existing environment variables. This makes it safe to update environment variables "+
+			"without pulling the existing ones into the MCP host's context. "+
+			"To replace all existing environment variables, set the 'replace' parameter to 'true'. "+
+			"The result lists the keys that were added, updated and deleted, and the services linked to the group, "+
+			"which pick up the changes on their next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update environment group variables",
+			DestructiveHint: pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group to update"),
+		),
+		mcp.WithBoolean("replace",
+			mcp.Description("Whether to replace all existing environment variables with the "+
+				"provided list, or merge with the existing ones. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		mcp.WithArray("envVars",
+			mcp.Required(),
+			mcp.Description("The list of environment variables to update or set for the environment group."),
+			mcp.Items(envVarsItems()),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var envVars []client.EnvVarInput
+			var ok bool
+			if envVars, ok, err = validate.EnvVars(request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("Environment variables are required"), nil
+			}
+
+			replace, _, err := validate.OptionalToolParam[bool](request, "replace")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			envGroup, err := envGroupRepo.GetEnvGroup(ctx, envGroupId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			toSet, update, err := planEnvVarUpdate(envGroup.EnvVars, envVars, replace)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			update.LinkedServices = summarize(envGroup).LinkedServices
+
+			// Each variable is set with its own request, so track what has been applied in case a
+			// later request fails.
+			applied := &EnvVarUpdate{
+				Added:          []string{},
+				Updated:        []string{},
+				Deleted:        []string{},
+				LinkedServices: update.LinkedServices,
+			}
+			for _, key := range update.Added {
+				if err := envGroupRepo.SetEnvVar(ctx, envGroupId, key, toSet[key]); err != nil {
+					return partialUpdateError(fmt.Sprintf("Error setting %s: %s", key, err), applied), nil
+				}
+				applied.Added = append(applied.Added, key)
+			}
+			for _, key := range update.Updated {
+				if err := envGroupRepo.SetEnvVar(ctx, envGroupId, key, toSet[key]); err != nil {
+					return partialUpdateError(fmt.Sprintf("Error setting %s: %s", key, err), applied), nil
+				}
+				applied.Updated = append(applied.Updated, key)
+			}
+			for _, key := range update.Deleted {
+				if err := envGroupRepo.DeleteEnvVar(ctx, envGroupId, key); err != nil {
+					return partialUpdateError(fmt.Sprintf("Error deleting %s: %s", key, err), applied), nil
+				}
+				applied.Deleted = append(applied.Deleted, key)
+			}
+
+			respJSON, err := json.Marshal(update)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+// partialUpdateError reports an update that failed partway through, along with the changes that were
+// applied before it failed. They aren't rolled back, so the MCP host needs to know about them.
+func partialUpdateError(message string, applied *EnvVarUpdate) *mcp.CallToolResult {
+	appliedJSON, err := json.Marshal(applied)
+	if err != nil {
+		return mcp.NewToolResultError(message)
+	}
+	return mcp.NewToolResultError(fmt.Sprintf("%s. These changes were applied before the error and were not rolled back: %s", message, appliedJSON))
+}
+
+func deleteEnvGroupEnvVar(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_env_group_env_var",
+		mcp.WithDescription("Delete an environment variable from an environment group. "+
+			"Services linked to the group stop receiving it on their next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete environment group variable",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group"),
+		),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description("The name of the environment variable to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			key, err := validate.RequiredToolParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetEnvGroup(ctx, envGroupId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := envGroupRepo.DeleteEnvVar(ctx, envGroupId, key); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Environment variable %s has been deleted from environment group %s", key, envGroupId)), nil
+		}
+}
+
+func updateEnvGroupSecretFile(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_env_group_secret_file",
+		mcp.WithDescription("Create or replace a secret file in an environment group. "+
+			"Services linked to the group can read it at /etc/secrets/<name> after their next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update environment group secret file",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the secret file"),
+		),
+		mcp.WithString("content",
+			mcp.Required(),
+			mcp.Description("The contents of the secret file"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			content, err := validate.RequiredToolParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetEnvGroup(ctx, envGroupId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := envGroupRepo.SetSecretFile(ctx, envGroupId, name, content); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Secret file %s has been saved to environment group %s", name, envGroupId)), nil
+		}
+}
+
+func deleteEnvGroupSecretFile(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_env_group_secret_file",
+		mcp.WithDescription("Delete a secret file from an environment group. "+
+			"Services linked to the group stop receiving it on their next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete environment group secret file",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the secret file to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetEnvGroup(ctx, envGroupId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := envGroupRepo.DeleteSecretFile(ctx, envGroupId, name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Secret file %s has been deleted from environment group %s", name, envGroupId)), nil
+		}
+}
+
+func linkServiceToEnvGroup(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("link_service_to_env_group",
+		mcp.WithDescription("Link a service to an environment group, so that the service receives the group's environment variables "+
+			"and secret files on its next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Link service to environment group",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group"),
+		),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to link"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetEnvGroup(ctx, envGroupId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			envGroup, err := envGroupRepo.LinkService(ctx, envGroupId, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(summarize(envGroup))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func unlinkServiceFromEnvGroup(envGroupRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("unlink_service_from_env_group",
+		mcp.WithDescription("Unlink a service from an environment group. The service stops receiving the group's environment variables "+
+			"and secret files on its next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Unlink service from environment group",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("envGroupId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment group"),
+		),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to unlink"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			envGroupId, err := validate.RequiredToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetEnvGroup(ctx, envGroupId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := envGroupRepo.GetService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := envGroupRepo.UnlinkService(ctx, envGroupId, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s has been unlinked from environment group %s", serviceId, envGroupId)), nil
+		}
+}
@@ -0,0 +1,329 @@
+package envgroup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEnvGroupEnvVarsTool(t *testing.T) {
+	tests := []struct {
+		name           string
+		ownerId        string
+		replace        bool
+		envVars        []interface{}
+		expectedSet    map[string]string
+		expectedDelete []string
+		expectedUpdate EnvVarUpdate
+		expectedError  string
+	}{
+		{
+			name:    "Merges with the existing environment variables",
+			ownerId: "own-123",
+			envVars: []interface{}{
+				envVarParam("API_URL", "https://api.example.com"),
+				envVarParam("LOG_LEVEL", "info"),
+				envVarParam("NEW_KEY", "new"),
+			},
+			expectedSet: map[string]string{
+				"LOG_LEVEL": "info",
+				"NEW_KEY":   "new",
+			},
+			expectedUpdate: EnvVarUpdate{
+				Added:   []string{"NEW_KEY"},
+				Updated: []string{"LOG_LEVEL"},
+				Deleted: []string{},
+			},
+		},
+		{
+			name:    "Replaces the existing environment variables",
+			ownerId: "own-123",
+			replace: true,
+			envVars: []interface{}{
+				envVarParam("API_URL", "https://api.example.com"),
+			},
+			expectedSet:    map[string]string{},
+			expectedDelete: []string{"LOG_LEVEL", "SECRET_TOKEN"},
+			expectedUpdate: EnvVarUpdate{
+				Added:   []string{},
+				Updated: []string{},
+				Deleted: []string{"LOG_LEVEL", "SECRET_TOKEN"},
+			},
+		},
+		{
+			name:    "Rejects env groups outside the current workspace",
+			ownerId: "own-other",
+			envVars: []interface{}{
+				envVarParam("LOG_LEVEL", "info"),
+			},
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeEnvGroupRepoClient{}
+			fakeClient.RetrieveEnvGroupWithResponseReturns(envGroupResponse(tt.ownerId), nil)
+			fakeClient.UpdateEnvGroupEnvVarWithResponseReturns(&client.UpdateEnvGroupEnvVarResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.DeleteEnvGroupEnvVarWithResponseReturns(&client.DeleteEnvGroupEnvVarResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"envGroupId": "evg-123",
+				"replace":    tt.replace,
+				"envVars":    tt.envVars,
+			}
+
+			_, handler := updateEnvGroupEnvVars(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.UpdateEnvGroupEnvVarWithResponseCallCount())
+				assert.Equal(t, 0, fakeClient.DeleteEnvGroupEnvVarWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.NotContains(t, text, "https://api.example.com")
+			assert.NotContains(t, text, "info")
+
+			set := map[string]string{}
+			for i := 0; i < fakeClient.UpdateEnvGroupEnvVarWithResponseCallCount(); i++ {
+				envGroupId, key, body := updateEnvVarArgs(t, fakeClient, i)
+				assert.Equal(t, "evg-123", envGroupId)
+				set[key] = body
+			}
+			assert.Equal(t, tt.expectedSet, set)
+
+			var deleted []string
+			for i := 0; i < fakeClient.DeleteEnvGroupEnvVarWithResponseCallCount(); i++ {
+				_, envGroupId, key, _ := fakeClient.DeleteEnvGroupEnvVarWithResponseArgsForCall(i)
+				assert.Equal(t, "evg-123", envGroupId)
+				deleted = append(deleted, key)
+			}
+			assert.Equal(t, tt.expectedDelete, deleted)
+
+			var update EnvVarUpdate
+			require.NoError(t, json.Unmarshal([]byte(text), &update))
+			tt.expectedUpdate.LinkedServices = []client.EnvGroupLink{{Id: "srv-123", Name: "api", Type: client.Web}}
+			assert.Equal(t, tt.expectedUpdate, update)
+		})
+	}
+}
+
+func TestUpdateEnvGroupEnvVarsToolReportsPartialUpdates(t *testing.T) {
+	fakeClient := &fakes.FakeEnvGroupRepoClient{}
+	fakeClient.RetrieveEnvGroupWithResponseReturns(envGroupResponse("own-123"), nil)
+	fakeClient.UpdateEnvGroupEnvVarWithResponseReturnsOnCall(0, &client.UpdateEnvGroupEnvVarResponse{
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.UpdateEnvGroupEnvVarWithResponseReturnsOnCall(1, &client.UpdateEnvGroupEnvVarResponse{
+		HTTPResponse: &http.Response{StatusCode: 500},
+	}, nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"envGroupId": "evg-123",
+		"envVars": []interface{}{
+			envVarParam("LOG_LEVEL", "info"),
+			envVarParam("NEW_KEY", "new"),
+		},
+	}
+
+	_, handler := updateEnvGroupEnvVars(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	text := result.Content[0].(mcp.TextContent).Text
+	assert.Contains(t, text, "Error setting LOG_LEVEL")
+	assert.Contains(t, text, `"added":["NEW_KEY"],"updated":[],"deleted":[]`)
+	assert.Equal(t, 2, fakeClient.UpdateEnvGroupEnvVarWithResponseCallCount())
+}
+
+func TestListEnvGroupsToolPages(t *testing.T) {
+	firstPage := make([]client.EnvGroupMeta, 100)
+	for i := range firstPage {
+		firstPage[i] = client.EnvGroupMeta{Id: fmt.Sprintf("evg-%03d", i), OwnerId: "own-123"}
+	}
+	secondPage := []client.EnvGroupMeta{{Id: "evg-100", OwnerId: "own-123"}}
+
+	fakeClient := &fakes.FakeEnvGroupRepoClient{}
+	fakeClient.ListEnvGroupsWithResponseReturnsOnCall(0, &client.ListEnvGroupsResponse{
+		JSON200:      &firstPage,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListEnvGroupsWithResponseReturnsOnCall(1, &client.ListEnvGroupsResponse{
+		JSON200:      &secondPage,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := listEnvGroups(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var envGroups []client.EnvGroupMeta
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &envGroups))
+	assert.Len(t, envGroups, 101)
+
+	require.Equal(t, 2, fakeClient.ListEnvGroupsWithResponseCallCount())
+	_, params, _ := fakeClient.ListEnvGroupsWithResponseArgsForCall(1)
+	assert.Equal(t, "evg-099", *params.Cursor)
+	assert.Equal(t, client.OwnerIdParam{"own-123"}, *params.OwnerId)
+}
+
+func TestGetEnvGroupToolOmitsSecrets(t *testing.T) {
+	fakeClient := &fakes.FakeEnvGroupRepoClient{}
+	fakeClient.RetrieveEnvGroupWithResponseReturns(envGroupResponse("own-123"), nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"envGroupId": "evg-123",
+	}
+
+	_, handler := getEnvGroup(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var summary EnvGroupSummary
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &summary))
+	assert.Equal(t, []string{"API_URL", "LOG_LEVEL", "SECRET_TOKEN"}, summary.EnvVarKeys)
+	assert.Equal(t, []string{"credentials.json"}, summary.SecretFileNames)
+	assert.Equal(t, "srv-123", summary.LinkedServices[0].Id)
+	assert.NotContains(t, result.Content[0].(mcp.TextContent).Text, "hunter2")
+	assert.NotContains(t, result.Content[0].(mcp.TextContent).Text, "private_key")
+}
+
+func TestLinkServiceToolsCheckServiceWorkspace(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceOwnerId string
+		expectError    bool
+	}{
+		{
+			name:           "Links a service in the workspace",
+			serviceOwnerId: "own-123",
+		},
+		{
+			name:           "Refuses a service in another workspace",
+			serviceOwnerId: "own-other",
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeEnvGroupRepoClient{}
+			fakeClient.RetrieveEnvGroupWithResponseReturns(envGroupResponse("own-123"), nil)
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      &client.Service{Id: "srv-456", OwnerId: tt.serviceOwnerId},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.LinkServiceToEnvGroupWithResponseReturns(&client.LinkServiceToEnvGroupResponse{
+				JSON200:      envGroupResponse("own-123").JSON200,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.UnlinkServiceFromEnvGroupWithResponseReturns(&client.UnlinkServiceFromEnvGroupResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"envGroupId": "evg-123",
+				"serviceId":  "srv-456",
+			}
+
+			repo := NewRepo(fakeClient)
+			_, link := linkServiceToEnvGroup(repo)
+			_, unlink := unlinkServiceFromEnvGroup(repo)
+			for _, handler := range []func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error){link, unlink} {
+				result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectError, result.IsError, result.Content)
+			}
+
+			_, serviceId, _ := fakeClient.RetrieveServiceWithResponseArgsForCall(0)
+			assert.Equal(t, "srv-456", serviceId)
+			if tt.expectError {
+				assert.Equal(t, 0, fakeClient.LinkServiceToEnvGroupWithResponseCallCount())
+				assert.Equal(t, 0, fakeClient.UnlinkServiceFromEnvGroupWithResponseCallCount())
+			} else {
+				assert.Equal(t, 1, fakeClient.LinkServiceToEnvGroupWithResponseCallCount())
+				assert.Equal(t, 1, fakeClient.UnlinkServiceFromEnvGroupWithResponseCallCount())
+			}
+		})
+	}
+}
+
+func TestCreateEnvGroupToolChecksServiceWorkspace(t *testing.T) {
+	fakeClient := &fakes.FakeEnvGroupRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200:      &client.Service{Id: "srv-456", OwnerId: "own-other"},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"name":       "shared",
+		"serviceIds": []interface{}{"srv-456"},
+	}
+
+	_, handler := createEnvGroup(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, 1, fakeClient.RetrieveServiceWithResponseCallCount())
+	assert.Equal(t, 0, fakeClient.CreateEnvGroupWithResponseCallCount())
+}
+
+func envGroupResponse(ownerId string) *client.RetrieveEnvGroupResponse {
+	return &client.RetrieveEnvGroupResponse{
+		JSON200: &client.EnvGroup{
+			Id:      "evg-123",
+			Name:    "shared",
+			OwnerId: ownerId,
+			EnvVars: []client.EnvVar{
+				{Key: "API_URL", Value: "https://api.example.com"},
+				{Key: "LOG_LEVEL", Value: "debug"},
+				{Key: "SECRET_TOKEN", Value: "hunter2"},
+			},
+			SecretFiles: []client.SecretFile{
+				{Name: "credentials.json", Content: `{"private_key": "..."}`},
+			},
+			ServiceLinks: []client.EnvGroupLink{
+				{Id: "srv-123", Name: "api", Type: client.Web},
+			},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}
+}
+
+func envVarParam(key, value string) map[string]interface{} {
+	return map[string]interface{}{"key": key, "value": value}
+}
+
+func updateEnvVarArgs(t *testing.T, fakeClient *fakes.FakeEnvGroupRepoClient, i int) (string, string, string) {
+	_, envGroupId, key, body, _ := fakeClient.UpdateEnvGroupEnvVarWithResponseArgsForCall(i)
+	value, err := body.AsEnvVarValue()
+	require.NoError(t, err)
+	return envGroupId, key, value.Value
+}
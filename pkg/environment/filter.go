@@ -0,0 +1,64 @@
+package environment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+// WithProjectFilter adds an optional projectId param to a list tool. Use it with
+// WithEnvironmentFilter and resolve both with EnvironmentFilter.
+func WithProjectFilter() mcp.ToolOption {
+	return mcp.WithString("projectId",
+		mcp.Description("Only list resources in the environments of this project"),
+	)
+}
+
+// WithEnvironmentFilter adds an optional environmentId param to a list tool.
+func WithEnvironmentFilter() mcp.ToolOption {
+	return mcp.WithString("environmentId",
+		mcp.Description("Only list resources in this environment"),
+	)
+}
+
+// EnvironmentFilter returns the environment IDs a list tool should filter by, based on its optional
+// projectId and environmentId params. The list endpoints only filter by environment, so a project is
+// expanded to its environments. ok is false if neither param is set. When ok is true and no IDs are
+// returned, the project has no environments and the list should be empty.
+func (e *Repo) EnvironmentFilter(ctx context.Context, request mcp.CallToolRequest) ([]string, bool, error) {
+	projectId, hasProject, err := validate.OptionalToolParam[string](request, "projectId")
+	if err != nil {
+		return nil, false, err
+	}
+
+	environmentId, hasEnvironment, err := validate.OptionalToolParam[string](request, "environmentId")
+	if err != nil {
+		return nil, false, err
+	}
+
+	if hasEnvironment {
+		env, err := e.GetEnvironmentInWorkspace(ctx, environmentId)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if hasProject && env.ProjectId != projectId {
+			return nil, false, fmt.Errorf("environment %s does not belong to project %s", environmentId, projectId)
+		}
+
+		return []string{environmentId}, true, nil
+	}
+
+	if hasProject {
+		project, err := e.GetProject(ctx, projectId)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return project.EnvironmentIds, true, nil
+	}
+
+	return nil, false, nil
+}
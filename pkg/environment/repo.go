@@ -5,16 +5,104 @@ import (
 	"fmt"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
 )
 
+//go:generate go tool counterfeiter -o ../fakes/fakeenvironmentrepoclient_gen.go . environmentRepoClient
+type environmentRepoClient interface {
+	ListProjectsWithResponse(ctx context.Context, params *client.ListProjectsParams, reqEditors ...client.RequestEditorFn) (*client.ListProjectsResponse, error)
+	RetrieveProjectWithResponse(ctx context.Context, projectId string, reqEditors ...client.RequestEditorFn) (*client.RetrieveProjectResponse, error)
+	CreateProjectWithResponse(ctx context.Context, body client.CreateProjectJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateProjectResponse, error)
+	ListEnvironmentsWithResponse(ctx context.Context, params *client.ListEnvironmentsParams, reqEditors ...client.RequestEditorFn) (*client.ListEnvironmentsResponse, error)
+	RetrieveEnvironmentWithResponse(ctx context.Context, environmentId string, reqEditors ...client.RequestEditorFn) (*client.RetrieveEnvironmentResponse, error)
+	CreateEnvironmentWithResponse(ctx context.Context, body client.CreateEnvironmentJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateEnvironmentResponse, error)
+	AddResourcesToEnvironmentWithResponse(ctx context.Context, environmentId string, body client.AddResourcesToEnvironmentJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AddResourcesToEnvironmentResponse, error)
+	RemoveResourcesFromEnvironmentWithResponse(ctx context.Context, environmentId string, params *client.RemoveResourcesFromEnvironmentParams, reqEditors ...client.RequestEditorFn) (*client.RemoveResourcesFromEnvironmentResponse, error)
+}
+
+// Repo calls the project and environment API. Methods that change an environment don't check that
+// its project belongs to the current workspace, so callers must look it up with GetProject or
+// GetEnvironmentInWorkspace first.
 type Repo struct {
-	client *client.ClientWithResponses
+	client environmentRepoClient
 }
 
-func NewRepo(client *client.ClientWithResponses) *Repo {
+func NewRepo(client environmentRepoClient) *Repo {
 	return &Repo{client: client}
 }
 
+func (e *Repo) ListProjects(ctx context.Context, params *client.ListProjectsParams) ([]*client.Project, error) {
+	workspace, err := session.FromContext(ctx).GetWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params.OwnerId = &client.OwnerIdParam{workspace}
+
+	return client.ListAll(ctx, params, e.listProjectsPage)
+}
+
+func (e *Repo) listProjectsPage(ctx context.Context, params *client.ListProjectsParams) ([]*client.Project, *client.Cursor, error) {
+	resp, err := e.client.ListProjectsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	projects := make([]*client.Project, 0, len(res))
+	for _, projectWithCursor := range res {
+		projects = append(projects, &projectWithCursor.Project)
+	}
+
+	return projects, &res[len(res)-1].Cursor, nil
+}
+
+// GetProject retrieves a project by ID after checking that it belongs to the current workspace.
+func (e *Repo) GetProject(ctx context.Context, id string) (*client.Project, error) {
+	resp, err := e.client.RetrieveProjectWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.Owner.Id); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (e *Repo) CreateProject(ctx context.Context, input client.ProjectPOSTInput) (*client.Project, error) {
+	workspace, err := session.FromContext(ctx).GetWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input.OwnerId = workspace
+
+	resp, err := e.client.CreateProjectWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
 // GetEnvironment retrieves an environment by ID.
 // Note: We are not checking the workspace here because we currently only call this is from contexts
 // where we've pulled the environment ID from a resource that was already checked. If this changes, we should
@@ -32,6 +120,21 @@ func (e *Repo) GetEnvironment(ctx context.Context, id string) (*client.Environme
 	return resp.JSON200, nil
 }
 
+// GetEnvironmentInWorkspace retrieves an environment by ID after checking that its project belongs
+// to the current workspace.
+func (e *Repo) GetEnvironmentInWorkspace(ctx context.Context, id string) (*client.Environment, error) {
+	env, err := e.GetEnvironment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := e.GetProject(ctx, env.ProjectId); err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
 func (e *Repo) ListEnvironments(ctx context.Context, params *client.ListEnvironmentsParams) ([]*client.Environment, error) {
 	return client.ListAll(ctx, params, e.listPage)
 }
@@ -57,3 +160,42 @@ func (e *Repo) listPage(ctx context.Context, params *client.ListEnvironmentsPara
 
 	return envs, &res[len(res)-1].Cursor, nil
 }
+
+func (e *Repo) CreateEnvironment(ctx context.Context, input client.EnvironmentPOSTInput) (*client.Environment, error) {
+	resp, err := e.client.CreateEnvironmentWithResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (e *Repo) AddResourcesToEnvironment(ctx context.Context, id string, resourceIds []string) (*client.Environment, error) {
+	resp, err := e.client.AddResourcesToEnvironmentWithResponse(ctx, id, client.EnvironmentResourcesPOSTInput{
+		ResourceIds: resourceIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (e *Repo) RemoveResourcesFromEnvironment(ctx context.Context, id string, resourceIds []string) error {
+	resp, err := e.client.RemoveResourcesFromEnvironmentWithResponse(ctx, id, &client.RemoveResourcesFromEnvironmentParams{
+		ResourceIds: resourceIds,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,429 @@
+package environment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	environmentRepo := NewRepo(c)
+
+	tool, handler := listProjects(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getProject(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createProject(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listEnvironments(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createEnvironment(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = addResourcesToEnvironment(environmentRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = removeResourcesFromEnvironment(environmentRepo)
+	s.AddTool(*tool, handler)
+}
+
+// ProjectWithEnvironments is a project along with its environments, which the API only returns IDs for.
+type ProjectWithEnvironments struct {
+	*client.Project
+	Environments []*client.Environment `json:"environments"`
+}
+
+func withResourceIds(description string) mcp.ToolOption {
+	return mcp.WithArray("resourceIds",
+		mcp.Required(),
+		mcp.Description(description),
+		mcp.Items(map[string]interface{}{
+			"type": "string",
+		}),
+	)
+}
+
+func resourceIdsParam(request mcp.CallToolRequest) ([]string, error) {
+	resourceIds, err := validate.RequiredToolArrayParam[string](request, "resourceIds")
+	if err != nil {
+		return nil, err
+	}
+	if len(resourceIds) == 0 {
+		return nil, errors.New("resourceIds must contain at least one resource ID")
+	}
+	return resourceIds, nil
+}
+
+func environmentSettings(request mcp.CallToolRequest) (*client.ProtectedStatus, *client.NetworkIsolationEnabled, error) {
+	var protectedStatus *client.ProtectedStatus
+	if status, ok, err := validate.OptionalToolParam[string](request, "protectedStatus"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		protectedStatus = pointers.From(client.ProtectedStatus(status))
+		if *protectedStatus != client.Protected && *protectedStatus != client.Unprotected {
+			return nil, nil, fmt.Errorf("invalid protectedStatus %q, must be protected or unprotected", status)
+		}
+	}
+
+	var networkIsolationEnabled *client.NetworkIsolationEnabled
+	if enabled, ok, err := validate.OptionalToolParam[bool](request, "networkIsolationEnabled"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		networkIsolationEnabled = &enabled
+	}
+
+	return protectedStatus, networkIsolationEnabled, nil
+}
+
+func listProjects(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_projects",
+		mcp.WithDescription("List the projects in your Render workspace. A project groups related services, "+
+			"databases and environment groups into environments, such as staging and production."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List projects",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Description("Only list projects with this name"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			params := &client.ListProjectsParams{}
+			if name, ok, err := validate.OptionalToolParam[string](request, "name"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				params.Name = &client.NameParam{name}
+			}
+
+			projects, err := environmentRepo.ListProjects(ctx, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(projects) == 0 {
+				return mcp.NewToolResultText("No projects found"), nil
+			}
+
+			respJSON, err := json.Marshal(projects)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func getProject(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_project",
+		mcp.WithDescription("Get a project along with its environments and the IDs of the resources in each."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get project",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("projectId",
+			mcp.Required(),
+			mcp.Description("The ID of the project"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectId, err := validate.RequiredToolParam[string](request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			project, err := environmentRepo.GetProject(ctx, projectId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			environments, err := environmentRepo.ListEnvironments(ctx, &client.ListEnvironmentsParams{
+				ProjectId: client.ProjectIdParam{projectId},
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(ProjectWithEnvironments{
+				Project:      project,
+				Environments: environments,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createProject(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_project",
+		mcp.WithDescription("Create a new project in your Render workspace along with its environments."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Create project",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the project"),
+		),
+		mcp.WithArray("environmentNames",
+			mcp.Required(),
+			mcp.Description("The names of the environments to create in the project, for example staging and production. "+
+				"At least one is required."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			environmentNames, err := validate.RequiredToolArrayParam[string](request, "environmentNames")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(environmentNames) == 0 {
+				return mcp.NewToolResultError("environmentNames must contain at least one environment name"), nil
+			}
+
+			environments := make([]client.ProjectPOSTEnvironmentInput, 0, len(environmentNames))
+			for _, environmentName := range environmentNames {
+				environments = append(environments, client.ProjectPOSTEnvironmentInput{Name: environmentName})
+			}
+
+			project, err := environmentRepo.CreateProject(ctx, client.ProjectPOSTInput{
+				Name:         name,
+				Environments: environments,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(project)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func listEnvironments(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_environments",
+		mcp.WithDescription("List the environments of a project, with the IDs of the services, databases, "+
+			"Key Value instances and environment groups in each."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List environments",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("projectId",
+			mcp.Required(),
+			mcp.Description("The ID of the project"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectId, err := validate.RequiredToolParam[string](request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := environmentRepo.GetProject(ctx, projectId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			environments, err := environmentRepo.ListEnvironments(ctx, &client.ListEnvironmentsParams{
+				ProjectId: client.ProjectIdParam{projectId},
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(environments) == 0 {
+				return mcp.NewToolResultText("No environments found"), nil
+			}
+
+			respJSON, err := json.Marshal(environments)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createEnvironment(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_environment",
+		mcp.WithDescription("Create a new environment in a project."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Create environment",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("projectId",
+			mcp.Required(),
+			mcp.Description("The ID of the project to create the environment in"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the environment"),
+		),
+		mcp.WithString("protectedStatus",
+			mcp.Description("Whether the environment is protected. Only admins can perform destructive actions "+
+				"on resources in a protected environment. Defaults to unprotected."),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.Protected, client.Unprotected)...),
+		),
+		mcp.WithBoolean("networkIsolationEnabled",
+			mcp.Description("Whether to block private network connections between this environment and the "+
+				"project's other environments. Defaults to false."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			projectId, err := validate.RequiredToolParam[string](request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			protectedStatus, networkIsolationEnabled, err := environmentSettings(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := environmentRepo.GetProject(ctx, projectId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			environment, err := environmentRepo.CreateEnvironment(ctx, client.EnvironmentPOSTInput{
+				ProjectId:               projectId,
+				Name:                    name,
+				ProtectedStatus:         protectedStatus,
+				NetworkIsolationEnabled: networkIsolationEnabled,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(environment)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func addResourcesToEnvironment(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("add_resources_to_environment",
+		mcp.WithDescription("Add services, databases, Key Value instances or environment groups to an environment."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Add resources to environment",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("environmentId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment"),
+		),
+		withResourceIds("The IDs of the resources to add to the environment"),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			environmentId, err := validate.RequiredToolParam[string](request, "environmentId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			resourceIds, err := resourceIdsParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := environmentRepo.GetEnvironmentInWorkspace(ctx, environmentId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			environment, err := environmentRepo.AddResourcesToEnvironment(ctx, environmentId, resourceIds)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(environment)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func removeResourcesFromEnvironment(environmentRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("remove_resources_from_environment",
+		mcp.WithDescription("Remove resources from an environment. The resources themselves are not deleted, "+
+			"they just no longer belong to a project."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Remove resources from environment",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("environmentId",
+			mcp.Required(),
+			mcp.Description("The ID of the environment"),
+		),
+		withResourceIds("The IDs of the resources to remove from the environment"),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			environmentId, err := validate.RequiredToolParam[string](request, "environmentId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			resourceIds, err := resourceIdsParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := environmentRepo.GetEnvironmentInWorkspace(ctx, environmentId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := environmentRepo.RemoveResourcesFromEnvironment(ctx, environmentId, resourceIds); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %d resources from environment %s", len(resourceIds), environmentId)), nil
+		}
+}
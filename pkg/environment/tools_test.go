@@ -0,0 +1,186 @@
+package environment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentFilter(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		ok          bool
+		ids         []string
+		errContains string
+	}{
+		{
+			name: "no filters",
+			args: map[string]interface{}{},
+		},
+		{
+			name: "project",
+			args: map[string]interface{}{"projectId": "prj-123"},
+			ok:   true,
+			ids:  []string{"evm-1", "evm-2"},
+		},
+		{
+			name: "environment",
+			args: map[string]interface{}{"environmentId": "evm-1"},
+			ok:   true,
+			ids:  []string{"evm-1"},
+		},
+		{
+			name: "environment in project",
+			args: map[string]interface{}{"projectId": "prj-123", "environmentId": "evm-1"},
+			ok:   true,
+			ids:  []string{"evm-1"},
+		},
+		{
+			name:        "environment in another project",
+			args:        map[string]interface{}{"projectId": "prj-456", "environmentId": "evm-1"},
+			errContains: "does not belong to project prj-456",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewRepo(fakeClientWithProject("own-123"))
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+
+			ids, ok, err := repo.EnvironmentFilter(session.ContextWithTestWorkspace(t, "own-123"), request)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func TestEnvironmentFilterChecksWorkspace(t *testing.T) {
+	repo := NewRepo(fakeClientWithProject("own-456"))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"environmentId": "evm-1"}
+
+	_, _, err := repo.EnvironmentFilter(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "resource in workspace does not match")
+}
+
+func TestGetProjectTool(t *testing.T) {
+	fakeClient := fakeClientWithProject("own-123")
+	fakeClient.ListEnvironmentsWithResponseReturnsOnCall(0, &client.ListEnvironmentsResponse{
+		JSON200: &[]client.EnvironmentWithCursor{
+			{Cursor: "c1", Environment: client.Environment{Id: "evm-1", Name: "staging", ProjectId: "prj-123", ServiceIds: []string{"srv-1"}}},
+			{Cursor: "c2", Environment: client.Environment{Id: "evm-2", Name: "production", ProjectId: "prj-123"}},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListEnvironmentsWithResponseReturnsOnCall(1, &client.ListEnvironmentsResponse{
+		JSON200:      &[]client.EnvironmentWithCursor{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := getProject(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"projectId": "prj-123"}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var project ProjectWithEnvironments
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &project))
+	assert.Equal(t, "prj-123", project.Id)
+	require.Len(t, project.Environments, 2)
+	assert.Equal(t, []string{"srv-1"}, project.Environments[0].ServiceIds)
+
+	_, params, _ := fakeClient.ListEnvironmentsWithResponseArgsForCall(0)
+	assert.Equal(t, client.ProjectIdParam{"prj-123"}, params.ProjectId)
+}
+
+func TestCreateProjectTool(t *testing.T) {
+	fakeClient := &fakes.FakeEnvironmentRepoClient{}
+	fakeClient.CreateProjectWithResponseReturns(&client.CreateProjectResponse{
+		JSON201:      &client.Project{Id: "prj-123", Name: "shop"},
+		HTTPResponse: &http.Response{StatusCode: 201},
+	}, nil)
+
+	_, handler := createProject(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"name":             "shop",
+		"environmentNames": []interface{}{"staging", "production"},
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	require.Equal(t, 1, fakeClient.CreateProjectWithResponseCallCount())
+	_, body, _ := fakeClient.CreateProjectWithResponseArgsForCall(0)
+	assert.Equal(t, client.ProjectPOSTInput{
+		Name:    "shop",
+		OwnerId: "own-123",
+		Environments: []client.ProjectPOSTEnvironmentInput{
+			{Name: "staging"},
+			{Name: "production"},
+		},
+	}, body)
+}
+
+func TestRemoveResourcesFromEnvironmentTool(t *testing.T) {
+	fakeClient := fakeClientWithProject("own-123")
+	fakeClient.RemoveResourcesFromEnvironmentWithResponseReturns(&client.RemoveResourcesFromEnvironmentResponse{
+		HTTPResponse: &http.Response{StatusCode: 204},
+	}, nil)
+
+	_, handler := removeResourcesFromEnvironment(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"environmentId": "evm-1",
+		"resourceIds":   []interface{}{"srv-1", "dpg-1"},
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	require.Equal(t, 1, fakeClient.RemoveResourcesFromEnvironmentWithResponseCallCount())
+	_, environmentId, params, _ := fakeClient.RemoveResourcesFromEnvironmentWithResponseArgsForCall(0)
+	assert.Equal(t, "evm-1", environmentId)
+	assert.Equal(t, []string{"srv-1", "dpg-1"}, params.ResourceIds)
+}
+
+func fakeClientWithProject(ownerId string) *fakes.FakeEnvironmentRepoClient {
+	fakeClient := &fakes.FakeEnvironmentRepoClient{}
+	fakeClient.RetrieveProjectWithResponseCalls(func(_ context.Context, projectId string, _ ...client.RequestEditorFn) (*client.RetrieveProjectResponse, error) {
+		return &client.RetrieveProjectResponse{
+			JSON200: &client.Project{
+				Id:             projectId,
+				Owner:          client.Owner{Id: ownerId},
+				EnvironmentIds: []string{"evm-1", "evm-2"},
+			},
+			HTTPResponse: &http.Response{StatusCode: 200},
+		}, nil
+	})
+	fakeClient.RetrieveEnvironmentWithResponseReturns(&client.RetrieveEnvironmentResponse{
+		JSON200:      &client.Environment{Id: "evm-1", ProjectId: "prj-123"},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
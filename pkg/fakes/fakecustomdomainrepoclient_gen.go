@@ -0,0 +1,544 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeCustomDomainRepoClient struct {
+	CreateCustomDomainWithResponseStub        func(context.Context, string, client.CreateCustomDomainJSONRequestBody, ...client.RequestEditorFn) (*client.CreateCustomDomainResponse, error)
+	createCustomDomainWithResponseMutex       sync.RWMutex
+	createCustomDomainWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.CreateCustomDomainJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	createCustomDomainWithResponseReturns struct {
+		result1 *client.CreateCustomDomainResponse
+		result2 error
+	}
+	createCustomDomainWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateCustomDomainResponse
+		result2 error
+	}
+	DeleteCustomDomainWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteCustomDomainResponse, error)
+	deleteCustomDomainWithResponseMutex       sync.RWMutex
+	deleteCustomDomainWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	deleteCustomDomainWithResponseReturns struct {
+		result1 *client.DeleteCustomDomainResponse
+		result2 error
+	}
+	deleteCustomDomainWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteCustomDomainResponse
+		result2 error
+	}
+	ListCustomDomainsWithResponseStub        func(context.Context, string, *client.ListCustomDomainsParams, ...client.RequestEditorFn) (*client.ListCustomDomainsResponse, error)
+	listCustomDomainsWithResponseMutex       sync.RWMutex
+	listCustomDomainsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListCustomDomainsParams
+		arg4 []client.RequestEditorFn
+	}
+	listCustomDomainsWithResponseReturns struct {
+		result1 *client.ListCustomDomainsResponse
+		result2 error
+	}
+	listCustomDomainsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListCustomDomainsResponse
+		result2 error
+	}
+	RefreshCustomDomainWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.RefreshCustomDomainResponse, error)
+	refreshCustomDomainWithResponseMutex       sync.RWMutex
+	refreshCustomDomainWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	refreshCustomDomainWithResponseReturns struct {
+		result1 *client.RefreshCustomDomainResponse
+		result2 error
+	}
+	refreshCustomDomainWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RefreshCustomDomainResponse
+		result2 error
+	}
+	RetrieveCustomDomainWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveCustomDomainResponse, error)
+	retrieveCustomDomainWithResponseMutex       sync.RWMutex
+	retrieveCustomDomainWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	retrieveCustomDomainWithResponseReturns struct {
+		result1 *client.RetrieveCustomDomainResponse
+		result2 error
+	}
+	retrieveCustomDomainWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveCustomDomainResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponse(arg1 context.Context, arg2 string, arg3 client.CreateCustomDomainJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.CreateCustomDomainResponse, error) {
+	fake.createCustomDomainWithResponseMutex.Lock()
+	ret, specificReturn := fake.createCustomDomainWithResponseReturnsOnCall[len(fake.createCustomDomainWithResponseArgsForCall)]
+	fake.createCustomDomainWithResponseArgsForCall = append(fake.createCustomDomainWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.CreateCustomDomainJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateCustomDomainWithResponseStub
+	fakeReturns := fake.createCustomDomainWithResponseReturns
+	fake.recordInvocation("CreateCustomDomainWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createCustomDomainWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponseCallCount() int {
+	fake.createCustomDomainWithResponseMutex.RLock()
+	defer fake.createCustomDomainWithResponseMutex.RUnlock()
+	return len(fake.createCustomDomainWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponseCalls(stub func(context.Context, string, client.CreateCustomDomainJSONRequestBody, ...client.RequestEditorFn) (*client.CreateCustomDomainResponse, error)) {
+	fake.createCustomDomainWithResponseMutex.Lock()
+	defer fake.createCustomDomainWithResponseMutex.Unlock()
+	fake.CreateCustomDomainWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponseArgsForCall(i int) (context.Context, string, client.CreateCustomDomainJSONRequestBody, []client.RequestEditorFn) {
+	fake.createCustomDomainWithResponseMutex.RLock()
+	defer fake.createCustomDomainWithResponseMutex.RUnlock()
+	argsForCall := fake.createCustomDomainWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponseReturns(result1 *client.CreateCustomDomainResponse, result2 error) {
+	fake.createCustomDomainWithResponseMutex.Lock()
+	defer fake.createCustomDomainWithResponseMutex.Unlock()
+	fake.CreateCustomDomainWithResponseStub = nil
+	fake.createCustomDomainWithResponseReturns = struct {
+		result1 *client.CreateCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) CreateCustomDomainWithResponseReturnsOnCall(i int, result1 *client.CreateCustomDomainResponse, result2 error) {
+	fake.createCustomDomainWithResponseMutex.Lock()
+	defer fake.createCustomDomainWithResponseMutex.Unlock()
+	fake.CreateCustomDomainWithResponseStub = nil
+	if fake.createCustomDomainWithResponseReturnsOnCall == nil {
+		fake.createCustomDomainWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateCustomDomainResponse
+			result2 error
+		})
+	}
+	fake.createCustomDomainWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.DeleteCustomDomainResponse, error) {
+	fake.deleteCustomDomainWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteCustomDomainWithResponseReturnsOnCall[len(fake.deleteCustomDomainWithResponseArgsForCall)]
+	fake.deleteCustomDomainWithResponseArgsForCall = append(fake.deleteCustomDomainWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteCustomDomainWithResponseStub
+	fakeReturns := fake.deleteCustomDomainWithResponseReturns
+	fake.recordInvocation("DeleteCustomDomainWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteCustomDomainWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponseCallCount() int {
+	fake.deleteCustomDomainWithResponseMutex.RLock()
+	defer fake.deleteCustomDomainWithResponseMutex.RUnlock()
+	return len(fake.deleteCustomDomainWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteCustomDomainResponse, error)) {
+	fake.deleteCustomDomainWithResponseMutex.Lock()
+	defer fake.deleteCustomDomainWithResponseMutex.Unlock()
+	fake.DeleteCustomDomainWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.deleteCustomDomainWithResponseMutex.RLock()
+	defer fake.deleteCustomDomainWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteCustomDomainWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponseReturns(result1 *client.DeleteCustomDomainResponse, result2 error) {
+	fake.deleteCustomDomainWithResponseMutex.Lock()
+	defer fake.deleteCustomDomainWithResponseMutex.Unlock()
+	fake.DeleteCustomDomainWithResponseStub = nil
+	fake.deleteCustomDomainWithResponseReturns = struct {
+		result1 *client.DeleteCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) DeleteCustomDomainWithResponseReturnsOnCall(i int, result1 *client.DeleteCustomDomainResponse, result2 error) {
+	fake.deleteCustomDomainWithResponseMutex.Lock()
+	defer fake.deleteCustomDomainWithResponseMutex.Unlock()
+	fake.DeleteCustomDomainWithResponseStub = nil
+	if fake.deleteCustomDomainWithResponseReturnsOnCall == nil {
+		fake.deleteCustomDomainWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteCustomDomainResponse
+			result2 error
+		})
+	}
+	fake.deleteCustomDomainWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponse(arg1 context.Context, arg2 string, arg3 *client.ListCustomDomainsParams, arg4 ...client.RequestEditorFn) (*client.ListCustomDomainsResponse, error) {
+	fake.listCustomDomainsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listCustomDomainsWithResponseReturnsOnCall[len(fake.listCustomDomainsWithResponseArgsForCall)]
+	fake.listCustomDomainsWithResponseArgsForCall = append(fake.listCustomDomainsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListCustomDomainsParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListCustomDomainsWithResponseStub
+	fakeReturns := fake.listCustomDomainsWithResponseReturns
+	fake.recordInvocation("ListCustomDomainsWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listCustomDomainsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponseCallCount() int {
+	fake.listCustomDomainsWithResponseMutex.RLock()
+	defer fake.listCustomDomainsWithResponseMutex.RUnlock()
+	return len(fake.listCustomDomainsWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponseCalls(stub func(context.Context, string, *client.ListCustomDomainsParams, ...client.RequestEditorFn) (*client.ListCustomDomainsResponse, error)) {
+	fake.listCustomDomainsWithResponseMutex.Lock()
+	defer fake.listCustomDomainsWithResponseMutex.Unlock()
+	fake.ListCustomDomainsWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponseArgsForCall(i int) (context.Context, string, *client.ListCustomDomainsParams, []client.RequestEditorFn) {
+	fake.listCustomDomainsWithResponseMutex.RLock()
+	defer fake.listCustomDomainsWithResponseMutex.RUnlock()
+	argsForCall := fake.listCustomDomainsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponseReturns(result1 *client.ListCustomDomainsResponse, result2 error) {
+	fake.listCustomDomainsWithResponseMutex.Lock()
+	defer fake.listCustomDomainsWithResponseMutex.Unlock()
+	fake.ListCustomDomainsWithResponseStub = nil
+	fake.listCustomDomainsWithResponseReturns = struct {
+		result1 *client.ListCustomDomainsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) ListCustomDomainsWithResponseReturnsOnCall(i int, result1 *client.ListCustomDomainsResponse, result2 error) {
+	fake.listCustomDomainsWithResponseMutex.Lock()
+	defer fake.listCustomDomainsWithResponseMutex.Unlock()
+	fake.ListCustomDomainsWithResponseStub = nil
+	if fake.listCustomDomainsWithResponseReturnsOnCall == nil {
+		fake.listCustomDomainsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListCustomDomainsResponse
+			result2 error
+		})
+	}
+	fake.listCustomDomainsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListCustomDomainsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.RefreshCustomDomainResponse, error) {
+	fake.refreshCustomDomainWithResponseMutex.Lock()
+	ret, specificReturn := fake.refreshCustomDomainWithResponseReturnsOnCall[len(fake.refreshCustomDomainWithResponseArgsForCall)]
+	fake.refreshCustomDomainWithResponseArgsForCall = append(fake.refreshCustomDomainWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RefreshCustomDomainWithResponseStub
+	fakeReturns := fake.refreshCustomDomainWithResponseReturns
+	fake.recordInvocation("RefreshCustomDomainWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.refreshCustomDomainWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponseCallCount() int {
+	fake.refreshCustomDomainWithResponseMutex.RLock()
+	defer fake.refreshCustomDomainWithResponseMutex.RUnlock()
+	return len(fake.refreshCustomDomainWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.RefreshCustomDomainResponse, error)) {
+	fake.refreshCustomDomainWithResponseMutex.Lock()
+	defer fake.refreshCustomDomainWithResponseMutex.Unlock()
+	fake.RefreshCustomDomainWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.refreshCustomDomainWithResponseMutex.RLock()
+	defer fake.refreshCustomDomainWithResponseMutex.RUnlock()
+	argsForCall := fake.refreshCustomDomainWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponseReturns(result1 *client.RefreshCustomDomainResponse, result2 error) {
+	fake.refreshCustomDomainWithResponseMutex.Lock()
+	defer fake.refreshCustomDomainWithResponseMutex.Unlock()
+	fake.RefreshCustomDomainWithResponseStub = nil
+	fake.refreshCustomDomainWithResponseReturns = struct {
+		result1 *client.RefreshCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RefreshCustomDomainWithResponseReturnsOnCall(i int, result1 *client.RefreshCustomDomainResponse, result2 error) {
+	fake.refreshCustomDomainWithResponseMutex.Lock()
+	defer fake.refreshCustomDomainWithResponseMutex.Unlock()
+	fake.RefreshCustomDomainWithResponseStub = nil
+	if fake.refreshCustomDomainWithResponseReturnsOnCall == nil {
+		fake.refreshCustomDomainWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RefreshCustomDomainResponse
+			result2 error
+		})
+	}
+	fake.refreshCustomDomainWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RefreshCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.RetrieveCustomDomainResponse, error) {
+	fake.retrieveCustomDomainWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveCustomDomainWithResponseReturnsOnCall[len(fake.retrieveCustomDomainWithResponseArgsForCall)]
+	fake.retrieveCustomDomainWithResponseArgsForCall = append(fake.retrieveCustomDomainWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RetrieveCustomDomainWithResponseStub
+	fakeReturns := fake.retrieveCustomDomainWithResponseReturns
+	fake.recordInvocation("RetrieveCustomDomainWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveCustomDomainWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponseCallCount() int {
+	fake.retrieveCustomDomainWithResponseMutex.RLock()
+	defer fake.retrieveCustomDomainWithResponseMutex.RUnlock()
+	return len(fake.retrieveCustomDomainWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveCustomDomainResponse, error)) {
+	fake.retrieveCustomDomainWithResponseMutex.Lock()
+	defer fake.retrieveCustomDomainWithResponseMutex.Unlock()
+	fake.RetrieveCustomDomainWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.retrieveCustomDomainWithResponseMutex.RLock()
+	defer fake.retrieveCustomDomainWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveCustomDomainWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponseReturns(result1 *client.RetrieveCustomDomainResponse, result2 error) {
+	fake.retrieveCustomDomainWithResponseMutex.Lock()
+	defer fake.retrieveCustomDomainWithResponseMutex.Unlock()
+	fake.RetrieveCustomDomainWithResponseStub = nil
+	fake.retrieveCustomDomainWithResponseReturns = struct {
+		result1 *client.RetrieveCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveCustomDomainWithResponseReturnsOnCall(i int, result1 *client.RetrieveCustomDomainResponse, result2 error) {
+	fake.retrieveCustomDomainWithResponseMutex.Lock()
+	defer fake.retrieveCustomDomainWithResponseMutex.Unlock()
+	fake.RetrieveCustomDomainWithResponseStub = nil
+	if fake.retrieveCustomDomainWithResponseReturnsOnCall == nil {
+		fake.retrieveCustomDomainWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveCustomDomainResponse
+			result2 error
+		})
+	}
+	fake.retrieveCustomDomainWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveCustomDomainResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCustomDomainRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createCustomDomainWithResponseMutex.RLock()
+	defer fake.createCustomDomainWithResponseMutex.RUnlock()
+	fake.deleteCustomDomainWithResponseMutex.RLock()
+	defer fake.deleteCustomDomainWithResponseMutex.RUnlock()
+	fake.listCustomDomainsWithResponseMutex.RLock()
+	defer fake.listCustomDomainsWithResponseMutex.RUnlock()
+	fake.refreshCustomDomainWithResponseMutex.RLock()
+	defer fake.refreshCustomDomainWithResponseMutex.RUnlock()
+	fake.retrieveCustomDomainWithResponseMutex.RLock()
+	defer fake.retrieveCustomDomainWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCustomDomainRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,544 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeDeployRepoClient struct {
+	CancelDeployWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.CancelDeployResponse, error)
+	cancelDeployWithResponseMutex       sync.RWMutex
+	cancelDeployWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	cancelDeployWithResponseReturns struct {
+		result1 *client.CancelDeployResponse
+		result2 error
+	}
+	cancelDeployWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CancelDeployResponse
+		result2 error
+	}
+	CreateDeployWithResponseStub        func(context.Context, string, client.CreateDeployJSONRequestBody, ...client.RequestEditorFn) (*client.CreateDeployResponse, error)
+	createDeployWithResponseMutex       sync.RWMutex
+	createDeployWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.CreateDeployJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	createDeployWithResponseReturns struct {
+		result1 *client.CreateDeployResponse
+		result2 error
+	}
+	createDeployWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateDeployResponse
+		result2 error
+	}
+	ListDeploysWithResponseStub        func(context.Context, string, *client.ListDeploysParams, ...client.RequestEditorFn) (*client.ListDeploysResponse, error)
+	listDeploysWithResponseMutex       sync.RWMutex
+	listDeploysWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListDeploysParams
+		arg4 []client.RequestEditorFn
+	}
+	listDeploysWithResponseReturns struct {
+		result1 *client.ListDeploysResponse
+		result2 error
+	}
+	listDeploysWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListDeploysResponse
+		result2 error
+	}
+	RetrieveDeployWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveDeployResponse, error)
+	retrieveDeployWithResponseMutex       sync.RWMutex
+	retrieveDeployWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	retrieveDeployWithResponseReturns struct {
+		result1 *client.RetrieveDeployResponse
+		result2 error
+	}
+	retrieveDeployWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveDeployResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	RollbackDeployWithResponseStub        func(context.Context, string, client.RollbackDeployJSONRequestBody, ...client.RequestEditorFn) (*client.RollbackDeployResponse, error)
+	rollbackDeployWithResponseMutex       sync.RWMutex
+	rollbackDeployWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RollbackDeployJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	rollbackDeployWithResponseReturns struct {
+		result1 *client.RollbackDeployResponse
+		result2 error
+	}
+	rollbackDeployWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RollbackDeployResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.CancelDeployResponse, error) {
+	fake.cancelDeployWithResponseMutex.Lock()
+	ret, specificReturn := fake.cancelDeployWithResponseReturnsOnCall[len(fake.cancelDeployWithResponseArgsForCall)]
+	fake.cancelDeployWithResponseArgsForCall = append(fake.cancelDeployWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CancelDeployWithResponseStub
+	fakeReturns := fake.cancelDeployWithResponseReturns
+	fake.recordInvocation("CancelDeployWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cancelDeployWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponseCallCount() int {
+	fake.cancelDeployWithResponseMutex.RLock()
+	defer fake.cancelDeployWithResponseMutex.RUnlock()
+	return len(fake.cancelDeployWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.CancelDeployResponse, error)) {
+	fake.cancelDeployWithResponseMutex.Lock()
+	defer fake.cancelDeployWithResponseMutex.Unlock()
+	fake.CancelDeployWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.cancelDeployWithResponseMutex.RLock()
+	defer fake.cancelDeployWithResponseMutex.RUnlock()
+	argsForCall := fake.cancelDeployWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponseReturns(result1 *client.CancelDeployResponse, result2 error) {
+	fake.cancelDeployWithResponseMutex.Lock()
+	defer fake.cancelDeployWithResponseMutex.Unlock()
+	fake.CancelDeployWithResponseStub = nil
+	fake.cancelDeployWithResponseReturns = struct {
+		result1 *client.CancelDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) CancelDeployWithResponseReturnsOnCall(i int, result1 *client.CancelDeployResponse, result2 error) {
+	fake.cancelDeployWithResponseMutex.Lock()
+	defer fake.cancelDeployWithResponseMutex.Unlock()
+	fake.CancelDeployWithResponseStub = nil
+	if fake.cancelDeployWithResponseReturnsOnCall == nil {
+		fake.cancelDeployWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CancelDeployResponse
+			result2 error
+		})
+	}
+	fake.cancelDeployWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CancelDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponse(arg1 context.Context, arg2 string, arg3 client.CreateDeployJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.CreateDeployResponse, error) {
+	fake.createDeployWithResponseMutex.Lock()
+	ret, specificReturn := fake.createDeployWithResponseReturnsOnCall[len(fake.createDeployWithResponseArgsForCall)]
+	fake.createDeployWithResponseArgsForCall = append(fake.createDeployWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.CreateDeployJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateDeployWithResponseStub
+	fakeReturns := fake.createDeployWithResponseReturns
+	fake.recordInvocation("CreateDeployWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createDeployWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponseCallCount() int {
+	fake.createDeployWithResponseMutex.RLock()
+	defer fake.createDeployWithResponseMutex.RUnlock()
+	return len(fake.createDeployWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponseCalls(stub func(context.Context, string, client.CreateDeployJSONRequestBody, ...client.RequestEditorFn) (*client.CreateDeployResponse, error)) {
+	fake.createDeployWithResponseMutex.Lock()
+	defer fake.createDeployWithResponseMutex.Unlock()
+	fake.CreateDeployWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponseArgsForCall(i int) (context.Context, string, client.CreateDeployJSONRequestBody, []client.RequestEditorFn) {
+	fake.createDeployWithResponseMutex.RLock()
+	defer fake.createDeployWithResponseMutex.RUnlock()
+	argsForCall := fake.createDeployWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponseReturns(result1 *client.CreateDeployResponse, result2 error) {
+	fake.createDeployWithResponseMutex.Lock()
+	defer fake.createDeployWithResponseMutex.Unlock()
+	fake.CreateDeployWithResponseStub = nil
+	fake.createDeployWithResponseReturns = struct {
+		result1 *client.CreateDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) CreateDeployWithResponseReturnsOnCall(i int, result1 *client.CreateDeployResponse, result2 error) {
+	fake.createDeployWithResponseMutex.Lock()
+	defer fake.createDeployWithResponseMutex.Unlock()
+	fake.CreateDeployWithResponseStub = nil
+	if fake.createDeployWithResponseReturnsOnCall == nil {
+		fake.createDeployWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateDeployResponse
+			result2 error
+		})
+	}
+	fake.createDeployWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponse(arg1 context.Context, arg2 string, arg3 *client.ListDeploysParams, arg4 ...client.RequestEditorFn) (*client.ListDeploysResponse, error) {
+	fake.listDeploysWithResponseMutex.Lock()
+	ret, specificReturn := fake.listDeploysWithResponseReturnsOnCall[len(fake.listDeploysWithResponseArgsForCall)]
+	fake.listDeploysWithResponseArgsForCall = append(fake.listDeploysWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListDeploysParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListDeploysWithResponseStub
+	fakeReturns := fake.listDeploysWithResponseReturns
+	fake.recordInvocation("ListDeploysWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listDeploysWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponseCallCount() int {
+	fake.listDeploysWithResponseMutex.RLock()
+	defer fake.listDeploysWithResponseMutex.RUnlock()
+	return len(fake.listDeploysWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponseCalls(stub func(context.Context, string, *client.ListDeploysParams, ...client.RequestEditorFn) (*client.ListDeploysResponse, error)) {
+	fake.listDeploysWithResponseMutex.Lock()
+	defer fake.listDeploysWithResponseMutex.Unlock()
+	fake.ListDeploysWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponseArgsForCall(i int) (context.Context, string, *client.ListDeploysParams, []client.RequestEditorFn) {
+	fake.listDeploysWithResponseMutex.RLock()
+	defer fake.listDeploysWithResponseMutex.RUnlock()
+	argsForCall := fake.listDeploysWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponseReturns(result1 *client.ListDeploysResponse, result2 error) {
+	fake.listDeploysWithResponseMutex.Lock()
+	defer fake.listDeploysWithResponseMutex.Unlock()
+	fake.ListDeploysWithResponseStub = nil
+	fake.listDeploysWithResponseReturns = struct {
+		result1 *client.ListDeploysResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) ListDeploysWithResponseReturnsOnCall(i int, result1 *client.ListDeploysResponse, result2 error) {
+	fake.listDeploysWithResponseMutex.Lock()
+	defer fake.listDeploysWithResponseMutex.Unlock()
+	fake.ListDeploysWithResponseStub = nil
+	if fake.listDeploysWithResponseReturnsOnCall == nil {
+		fake.listDeploysWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListDeploysResponse
+			result2 error
+		})
+	}
+	fake.listDeploysWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListDeploysResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.RetrieveDeployResponse, error) {
+	fake.retrieveDeployWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveDeployWithResponseReturnsOnCall[len(fake.retrieveDeployWithResponseArgsForCall)]
+	fake.retrieveDeployWithResponseArgsForCall = append(fake.retrieveDeployWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RetrieveDeployWithResponseStub
+	fakeReturns := fake.retrieveDeployWithResponseReturns
+	fake.recordInvocation("RetrieveDeployWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveDeployWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponseCallCount() int {
+	fake.retrieveDeployWithResponseMutex.RLock()
+	defer fake.retrieveDeployWithResponseMutex.RUnlock()
+	return len(fake.retrieveDeployWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveDeployResponse, error)) {
+	fake.retrieveDeployWithResponseMutex.Lock()
+	defer fake.retrieveDeployWithResponseMutex.Unlock()
+	fake.RetrieveDeployWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.retrieveDeployWithResponseMutex.RLock()
+	defer fake.retrieveDeployWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveDeployWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponseReturns(result1 *client.RetrieveDeployResponse, result2 error) {
+	fake.retrieveDeployWithResponseMutex.Lock()
+	defer fake.retrieveDeployWithResponseMutex.Unlock()
+	fake.RetrieveDeployWithResponseStub = nil
+	fake.retrieveDeployWithResponseReturns = struct {
+		result1 *client.RetrieveDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RetrieveDeployWithResponseReturnsOnCall(i int, result1 *client.RetrieveDeployResponse, result2 error) {
+	fake.retrieveDeployWithResponseMutex.Lock()
+	defer fake.retrieveDeployWithResponseMutex.Unlock()
+	fake.RetrieveDeployWithResponseStub = nil
+	if fake.retrieveDeployWithResponseReturnsOnCall == nil {
+		fake.retrieveDeployWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveDeployResponse
+			result2 error
+		})
+	}
+	fake.retrieveDeployWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponse(arg1 context.Context, arg2 string, arg3 client.RollbackDeployJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.RollbackDeployResponse, error) {
+	fake.rollbackDeployWithResponseMutex.Lock()
+	ret, specificReturn := fake.rollbackDeployWithResponseReturnsOnCall[len(fake.rollbackDeployWithResponseArgsForCall)]
+	fake.rollbackDeployWithResponseArgsForCall = append(fake.rollbackDeployWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RollbackDeployJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RollbackDeployWithResponseStub
+	fakeReturns := fake.rollbackDeployWithResponseReturns
+	fake.recordInvocation("RollbackDeployWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rollbackDeployWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponseCallCount() int {
+	fake.rollbackDeployWithResponseMutex.RLock()
+	defer fake.rollbackDeployWithResponseMutex.RUnlock()
+	return len(fake.rollbackDeployWithResponseArgsForCall)
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponseCalls(stub func(context.Context, string, client.RollbackDeployJSONRequestBody, ...client.RequestEditorFn) (*client.RollbackDeployResponse, error)) {
+	fake.rollbackDeployWithResponseMutex.Lock()
+	defer fake.rollbackDeployWithResponseMutex.Unlock()
+	fake.RollbackDeployWithResponseStub = stub
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponseArgsForCall(i int) (context.Context, string, client.RollbackDeployJSONRequestBody, []client.RequestEditorFn) {
+	fake.rollbackDeployWithResponseMutex.RLock()
+	defer fake.rollbackDeployWithResponseMutex.RUnlock()
+	argsForCall := fake.rollbackDeployWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponseReturns(result1 *client.RollbackDeployResponse, result2 error) {
+	fake.rollbackDeployWithResponseMutex.Lock()
+	defer fake.rollbackDeployWithResponseMutex.Unlock()
+	fake.RollbackDeployWithResponseStub = nil
+	fake.rollbackDeployWithResponseReturns = struct {
+		result1 *client.RollbackDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) RollbackDeployWithResponseReturnsOnCall(i int, result1 *client.RollbackDeployResponse, result2 error) {
+	fake.rollbackDeployWithResponseMutex.Lock()
+	defer fake.rollbackDeployWithResponseMutex.Unlock()
+	fake.RollbackDeployWithResponseStub = nil
+	if fake.rollbackDeployWithResponseReturnsOnCall == nil {
+		fake.rollbackDeployWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RollbackDeployResponse
+			result2 error
+		})
+	}
+	fake.rollbackDeployWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RollbackDeployResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cancelDeployWithResponseMutex.RLock()
+	defer fake.cancelDeployWithResponseMutex.RUnlock()
+	fake.createDeployWithResponseMutex.RLock()
+	defer fake.createDeployWithResponseMutex.RUnlock()
+	fake.listDeploysWithResponseMutex.RLock()
+	defer fake.listDeploysWithResponseMutex.RUnlock()
+	fake.retrieveDeployWithResponseMutex.RLock()
+	defer fake.retrieveDeployWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	fake.rollbackDeployWithResponseMutex.RLock()
+	defer fake.rollbackDeployWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeployRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,705 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	clienta "github.com/render-oss/render-mcp-server/pkg/client/disks"
+)
+
+type FakeDiskRepoClient struct {
+	AddDiskWithResponseStub        func(context.Context, client.AddDiskJSONRequestBody, ...client.RequestEditorFn) (*client.AddDiskResponse, error)
+	addDiskWithResponseMutex       sync.RWMutex
+	addDiskWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.AddDiskJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}
+	addDiskWithResponseReturns struct {
+		result1 *client.AddDiskResponse
+		result2 error
+	}
+	addDiskWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AddDiskResponse
+		result2 error
+	}
+	DeleteDiskWithResponseStub        func(context.Context, clienta.DiskId, ...client.RequestEditorFn) (*client.DeleteDiskResponse, error)
+	deleteDiskWithResponseMutex       sync.RWMutex
+	deleteDiskWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 []client.RequestEditorFn
+	}
+	deleteDiskWithResponseReturns struct {
+		result1 *client.DeleteDiskResponse
+		result2 error
+	}
+	deleteDiskWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteDiskResponse
+		result2 error
+	}
+	ListDisksWithResponseStub        func(context.Context, *client.ListDisksParams, ...client.RequestEditorFn) (*client.ListDisksResponse, error)
+	listDisksWithResponseMutex       sync.RWMutex
+	listDisksWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *client.ListDisksParams
+		arg3 []client.RequestEditorFn
+	}
+	listDisksWithResponseReturns struct {
+		result1 *client.ListDisksResponse
+		result2 error
+	}
+	listDisksWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListDisksResponse
+		result2 error
+	}
+	ListSnapshotsWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.ListSnapshotsResponse, error)
+	listSnapshotsWithResponseMutex       sync.RWMutex
+	listSnapshotsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	listSnapshotsWithResponseReturns struct {
+		result1 *client.ListSnapshotsResponse
+		result2 error
+	}
+	listSnapshotsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListSnapshotsResponse
+		result2 error
+	}
+	RestoreSnapshotWithResponseStub        func(context.Context, string, client.RestoreSnapshotJSONRequestBody, ...client.RequestEditorFn) (*client.RestoreSnapshotResponse, error)
+	restoreSnapshotWithResponseMutex       sync.RWMutex
+	restoreSnapshotWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RestoreSnapshotJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	restoreSnapshotWithResponseReturns struct {
+		result1 *client.RestoreSnapshotResponse
+		result2 error
+	}
+	restoreSnapshotWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RestoreSnapshotResponse
+		result2 error
+	}
+	RetrieveDiskWithResponseStub        func(context.Context, clienta.DiskId, ...client.RequestEditorFn) (*client.RetrieveDiskResponse, error)
+	retrieveDiskWithResponseMutex       sync.RWMutex
+	retrieveDiskWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 []client.RequestEditorFn
+	}
+	retrieveDiskWithResponseReturns struct {
+		result1 *client.RetrieveDiskResponse
+		result2 error
+	}
+	retrieveDiskWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveDiskResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	UpdateDiskWithResponseStub        func(context.Context, clienta.DiskId, client.UpdateDiskJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateDiskResponse, error)
+	updateDiskWithResponseMutex       sync.RWMutex
+	updateDiskWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 client.UpdateDiskJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	updateDiskWithResponseReturns struct {
+		result1 *client.UpdateDiskResponse
+		result2 error
+	}
+	updateDiskWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateDiskResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponse(arg1 context.Context, arg2 client.AddDiskJSONRequestBody, arg3 ...client.RequestEditorFn) (*client.AddDiskResponse, error) {
+	fake.addDiskWithResponseMutex.Lock()
+	ret, specificReturn := fake.addDiskWithResponseReturnsOnCall[len(fake.addDiskWithResponseArgsForCall)]
+	fake.addDiskWithResponseArgsForCall = append(fake.addDiskWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.AddDiskJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.AddDiskWithResponseStub
+	fakeReturns := fake.addDiskWithResponseReturns
+	fake.recordInvocation("AddDiskWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.addDiskWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponseCallCount() int {
+	fake.addDiskWithResponseMutex.RLock()
+	defer fake.addDiskWithResponseMutex.RUnlock()
+	return len(fake.addDiskWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponseCalls(stub func(context.Context, client.AddDiskJSONRequestBody, ...client.RequestEditorFn) (*client.AddDiskResponse, error)) {
+	fake.addDiskWithResponseMutex.Lock()
+	defer fake.addDiskWithResponseMutex.Unlock()
+	fake.AddDiskWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponseArgsForCall(i int) (context.Context, client.AddDiskJSONRequestBody, []client.RequestEditorFn) {
+	fake.addDiskWithResponseMutex.RLock()
+	defer fake.addDiskWithResponseMutex.RUnlock()
+	argsForCall := fake.addDiskWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponseReturns(result1 *client.AddDiskResponse, result2 error) {
+	fake.addDiskWithResponseMutex.Lock()
+	defer fake.addDiskWithResponseMutex.Unlock()
+	fake.AddDiskWithResponseStub = nil
+	fake.addDiskWithResponseReturns = struct {
+		result1 *client.AddDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) AddDiskWithResponseReturnsOnCall(i int, result1 *client.AddDiskResponse, result2 error) {
+	fake.addDiskWithResponseMutex.Lock()
+	defer fake.addDiskWithResponseMutex.Unlock()
+	fake.AddDiskWithResponseStub = nil
+	if fake.addDiskWithResponseReturnsOnCall == nil {
+		fake.addDiskWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AddDiskResponse
+			result2 error
+		})
+	}
+	fake.addDiskWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AddDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponse(arg1 context.Context, arg2 clienta.DiskId, arg3 ...client.RequestEditorFn) (*client.DeleteDiskResponse, error) {
+	fake.deleteDiskWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteDiskWithResponseReturnsOnCall[len(fake.deleteDiskWithResponseArgsForCall)]
+	fake.deleteDiskWithResponseArgsForCall = append(fake.deleteDiskWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteDiskWithResponseStub
+	fakeReturns := fake.deleteDiskWithResponseReturns
+	fake.recordInvocation("DeleteDiskWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deleteDiskWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponseCallCount() int {
+	fake.deleteDiskWithResponseMutex.RLock()
+	defer fake.deleteDiskWithResponseMutex.RUnlock()
+	return len(fake.deleteDiskWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponseCalls(stub func(context.Context, clienta.DiskId, ...client.RequestEditorFn) (*client.DeleteDiskResponse, error)) {
+	fake.deleteDiskWithResponseMutex.Lock()
+	defer fake.deleteDiskWithResponseMutex.Unlock()
+	fake.DeleteDiskWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponseArgsForCall(i int) (context.Context, clienta.DiskId, []client.RequestEditorFn) {
+	fake.deleteDiskWithResponseMutex.RLock()
+	defer fake.deleteDiskWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteDiskWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponseReturns(result1 *client.DeleteDiskResponse, result2 error) {
+	fake.deleteDiskWithResponseMutex.Lock()
+	defer fake.deleteDiskWithResponseMutex.Unlock()
+	fake.DeleteDiskWithResponseStub = nil
+	fake.deleteDiskWithResponseReturns = struct {
+		result1 *client.DeleteDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) DeleteDiskWithResponseReturnsOnCall(i int, result1 *client.DeleteDiskResponse, result2 error) {
+	fake.deleteDiskWithResponseMutex.Lock()
+	defer fake.deleteDiskWithResponseMutex.Unlock()
+	fake.DeleteDiskWithResponseStub = nil
+	if fake.deleteDiskWithResponseReturnsOnCall == nil {
+		fake.deleteDiskWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteDiskResponse
+			result2 error
+		})
+	}
+	fake.deleteDiskWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponse(arg1 context.Context, arg2 *client.ListDisksParams, arg3 ...client.RequestEditorFn) (*client.ListDisksResponse, error) {
+	fake.listDisksWithResponseMutex.Lock()
+	ret, specificReturn := fake.listDisksWithResponseReturnsOnCall[len(fake.listDisksWithResponseArgsForCall)]
+	fake.listDisksWithResponseArgsForCall = append(fake.listDisksWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *client.ListDisksParams
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListDisksWithResponseStub
+	fakeReturns := fake.listDisksWithResponseReturns
+	fake.recordInvocation("ListDisksWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listDisksWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponseCallCount() int {
+	fake.listDisksWithResponseMutex.RLock()
+	defer fake.listDisksWithResponseMutex.RUnlock()
+	return len(fake.listDisksWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponseCalls(stub func(context.Context, *client.ListDisksParams, ...client.RequestEditorFn) (*client.ListDisksResponse, error)) {
+	fake.listDisksWithResponseMutex.Lock()
+	defer fake.listDisksWithResponseMutex.Unlock()
+	fake.ListDisksWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponseArgsForCall(i int) (context.Context, *client.ListDisksParams, []client.RequestEditorFn) {
+	fake.listDisksWithResponseMutex.RLock()
+	defer fake.listDisksWithResponseMutex.RUnlock()
+	argsForCall := fake.listDisksWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponseReturns(result1 *client.ListDisksResponse, result2 error) {
+	fake.listDisksWithResponseMutex.Lock()
+	defer fake.listDisksWithResponseMutex.Unlock()
+	fake.ListDisksWithResponseStub = nil
+	fake.listDisksWithResponseReturns = struct {
+		result1 *client.ListDisksResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) ListDisksWithResponseReturnsOnCall(i int, result1 *client.ListDisksResponse, result2 error) {
+	fake.listDisksWithResponseMutex.Lock()
+	defer fake.listDisksWithResponseMutex.Unlock()
+	fake.ListDisksWithResponseStub = nil
+	if fake.listDisksWithResponseReturnsOnCall == nil {
+		fake.listDisksWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListDisksResponse
+			result2 error
+		})
+	}
+	fake.listDisksWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListDisksResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.ListSnapshotsResponse, error) {
+	fake.listSnapshotsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listSnapshotsWithResponseReturnsOnCall[len(fake.listSnapshotsWithResponseArgsForCall)]
+	fake.listSnapshotsWithResponseArgsForCall = append(fake.listSnapshotsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListSnapshotsWithResponseStub
+	fakeReturns := fake.listSnapshotsWithResponseReturns
+	fake.recordInvocation("ListSnapshotsWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listSnapshotsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponseCallCount() int {
+	fake.listSnapshotsWithResponseMutex.RLock()
+	defer fake.listSnapshotsWithResponseMutex.RUnlock()
+	return len(fake.listSnapshotsWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.ListSnapshotsResponse, error)) {
+	fake.listSnapshotsWithResponseMutex.Lock()
+	defer fake.listSnapshotsWithResponseMutex.Unlock()
+	fake.ListSnapshotsWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.listSnapshotsWithResponseMutex.RLock()
+	defer fake.listSnapshotsWithResponseMutex.RUnlock()
+	argsForCall := fake.listSnapshotsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponseReturns(result1 *client.ListSnapshotsResponse, result2 error) {
+	fake.listSnapshotsWithResponseMutex.Lock()
+	defer fake.listSnapshotsWithResponseMutex.Unlock()
+	fake.ListSnapshotsWithResponseStub = nil
+	fake.listSnapshotsWithResponseReturns = struct {
+		result1 *client.ListSnapshotsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) ListSnapshotsWithResponseReturnsOnCall(i int, result1 *client.ListSnapshotsResponse, result2 error) {
+	fake.listSnapshotsWithResponseMutex.Lock()
+	defer fake.listSnapshotsWithResponseMutex.Unlock()
+	fake.ListSnapshotsWithResponseStub = nil
+	if fake.listSnapshotsWithResponseReturnsOnCall == nil {
+		fake.listSnapshotsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListSnapshotsResponse
+			result2 error
+		})
+	}
+	fake.listSnapshotsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListSnapshotsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponse(arg1 context.Context, arg2 string, arg3 client.RestoreSnapshotJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.RestoreSnapshotResponse, error) {
+	fake.restoreSnapshotWithResponseMutex.Lock()
+	ret, specificReturn := fake.restoreSnapshotWithResponseReturnsOnCall[len(fake.restoreSnapshotWithResponseArgsForCall)]
+	fake.restoreSnapshotWithResponseArgsForCall = append(fake.restoreSnapshotWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RestoreSnapshotJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RestoreSnapshotWithResponseStub
+	fakeReturns := fake.restoreSnapshotWithResponseReturns
+	fake.recordInvocation("RestoreSnapshotWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.restoreSnapshotWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponseCallCount() int {
+	fake.restoreSnapshotWithResponseMutex.RLock()
+	defer fake.restoreSnapshotWithResponseMutex.RUnlock()
+	return len(fake.restoreSnapshotWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponseCalls(stub func(context.Context, string, client.RestoreSnapshotJSONRequestBody, ...client.RequestEditorFn) (*client.RestoreSnapshotResponse, error)) {
+	fake.restoreSnapshotWithResponseMutex.Lock()
+	defer fake.restoreSnapshotWithResponseMutex.Unlock()
+	fake.RestoreSnapshotWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponseArgsForCall(i int) (context.Context, string, client.RestoreSnapshotJSONRequestBody, []client.RequestEditorFn) {
+	fake.restoreSnapshotWithResponseMutex.RLock()
+	defer fake.restoreSnapshotWithResponseMutex.RUnlock()
+	argsForCall := fake.restoreSnapshotWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponseReturns(result1 *client.RestoreSnapshotResponse, result2 error) {
+	fake.restoreSnapshotWithResponseMutex.Lock()
+	defer fake.restoreSnapshotWithResponseMutex.Unlock()
+	fake.RestoreSnapshotWithResponseStub = nil
+	fake.restoreSnapshotWithResponseReturns = struct {
+		result1 *client.RestoreSnapshotResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RestoreSnapshotWithResponseReturnsOnCall(i int, result1 *client.RestoreSnapshotResponse, result2 error) {
+	fake.restoreSnapshotWithResponseMutex.Lock()
+	defer fake.restoreSnapshotWithResponseMutex.Unlock()
+	fake.RestoreSnapshotWithResponseStub = nil
+	if fake.restoreSnapshotWithResponseReturnsOnCall == nil {
+		fake.restoreSnapshotWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RestoreSnapshotResponse
+			result2 error
+		})
+	}
+	fake.restoreSnapshotWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RestoreSnapshotResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponse(arg1 context.Context, arg2 clienta.DiskId, arg3 ...client.RequestEditorFn) (*client.RetrieveDiskResponse, error) {
+	fake.retrieveDiskWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveDiskWithResponseReturnsOnCall[len(fake.retrieveDiskWithResponseArgsForCall)]
+	fake.retrieveDiskWithResponseArgsForCall = append(fake.retrieveDiskWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveDiskWithResponseStub
+	fakeReturns := fake.retrieveDiskWithResponseReturns
+	fake.recordInvocation("RetrieveDiskWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveDiskWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponseCallCount() int {
+	fake.retrieveDiskWithResponseMutex.RLock()
+	defer fake.retrieveDiskWithResponseMutex.RUnlock()
+	return len(fake.retrieveDiskWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponseCalls(stub func(context.Context, clienta.DiskId, ...client.RequestEditorFn) (*client.RetrieveDiskResponse, error)) {
+	fake.retrieveDiskWithResponseMutex.Lock()
+	defer fake.retrieveDiskWithResponseMutex.Unlock()
+	fake.RetrieveDiskWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponseArgsForCall(i int) (context.Context, clienta.DiskId, []client.RequestEditorFn) {
+	fake.retrieveDiskWithResponseMutex.RLock()
+	defer fake.retrieveDiskWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveDiskWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponseReturns(result1 *client.RetrieveDiskResponse, result2 error) {
+	fake.retrieveDiskWithResponseMutex.Lock()
+	defer fake.retrieveDiskWithResponseMutex.Unlock()
+	fake.RetrieveDiskWithResponseStub = nil
+	fake.retrieveDiskWithResponseReturns = struct {
+		result1 *client.RetrieveDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RetrieveDiskWithResponseReturnsOnCall(i int, result1 *client.RetrieveDiskResponse, result2 error) {
+	fake.retrieveDiskWithResponseMutex.Lock()
+	defer fake.retrieveDiskWithResponseMutex.Unlock()
+	fake.RetrieveDiskWithResponseStub = nil
+	if fake.retrieveDiskWithResponseReturnsOnCall == nil {
+		fake.retrieveDiskWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveDiskResponse
+			result2 error
+		})
+	}
+	fake.retrieveDiskWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponse(arg1 context.Context, arg2 clienta.DiskId, arg3 client.UpdateDiskJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.UpdateDiskResponse, error) {
+	fake.updateDiskWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateDiskWithResponseReturnsOnCall[len(fake.updateDiskWithResponseArgsForCall)]
+	fake.updateDiskWithResponseArgsForCall = append(fake.updateDiskWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 clienta.DiskId
+		arg3 client.UpdateDiskJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateDiskWithResponseStub
+	fakeReturns := fake.updateDiskWithResponseReturns
+	fake.recordInvocation("UpdateDiskWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateDiskWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponseCallCount() int {
+	fake.updateDiskWithResponseMutex.RLock()
+	defer fake.updateDiskWithResponseMutex.RUnlock()
+	return len(fake.updateDiskWithResponseArgsForCall)
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponseCalls(stub func(context.Context, clienta.DiskId, client.UpdateDiskJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateDiskResponse, error)) {
+	fake.updateDiskWithResponseMutex.Lock()
+	defer fake.updateDiskWithResponseMutex.Unlock()
+	fake.UpdateDiskWithResponseStub = stub
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponseArgsForCall(i int) (context.Context, clienta.DiskId, client.UpdateDiskJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateDiskWithResponseMutex.RLock()
+	defer fake.updateDiskWithResponseMutex.RUnlock()
+	argsForCall := fake.updateDiskWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponseReturns(result1 *client.UpdateDiskResponse, result2 error) {
+	fake.updateDiskWithResponseMutex.Lock()
+	defer fake.updateDiskWithResponseMutex.Unlock()
+	fake.UpdateDiskWithResponseStub = nil
+	fake.updateDiskWithResponseReturns = struct {
+		result1 *client.UpdateDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) UpdateDiskWithResponseReturnsOnCall(i int, result1 *client.UpdateDiskResponse, result2 error) {
+	fake.updateDiskWithResponseMutex.Lock()
+	defer fake.updateDiskWithResponseMutex.Unlock()
+	fake.UpdateDiskWithResponseStub = nil
+	if fake.updateDiskWithResponseReturnsOnCall == nil {
+		fake.updateDiskWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateDiskResponse
+			result2 error
+		})
+	}
+	fake.updateDiskWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateDiskResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDiskRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addDiskWithResponseMutex.RLock()
+	defer fake.addDiskWithResponseMutex.RUnlock()
+	fake.deleteDiskWithResponseMutex.RLock()
+	defer fake.deleteDiskWithResponseMutex.RUnlock()
+	fake.listDisksWithResponseMutex.RLock()
+	defer fake.listDisksWithResponseMutex.RUnlock()
+	fake.listSnapshotsWithResponseMutex.RLock()
+	defer fake.listSnapshotsWithResponseMutex.RUnlock()
+	fake.restoreSnapshotWithResponseMutex.RLock()
+	defer fake.restoreSnapshotWithResponseMutex.RUnlock()
+	fake.retrieveDiskWithResponseMutex.RLock()
+	defer fake.retrieveDiskWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	fake.updateDiskWithResponseMutex.RLock()
+	defer fake.updateDiskWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDiskRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
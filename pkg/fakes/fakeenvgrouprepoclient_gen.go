@@ -0,0 +1,882 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeEnvGroupRepoClient struct {
+	CreateEnvGroupWithResponseStub        func(context.Context, client.CreateEnvGroupJSONRequestBody, ...client.RequestEditorFn) (*client.CreateEnvGroupResponse, error)
+	createEnvGroupWithResponseMutex       sync.RWMutex
+	createEnvGroupWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CreateEnvGroupJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}
+	createEnvGroupWithResponseReturns struct {
+		result1 *client.CreateEnvGroupResponse
+		result2 error
+	}
+	createEnvGroupWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateEnvGroupResponse
+		result2 error
+	}
+	DeleteEnvGroupEnvVarWithResponseStub        func(context.Context, string, client.EnvVarKeyParam, ...client.RequestEditorFn) (*client.DeleteEnvGroupEnvVarResponse, error)
+	deleteEnvGroupEnvVarWithResponseMutex       sync.RWMutex
+	deleteEnvGroupEnvVarWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.EnvVarKeyParam
+		arg4 []client.RequestEditorFn
+	}
+	deleteEnvGroupEnvVarWithResponseReturns struct {
+		result1 *client.DeleteEnvGroupEnvVarResponse
+		result2 error
+	}
+	deleteEnvGroupEnvVarWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteEnvGroupEnvVarResponse
+		result2 error
+	}
+	DeleteEnvGroupSecretFileWithResponseStub        func(context.Context, client.EnvGroupIdParam, client.SecretFileNameParam, ...client.RequestEditorFn) (*client.DeleteEnvGroupSecretFileResponse, error)
+	deleteEnvGroupSecretFileWithResponseMutex       sync.RWMutex
+	deleteEnvGroupSecretFileWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.SecretFileNameParam
+		arg4 []client.RequestEditorFn
+	}
+	deleteEnvGroupSecretFileWithResponseReturns struct {
+		result1 *client.DeleteEnvGroupSecretFileResponse
+		result2 error
+	}
+	deleteEnvGroupSecretFileWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteEnvGroupSecretFileResponse
+		result2 error
+	}
+	LinkServiceToEnvGroupWithResponseStub        func(context.Context, client.EnvGroupIdParam, client.ServiceIdParam, ...client.RequestEditorFn) (*client.LinkServiceToEnvGroupResponse, error)
+	linkServiceToEnvGroupWithResponseMutex       sync.RWMutex
+	linkServiceToEnvGroupWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.ServiceIdParam
+		arg4 []client.RequestEditorFn
+	}
+	linkServiceToEnvGroupWithResponseReturns struct {
+		result1 *client.LinkServiceToEnvGroupResponse
+		result2 error
+	}
+	linkServiceToEnvGroupWithResponseReturnsOnCall map[int]struct {
+		result1 *client.LinkServiceToEnvGroupResponse
+		result2 error
+	}
+	ListEnvGroupsWithResponseStub        func(context.Context, *client.ListEnvGroupsParams, ...client.RequestEditorFn) (*client.ListEnvGroupsResponse, error)
+	listEnvGroupsWithResponseMutex       sync.RWMutex
+	listEnvGroupsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *client.ListEnvGroupsParams
+		arg3 []client.RequestEditorFn
+	}
+	listEnvGroupsWithResponseReturns struct {
+		result1 *client.ListEnvGroupsResponse
+		result2 error
+	}
+	listEnvGroupsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListEnvGroupsResponse
+		result2 error
+	}
+	RetrieveEnvGroupWithResponseStub        func(context.Context, client.EnvGroupIdParam, ...client.RequestEditorFn) (*client.RetrieveEnvGroupResponse, error)
+	retrieveEnvGroupWithResponseMutex       sync.RWMutex
+	retrieveEnvGroupWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 []client.RequestEditorFn
+	}
+	retrieveEnvGroupWithResponseReturns struct {
+		result1 *client.RetrieveEnvGroupResponse
+		result2 error
+	}
+	retrieveEnvGroupWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveEnvGroupResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	UnlinkServiceFromEnvGroupWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.UnlinkServiceFromEnvGroupResponse, error)
+	unlinkServiceFromEnvGroupWithResponseMutex       sync.RWMutex
+	unlinkServiceFromEnvGroupWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	unlinkServiceFromEnvGroupWithResponseReturns struct {
+		result1 *client.UnlinkServiceFromEnvGroupResponse
+		result2 error
+	}
+	unlinkServiceFromEnvGroupWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UnlinkServiceFromEnvGroupResponse
+		result2 error
+	}
+	UpdateEnvGroupEnvVarWithResponseStub        func(context.Context, client.EnvGroupIdParam, client.EnvVarKeyParam, client.UpdateEnvGroupEnvVarJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateEnvGroupEnvVarResponse, error)
+	updateEnvGroupEnvVarWithResponseMutex       sync.RWMutex
+	updateEnvGroupEnvVarWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.EnvVarKeyParam
+		arg4 client.UpdateEnvGroupEnvVarJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}
+	updateEnvGroupEnvVarWithResponseReturns struct {
+		result1 *client.UpdateEnvGroupEnvVarResponse
+		result2 error
+	}
+	updateEnvGroupEnvVarWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateEnvGroupEnvVarResponse
+		result2 error
+	}
+	UpdateEnvGroupSecretFileWithResponseStub        func(context.Context, string, string, client.UpdateEnvGroupSecretFileJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateEnvGroupSecretFileResponse, error)
+	updateEnvGroupSecretFileWithResponseMutex       sync.RWMutex
+	updateEnvGroupSecretFileWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 client.UpdateEnvGroupSecretFileJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}
+	updateEnvGroupSecretFileWithResponseReturns struct {
+		result1 *client.UpdateEnvGroupSecretFileResponse
+		result2 error
+	}
+	updateEnvGroupSecretFileWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateEnvGroupSecretFileResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponse(arg1 context.Context, arg2 client.CreateEnvGroupJSONRequestBody, arg3 ...client.RequestEditorFn) (*client.CreateEnvGroupResponse, error) {
+	fake.createEnvGroupWithResponseMutex.Lock()
+	ret, specificReturn := fake.createEnvGroupWithResponseReturnsOnCall[len(fake.createEnvGroupWithResponseArgsForCall)]
+	fake.createEnvGroupWithResponseArgsForCall = append(fake.createEnvGroupWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CreateEnvGroupJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CreateEnvGroupWithResponseStub
+	fakeReturns := fake.createEnvGroupWithResponseReturns
+	fake.recordInvocation("CreateEnvGroupWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.createEnvGroupWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponseCallCount() int {
+	fake.createEnvGroupWithResponseMutex.RLock()
+	defer fake.createEnvGroupWithResponseMutex.RUnlock()
+	return len(fake.createEnvGroupWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponseCalls(stub func(context.Context, client.CreateEnvGroupJSONRequestBody, ...client.RequestEditorFn) (*client.CreateEnvGroupResponse, error)) {
+	fake.createEnvGroupWithResponseMutex.Lock()
+	defer fake.createEnvGroupWithResponseMutex.Unlock()
+	fake.CreateEnvGroupWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponseArgsForCall(i int) (context.Context, client.CreateEnvGroupJSONRequestBody, []client.RequestEditorFn) {
+	fake.createEnvGroupWithResponseMutex.RLock()
+	defer fake.createEnvGroupWithResponseMutex.RUnlock()
+	argsForCall := fake.createEnvGroupWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponseReturns(result1 *client.CreateEnvGroupResponse, result2 error) {
+	fake.createEnvGroupWithResponseMutex.Lock()
+	defer fake.createEnvGroupWithResponseMutex.Unlock()
+	fake.CreateEnvGroupWithResponseStub = nil
+	fake.createEnvGroupWithResponseReturns = struct {
+		result1 *client.CreateEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) CreateEnvGroupWithResponseReturnsOnCall(i int, result1 *client.CreateEnvGroupResponse, result2 error) {
+	fake.createEnvGroupWithResponseMutex.Lock()
+	defer fake.createEnvGroupWithResponseMutex.Unlock()
+	fake.CreateEnvGroupWithResponseStub = nil
+	if fake.createEnvGroupWithResponseReturnsOnCall == nil {
+		fake.createEnvGroupWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateEnvGroupResponse
+			result2 error
+		})
+	}
+	fake.createEnvGroupWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponse(arg1 context.Context, arg2 string, arg3 client.EnvVarKeyParam, arg4 ...client.RequestEditorFn) (*client.DeleteEnvGroupEnvVarResponse, error) {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteEnvGroupEnvVarWithResponseReturnsOnCall[len(fake.deleteEnvGroupEnvVarWithResponseArgsForCall)]
+	fake.deleteEnvGroupEnvVarWithResponseArgsForCall = append(fake.deleteEnvGroupEnvVarWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.EnvVarKeyParam
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEnvGroupEnvVarWithResponseStub
+	fakeReturns := fake.deleteEnvGroupEnvVarWithResponseReturns
+	fake.recordInvocation("DeleteEnvGroupEnvVarWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEnvGroupEnvVarWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponseCallCount() int {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.RUnlock()
+	return len(fake.deleteEnvGroupEnvVarWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponseCalls(stub func(context.Context, string, client.EnvVarKeyParam, ...client.RequestEditorFn) (*client.DeleteEnvGroupEnvVarResponse, error)) {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupEnvVarWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponseArgsForCall(i int) (context.Context, string, client.EnvVarKeyParam, []client.RequestEditorFn) {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteEnvGroupEnvVarWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponseReturns(result1 *client.DeleteEnvGroupEnvVarResponse, result2 error) {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupEnvVarWithResponseStub = nil
+	fake.deleteEnvGroupEnvVarWithResponseReturns = struct {
+		result1 *client.DeleteEnvGroupEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupEnvVarWithResponseReturnsOnCall(i int, result1 *client.DeleteEnvGroupEnvVarResponse, result2 error) {
+	fake.deleteEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupEnvVarWithResponseStub = nil
+	if fake.deleteEnvGroupEnvVarWithResponseReturnsOnCall == nil {
+		fake.deleteEnvGroupEnvVarWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteEnvGroupEnvVarResponse
+			result2 error
+		})
+	}
+	fake.deleteEnvGroupEnvVarWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteEnvGroupEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponse(arg1 context.Context, arg2 client.EnvGroupIdParam, arg3 client.SecretFileNameParam, arg4 ...client.RequestEditorFn) (*client.DeleteEnvGroupSecretFileResponse, error) {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteEnvGroupSecretFileWithResponseReturnsOnCall[len(fake.deleteEnvGroupSecretFileWithResponseArgsForCall)]
+	fake.deleteEnvGroupSecretFileWithResponseArgsForCall = append(fake.deleteEnvGroupSecretFileWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.SecretFileNameParam
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEnvGroupSecretFileWithResponseStub
+	fakeReturns := fake.deleteEnvGroupSecretFileWithResponseReturns
+	fake.recordInvocation("DeleteEnvGroupSecretFileWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEnvGroupSecretFileWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponseCallCount() int {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.RUnlock()
+	return len(fake.deleteEnvGroupSecretFileWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponseCalls(stub func(context.Context, client.EnvGroupIdParam, client.SecretFileNameParam, ...client.RequestEditorFn) (*client.DeleteEnvGroupSecretFileResponse, error)) {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupSecretFileWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponseArgsForCall(i int) (context.Context, client.EnvGroupIdParam, client.SecretFileNameParam, []client.RequestEditorFn) {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteEnvGroupSecretFileWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponseReturns(result1 *client.DeleteEnvGroupSecretFileResponse, result2 error) {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupSecretFileWithResponseStub = nil
+	fake.deleteEnvGroupSecretFileWithResponseReturns = struct {
+		result1 *client.DeleteEnvGroupSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) DeleteEnvGroupSecretFileWithResponseReturnsOnCall(i int, result1 *client.DeleteEnvGroupSecretFileResponse, result2 error) {
+	fake.deleteEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.DeleteEnvGroupSecretFileWithResponseStub = nil
+	if fake.deleteEnvGroupSecretFileWithResponseReturnsOnCall == nil {
+		fake.deleteEnvGroupSecretFileWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteEnvGroupSecretFileResponse
+			result2 error
+		})
+	}
+	fake.deleteEnvGroupSecretFileWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteEnvGroupSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponse(arg1 context.Context, arg2 client.EnvGroupIdParam, arg3 client.ServiceIdParam, arg4 ...client.RequestEditorFn) (*client.LinkServiceToEnvGroupResponse, error) {
+	fake.linkServiceToEnvGroupWithResponseMutex.Lock()
+	ret, specificReturn := fake.linkServiceToEnvGroupWithResponseReturnsOnCall[len(fake.linkServiceToEnvGroupWithResponseArgsForCall)]
+	fake.linkServiceToEnvGroupWithResponseArgsForCall = append(fake.linkServiceToEnvGroupWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.ServiceIdParam
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.LinkServiceToEnvGroupWithResponseStub
+	fakeReturns := fake.linkServiceToEnvGroupWithResponseReturns
+	fake.recordInvocation("LinkServiceToEnvGroupWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.linkServiceToEnvGroupWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponseCallCount() int {
+	fake.linkServiceToEnvGroupWithResponseMutex.RLock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.RUnlock()
+	return len(fake.linkServiceToEnvGroupWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponseCalls(stub func(context.Context, client.EnvGroupIdParam, client.ServiceIdParam, ...client.RequestEditorFn) (*client.LinkServiceToEnvGroupResponse, error)) {
+	fake.linkServiceToEnvGroupWithResponseMutex.Lock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.Unlock()
+	fake.LinkServiceToEnvGroupWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponseArgsForCall(i int) (context.Context, client.EnvGroupIdParam, client.ServiceIdParam, []client.RequestEditorFn) {
+	fake.linkServiceToEnvGroupWithResponseMutex.RLock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.RUnlock()
+	argsForCall := fake.linkServiceToEnvGroupWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponseReturns(result1 *client.LinkServiceToEnvGroupResponse, result2 error) {
+	fake.linkServiceToEnvGroupWithResponseMutex.Lock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.Unlock()
+	fake.LinkServiceToEnvGroupWithResponseStub = nil
+	fake.linkServiceToEnvGroupWithResponseReturns = struct {
+		result1 *client.LinkServiceToEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) LinkServiceToEnvGroupWithResponseReturnsOnCall(i int, result1 *client.LinkServiceToEnvGroupResponse, result2 error) {
+	fake.linkServiceToEnvGroupWithResponseMutex.Lock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.Unlock()
+	fake.LinkServiceToEnvGroupWithResponseStub = nil
+	if fake.linkServiceToEnvGroupWithResponseReturnsOnCall == nil {
+		fake.linkServiceToEnvGroupWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.LinkServiceToEnvGroupResponse
+			result2 error
+		})
+	}
+	fake.linkServiceToEnvGroupWithResponseReturnsOnCall[i] = struct {
+		result1 *client.LinkServiceToEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponse(arg1 context.Context, arg2 *client.ListEnvGroupsParams, arg3 ...client.RequestEditorFn) (*client.ListEnvGroupsResponse, error) {
+	fake.listEnvGroupsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listEnvGroupsWithResponseReturnsOnCall[len(fake.listEnvGroupsWithResponseArgsForCall)]
+	fake.listEnvGroupsWithResponseArgsForCall = append(fake.listEnvGroupsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *client.ListEnvGroupsParams
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListEnvGroupsWithResponseStub
+	fakeReturns := fake.listEnvGroupsWithResponseReturns
+	fake.recordInvocation("ListEnvGroupsWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listEnvGroupsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponseCallCount() int {
+	fake.listEnvGroupsWithResponseMutex.RLock()
+	defer fake.listEnvGroupsWithResponseMutex.RUnlock()
+	return len(fake.listEnvGroupsWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponseCalls(stub func(context.Context, *client.ListEnvGroupsParams, ...client.RequestEditorFn) (*client.ListEnvGroupsResponse, error)) {
+	fake.listEnvGroupsWithResponseMutex.Lock()
+	defer fake.listEnvGroupsWithResponseMutex.Unlock()
+	fake.ListEnvGroupsWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponseArgsForCall(i int) (context.Context, *client.ListEnvGroupsParams, []client.RequestEditorFn) {
+	fake.listEnvGroupsWithResponseMutex.RLock()
+	defer fake.listEnvGroupsWithResponseMutex.RUnlock()
+	argsForCall := fake.listEnvGroupsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponseReturns(result1 *client.ListEnvGroupsResponse, result2 error) {
+	fake.listEnvGroupsWithResponseMutex.Lock()
+	defer fake.listEnvGroupsWithResponseMutex.Unlock()
+	fake.ListEnvGroupsWithResponseStub = nil
+	fake.listEnvGroupsWithResponseReturns = struct {
+		result1 *client.ListEnvGroupsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) ListEnvGroupsWithResponseReturnsOnCall(i int, result1 *client.ListEnvGroupsResponse, result2 error) {
+	fake.listEnvGroupsWithResponseMutex.Lock()
+	defer fake.listEnvGroupsWithResponseMutex.Unlock()
+	fake.ListEnvGroupsWithResponseStub = nil
+	if fake.listEnvGroupsWithResponseReturnsOnCall == nil {
+		fake.listEnvGroupsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListEnvGroupsResponse
+			result2 error
+		})
+	}
+	fake.listEnvGroupsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListEnvGroupsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponse(arg1 context.Context, arg2 client.EnvGroupIdParam, arg3 ...client.RequestEditorFn) (*client.RetrieveEnvGroupResponse, error) {
+	fake.retrieveEnvGroupWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveEnvGroupWithResponseReturnsOnCall[len(fake.retrieveEnvGroupWithResponseArgsForCall)]
+	fake.retrieveEnvGroupWithResponseArgsForCall = append(fake.retrieveEnvGroupWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveEnvGroupWithResponseStub
+	fakeReturns := fake.retrieveEnvGroupWithResponseReturns
+	fake.recordInvocation("RetrieveEnvGroupWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveEnvGroupWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponseCallCount() int {
+	fake.retrieveEnvGroupWithResponseMutex.RLock()
+	defer fake.retrieveEnvGroupWithResponseMutex.RUnlock()
+	return len(fake.retrieveEnvGroupWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponseCalls(stub func(context.Context, client.EnvGroupIdParam, ...client.RequestEditorFn) (*client.RetrieveEnvGroupResponse, error)) {
+	fake.retrieveEnvGroupWithResponseMutex.Lock()
+	defer fake.retrieveEnvGroupWithResponseMutex.Unlock()
+	fake.RetrieveEnvGroupWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponseArgsForCall(i int) (context.Context, client.EnvGroupIdParam, []client.RequestEditorFn) {
+	fake.retrieveEnvGroupWithResponseMutex.RLock()
+	defer fake.retrieveEnvGroupWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveEnvGroupWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponseReturns(result1 *client.RetrieveEnvGroupResponse, result2 error) {
+	fake.retrieveEnvGroupWithResponseMutex.Lock()
+	defer fake.retrieveEnvGroupWithResponseMutex.Unlock()
+	fake.RetrieveEnvGroupWithResponseStub = nil
+	fake.retrieveEnvGroupWithResponseReturns = struct {
+		result1 *client.RetrieveEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveEnvGroupWithResponseReturnsOnCall(i int, result1 *client.RetrieveEnvGroupResponse, result2 error) {
+	fake.retrieveEnvGroupWithResponseMutex.Lock()
+	defer fake.retrieveEnvGroupWithResponseMutex.Unlock()
+	fake.RetrieveEnvGroupWithResponseStub = nil
+	if fake.retrieveEnvGroupWithResponseReturnsOnCall == nil {
+		fake.retrieveEnvGroupWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveEnvGroupResponse
+			result2 error
+		})
+	}
+	fake.retrieveEnvGroupWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.UnlinkServiceFromEnvGroupResponse, error) {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.Lock()
+	ret, specificReturn := fake.unlinkServiceFromEnvGroupWithResponseReturnsOnCall[len(fake.unlinkServiceFromEnvGroupWithResponseArgsForCall)]
+	fake.unlinkServiceFromEnvGroupWithResponseArgsForCall = append(fake.unlinkServiceFromEnvGroupWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UnlinkServiceFromEnvGroupWithResponseStub
+	fakeReturns := fake.unlinkServiceFromEnvGroupWithResponseReturns
+	fake.recordInvocation("UnlinkServiceFromEnvGroupWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponseCallCount() int {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.RLock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.RUnlock()
+	return len(fake.unlinkServiceFromEnvGroupWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.UnlinkServiceFromEnvGroupResponse, error)) {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.Lock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.Unlock()
+	fake.UnlinkServiceFromEnvGroupWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.RLock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.RUnlock()
+	argsForCall := fake.unlinkServiceFromEnvGroupWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponseReturns(result1 *client.UnlinkServiceFromEnvGroupResponse, result2 error) {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.Lock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.Unlock()
+	fake.UnlinkServiceFromEnvGroupWithResponseStub = nil
+	fake.unlinkServiceFromEnvGroupWithResponseReturns = struct {
+		result1 *client.UnlinkServiceFromEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UnlinkServiceFromEnvGroupWithResponseReturnsOnCall(i int, result1 *client.UnlinkServiceFromEnvGroupResponse, result2 error) {
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.Lock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.Unlock()
+	fake.UnlinkServiceFromEnvGroupWithResponseStub = nil
+	if fake.unlinkServiceFromEnvGroupWithResponseReturnsOnCall == nil {
+		fake.unlinkServiceFromEnvGroupWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UnlinkServiceFromEnvGroupResponse
+			result2 error
+		})
+	}
+	fake.unlinkServiceFromEnvGroupWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UnlinkServiceFromEnvGroupResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponse(arg1 context.Context, arg2 client.EnvGroupIdParam, arg3 client.EnvVarKeyParam, arg4 client.UpdateEnvGroupEnvVarJSONRequestBody, arg5 ...client.RequestEditorFn) (*client.UpdateEnvGroupEnvVarResponse, error) {
+	fake.updateEnvGroupEnvVarWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateEnvGroupEnvVarWithResponseReturnsOnCall[len(fake.updateEnvGroupEnvVarWithResponseArgsForCall)]
+	fake.updateEnvGroupEnvVarWithResponseArgsForCall = append(fake.updateEnvGroupEnvVarWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.EnvGroupIdParam
+		arg3 client.EnvVarKeyParam
+		arg4 client.UpdateEnvGroupEnvVarJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateEnvGroupEnvVarWithResponseStub
+	fakeReturns := fake.updateEnvGroupEnvVarWithResponseReturns
+	fake.recordInvocation("UpdateEnvGroupEnvVarWithResponse", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateEnvGroupEnvVarWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponseCallCount() int {
+	fake.updateEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.RUnlock()
+	return len(fake.updateEnvGroupEnvVarWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponseCalls(stub func(context.Context, client.EnvGroupIdParam, client.EnvVarKeyParam, client.UpdateEnvGroupEnvVarJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateEnvGroupEnvVarResponse, error)) {
+	fake.updateEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupEnvVarWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponseArgsForCall(i int) (context.Context, client.EnvGroupIdParam, client.EnvVarKeyParam, client.UpdateEnvGroupEnvVarJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.RUnlock()
+	argsForCall := fake.updateEnvGroupEnvVarWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponseReturns(result1 *client.UpdateEnvGroupEnvVarResponse, result2 error) {
+	fake.updateEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupEnvVarWithResponseStub = nil
+	fake.updateEnvGroupEnvVarWithResponseReturns = struct {
+		result1 *client.UpdateEnvGroupEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupEnvVarWithResponseReturnsOnCall(i int, result1 *client.UpdateEnvGroupEnvVarResponse, result2 error) {
+	fake.updateEnvGroupEnvVarWithResponseMutex.Lock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupEnvVarWithResponseStub = nil
+	if fake.updateEnvGroupEnvVarWithResponseReturnsOnCall == nil {
+		fake.updateEnvGroupEnvVarWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateEnvGroupEnvVarResponse
+			result2 error
+		})
+	}
+	fake.updateEnvGroupEnvVarWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateEnvGroupEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 client.UpdateEnvGroupSecretFileJSONRequestBody, arg5 ...client.RequestEditorFn) (*client.UpdateEnvGroupSecretFileResponse, error) {
+	fake.updateEnvGroupSecretFileWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateEnvGroupSecretFileWithResponseReturnsOnCall[len(fake.updateEnvGroupSecretFileWithResponseArgsForCall)]
+	fake.updateEnvGroupSecretFileWithResponseArgsForCall = append(fake.updateEnvGroupSecretFileWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 client.UpdateEnvGroupSecretFileJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateEnvGroupSecretFileWithResponseStub
+	fakeReturns := fake.updateEnvGroupSecretFileWithResponseReturns
+	fake.recordInvocation("UpdateEnvGroupSecretFileWithResponse", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateEnvGroupSecretFileWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponseCallCount() int {
+	fake.updateEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.RUnlock()
+	return len(fake.updateEnvGroupSecretFileWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponseCalls(stub func(context.Context, string, string, client.UpdateEnvGroupSecretFileJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateEnvGroupSecretFileResponse, error)) {
+	fake.updateEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupSecretFileWithResponseStub = stub
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponseArgsForCall(i int) (context.Context, string, string, client.UpdateEnvGroupSecretFileJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.RUnlock()
+	argsForCall := fake.updateEnvGroupSecretFileWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponseReturns(result1 *client.UpdateEnvGroupSecretFileResponse, result2 error) {
+	fake.updateEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupSecretFileWithResponseStub = nil
+	fake.updateEnvGroupSecretFileWithResponseReturns = struct {
+		result1 *client.UpdateEnvGroupSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) UpdateEnvGroupSecretFileWithResponseReturnsOnCall(i int, result1 *client.UpdateEnvGroupSecretFileResponse, result2 error) {
+	fake.updateEnvGroupSecretFileWithResponseMutex.Lock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.Unlock()
+	fake.UpdateEnvGroupSecretFileWithResponseStub = nil
+	if fake.updateEnvGroupSecretFileWithResponseReturnsOnCall == nil {
+		fake.updateEnvGroupSecretFileWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateEnvGroupSecretFileResponse
+			result2 error
+		})
+	}
+	fake.updateEnvGroupSecretFileWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateEnvGroupSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvGroupRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createEnvGroupWithResponseMutex.RLock()
+	defer fake.createEnvGroupWithResponseMutex.RUnlock()
+	fake.deleteEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupEnvVarWithResponseMutex.RUnlock()
+	fake.deleteEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.deleteEnvGroupSecretFileWithResponseMutex.RUnlock()
+	fake.linkServiceToEnvGroupWithResponseMutex.RLock()
+	defer fake.linkServiceToEnvGroupWithResponseMutex.RUnlock()
+	fake.listEnvGroupsWithResponseMutex.RLock()
+	defer fake.listEnvGroupsWithResponseMutex.RUnlock()
+	fake.retrieveEnvGroupWithResponseMutex.RLock()
+	defer fake.retrieveEnvGroupWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	fake.unlinkServiceFromEnvGroupWithResponseMutex.RLock()
+	defer fake.unlinkServiceFromEnvGroupWithResponseMutex.RUnlock()
+	fake.updateEnvGroupEnvVarWithResponseMutex.RLock()
+	defer fake.updateEnvGroupEnvVarWithResponseMutex.RUnlock()
+	fake.updateEnvGroupSecretFileWithResponseMutex.RLock()
+	defer fake.updateEnvGroupSecretFileWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEnvGroupRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,704 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeEnvironmentRepoClient struct {
+	AddResourcesToEnvironmentWithResponseStub        func(context.Context, string, client.AddResourcesToEnvironmentJSONRequestBody, ...client.RequestEditorFn) (*client.AddResourcesToEnvironmentResponse, error)
+	addResourcesToEnvironmentWithResponseMutex       sync.RWMutex
+	addResourcesToEnvironmentWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddResourcesToEnvironmentJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	addResourcesToEnvironmentWithResponseReturns struct {
+		result1 *client.AddResourcesToEnvironmentResponse
+		result2 error
+	}
+	addResourcesToEnvironmentWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AddResourcesToEnvironmentResponse
+		result2 error
+	}
+	CreateEnvironmentWithResponseStub        func(context.Context, client.CreateEnvironmentJSONRequestBody, ...client.RequestEditorFn) (*client.CreateEnvironmentResponse, error)
+	createEnvironmentWithResponseMutex       sync.RWMutex
+	createEnvironmentWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CreateEnvironmentJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}
+	createEnvironmentWithResponseReturns struct {
+		result1 *client.CreateEnvironmentResponse
+		result2 error
+	}
+	createEnvironmentWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateEnvironmentResponse
+		result2 error
+	}
+	CreateProjectWithResponseStub        func(context.Context, client.CreateProjectJSONRequestBody, ...client.RequestEditorFn) (*client.CreateProjectResponse, error)
+	createProjectWithResponseMutex       sync.RWMutex
+	createProjectWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CreateProjectJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}
+	createProjectWithResponseReturns struct {
+		result1 *client.CreateProjectResponse
+		result2 error
+	}
+	createProjectWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateProjectResponse
+		result2 error
+	}
+	ListEnvironmentsWithResponseStub        func(context.Context, *client.ListEnvironmentsParams, ...client.RequestEditorFn) (*client.ListEnvironmentsResponse, error)
+	listEnvironmentsWithResponseMutex       sync.RWMutex
+	listEnvironmentsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *client.ListEnvironmentsParams
+		arg3 []client.RequestEditorFn
+	}
+	listEnvironmentsWithResponseReturns struct {
+		result1 *client.ListEnvironmentsResponse
+		result2 error
+	}
+	listEnvironmentsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListEnvironmentsResponse
+		result2 error
+	}
+	ListProjectsWithResponseStub        func(context.Context, *client.ListProjectsParams, ...client.RequestEditorFn) (*client.ListProjectsResponse, error)
+	listProjectsWithResponseMutex       sync.RWMutex
+	listProjectsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *client.ListProjectsParams
+		arg3 []client.RequestEditorFn
+	}
+	listProjectsWithResponseReturns struct {
+		result1 *client.ListProjectsResponse
+		result2 error
+	}
+	listProjectsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListProjectsResponse
+		result2 error
+	}
+	RemoveResourcesFromEnvironmentWithResponseStub        func(context.Context, string, *client.RemoveResourcesFromEnvironmentParams, ...client.RequestEditorFn) (*client.RemoveResourcesFromEnvironmentResponse, error)
+	removeResourcesFromEnvironmentWithResponseMutex       sync.RWMutex
+	removeResourcesFromEnvironmentWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.RemoveResourcesFromEnvironmentParams
+		arg4 []client.RequestEditorFn
+	}
+	removeResourcesFromEnvironmentWithResponseReturns struct {
+		result1 *client.RemoveResourcesFromEnvironmentResponse
+		result2 error
+	}
+	removeResourcesFromEnvironmentWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RemoveResourcesFromEnvironmentResponse
+		result2 error
+	}
+	RetrieveEnvironmentWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveEnvironmentResponse, error)
+	retrieveEnvironmentWithResponseMutex       sync.RWMutex
+	retrieveEnvironmentWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveEnvironmentWithResponseReturns struct {
+		result1 *client.RetrieveEnvironmentResponse
+		result2 error
+	}
+	retrieveEnvironmentWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveEnvironmentResponse
+		result2 error
+	}
+	RetrieveProjectWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveProjectResponse, error)
+	retrieveProjectWithResponseMutex       sync.RWMutex
+	retrieveProjectWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveProjectWithResponseReturns struct {
+		result1 *client.RetrieveProjectResponse
+		result2 error
+	}
+	retrieveProjectWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveProjectResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponse(arg1 context.Context, arg2 string, arg3 client.AddResourcesToEnvironmentJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.AddResourcesToEnvironmentResponse, error) {
+	fake.addResourcesToEnvironmentWithResponseMutex.Lock()
+	ret, specificReturn := fake.addResourcesToEnvironmentWithResponseReturnsOnCall[len(fake.addResourcesToEnvironmentWithResponseArgsForCall)]
+	fake.addResourcesToEnvironmentWithResponseArgsForCall = append(fake.addResourcesToEnvironmentWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddResourcesToEnvironmentJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddResourcesToEnvironmentWithResponseStub
+	fakeReturns := fake.addResourcesToEnvironmentWithResponseReturns
+	fake.recordInvocation("AddResourcesToEnvironmentWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addResourcesToEnvironmentWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponseCallCount() int {
+	fake.addResourcesToEnvironmentWithResponseMutex.RLock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.RUnlock()
+	return len(fake.addResourcesToEnvironmentWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponseCalls(stub func(context.Context, string, client.AddResourcesToEnvironmentJSONRequestBody, ...client.RequestEditorFn) (*client.AddResourcesToEnvironmentResponse, error)) {
+	fake.addResourcesToEnvironmentWithResponseMutex.Lock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.Unlock()
+	fake.AddResourcesToEnvironmentWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponseArgsForCall(i int) (context.Context, string, client.AddResourcesToEnvironmentJSONRequestBody, []client.RequestEditorFn) {
+	fake.addResourcesToEnvironmentWithResponseMutex.RLock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.RUnlock()
+	argsForCall := fake.addResourcesToEnvironmentWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponseReturns(result1 *client.AddResourcesToEnvironmentResponse, result2 error) {
+	fake.addResourcesToEnvironmentWithResponseMutex.Lock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.Unlock()
+	fake.AddResourcesToEnvironmentWithResponseStub = nil
+	fake.addResourcesToEnvironmentWithResponseReturns = struct {
+		result1 *client.AddResourcesToEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) AddResourcesToEnvironmentWithResponseReturnsOnCall(i int, result1 *client.AddResourcesToEnvironmentResponse, result2 error) {
+	fake.addResourcesToEnvironmentWithResponseMutex.Lock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.Unlock()
+	fake.AddResourcesToEnvironmentWithResponseStub = nil
+	if fake.addResourcesToEnvironmentWithResponseReturnsOnCall == nil {
+		fake.addResourcesToEnvironmentWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AddResourcesToEnvironmentResponse
+			result2 error
+		})
+	}
+	fake.addResourcesToEnvironmentWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AddResourcesToEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponse(arg1 context.Context, arg2 client.CreateEnvironmentJSONRequestBody, arg3 ...client.RequestEditorFn) (*client.CreateEnvironmentResponse, error) {
+	fake.createEnvironmentWithResponseMutex.Lock()
+	ret, specificReturn := fake.createEnvironmentWithResponseReturnsOnCall[len(fake.createEnvironmentWithResponseArgsForCall)]
+	fake.createEnvironmentWithResponseArgsForCall = append(fake.createEnvironmentWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CreateEnvironmentJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CreateEnvironmentWithResponseStub
+	fakeReturns := fake.createEnvironmentWithResponseReturns
+	fake.recordInvocation("CreateEnvironmentWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.createEnvironmentWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponseCallCount() int {
+	fake.createEnvironmentWithResponseMutex.RLock()
+	defer fake.createEnvironmentWithResponseMutex.RUnlock()
+	return len(fake.createEnvironmentWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponseCalls(stub func(context.Context, client.CreateEnvironmentJSONRequestBody, ...client.RequestEditorFn) (*client.CreateEnvironmentResponse, error)) {
+	fake.createEnvironmentWithResponseMutex.Lock()
+	defer fake.createEnvironmentWithResponseMutex.Unlock()
+	fake.CreateEnvironmentWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponseArgsForCall(i int) (context.Context, client.CreateEnvironmentJSONRequestBody, []client.RequestEditorFn) {
+	fake.createEnvironmentWithResponseMutex.RLock()
+	defer fake.createEnvironmentWithResponseMutex.RUnlock()
+	argsForCall := fake.createEnvironmentWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponseReturns(result1 *client.CreateEnvironmentResponse, result2 error) {
+	fake.createEnvironmentWithResponseMutex.Lock()
+	defer fake.createEnvironmentWithResponseMutex.Unlock()
+	fake.CreateEnvironmentWithResponseStub = nil
+	fake.createEnvironmentWithResponseReturns = struct {
+		result1 *client.CreateEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateEnvironmentWithResponseReturnsOnCall(i int, result1 *client.CreateEnvironmentResponse, result2 error) {
+	fake.createEnvironmentWithResponseMutex.Lock()
+	defer fake.createEnvironmentWithResponseMutex.Unlock()
+	fake.CreateEnvironmentWithResponseStub = nil
+	if fake.createEnvironmentWithResponseReturnsOnCall == nil {
+		fake.createEnvironmentWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateEnvironmentResponse
+			result2 error
+		})
+	}
+	fake.createEnvironmentWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponse(arg1 context.Context, arg2 client.CreateProjectJSONRequestBody, arg3 ...client.RequestEditorFn) (*client.CreateProjectResponse, error) {
+	fake.createProjectWithResponseMutex.Lock()
+	ret, specificReturn := fake.createProjectWithResponseReturnsOnCall[len(fake.createProjectWithResponseArgsForCall)]
+	fake.createProjectWithResponseArgsForCall = append(fake.createProjectWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CreateProjectJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CreateProjectWithResponseStub
+	fakeReturns := fake.createProjectWithResponseReturns
+	fake.recordInvocation("CreateProjectWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.createProjectWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponseCallCount() int {
+	fake.createProjectWithResponseMutex.RLock()
+	defer fake.createProjectWithResponseMutex.RUnlock()
+	return len(fake.createProjectWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponseCalls(stub func(context.Context, client.CreateProjectJSONRequestBody, ...client.RequestEditorFn) (*client.CreateProjectResponse, error)) {
+	fake.createProjectWithResponseMutex.Lock()
+	defer fake.createProjectWithResponseMutex.Unlock()
+	fake.CreateProjectWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponseArgsForCall(i int) (context.Context, client.CreateProjectJSONRequestBody, []client.RequestEditorFn) {
+	fake.createProjectWithResponseMutex.RLock()
+	defer fake.createProjectWithResponseMutex.RUnlock()
+	argsForCall := fake.createProjectWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponseReturns(result1 *client.CreateProjectResponse, result2 error) {
+	fake.createProjectWithResponseMutex.Lock()
+	defer fake.createProjectWithResponseMutex.Unlock()
+	fake.CreateProjectWithResponseStub = nil
+	fake.createProjectWithResponseReturns = struct {
+		result1 *client.CreateProjectResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) CreateProjectWithResponseReturnsOnCall(i int, result1 *client.CreateProjectResponse, result2 error) {
+	fake.createProjectWithResponseMutex.Lock()
+	defer fake.createProjectWithResponseMutex.Unlock()
+	fake.CreateProjectWithResponseStub = nil
+	if fake.createProjectWithResponseReturnsOnCall == nil {
+		fake.createProjectWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateProjectResponse
+			result2 error
+		})
+	}
+	fake.createProjectWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateProjectResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponse(arg1 context.Context, arg2 *client.ListEnvironmentsParams, arg3 ...client.RequestEditorFn) (*client.ListEnvironmentsResponse, error) {
+	fake.listEnvironmentsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listEnvironmentsWithResponseReturnsOnCall[len(fake.listEnvironmentsWithResponseArgsForCall)]
+	fake.listEnvironmentsWithResponseArgsForCall = append(fake.listEnvironmentsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *client.ListEnvironmentsParams
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListEnvironmentsWithResponseStub
+	fakeReturns := fake.listEnvironmentsWithResponseReturns
+	fake.recordInvocation("ListEnvironmentsWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listEnvironmentsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponseCallCount() int {
+	fake.listEnvironmentsWithResponseMutex.RLock()
+	defer fake.listEnvironmentsWithResponseMutex.RUnlock()
+	return len(fake.listEnvironmentsWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponseCalls(stub func(context.Context, *client.ListEnvironmentsParams, ...client.RequestEditorFn) (*client.ListEnvironmentsResponse, error)) {
+	fake.listEnvironmentsWithResponseMutex.Lock()
+	defer fake.listEnvironmentsWithResponseMutex.Unlock()
+	fake.ListEnvironmentsWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponseArgsForCall(i int) (context.Context, *client.ListEnvironmentsParams, []client.RequestEditorFn) {
+	fake.listEnvironmentsWithResponseMutex.RLock()
+	defer fake.listEnvironmentsWithResponseMutex.RUnlock()
+	argsForCall := fake.listEnvironmentsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponseReturns(result1 *client.ListEnvironmentsResponse, result2 error) {
+	fake.listEnvironmentsWithResponseMutex.Lock()
+	defer fake.listEnvironmentsWithResponseMutex.Unlock()
+	fake.ListEnvironmentsWithResponseStub = nil
+	fake.listEnvironmentsWithResponseReturns = struct {
+		result1 *client.ListEnvironmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) ListEnvironmentsWithResponseReturnsOnCall(i int, result1 *client.ListEnvironmentsResponse, result2 error) {
+	fake.listEnvironmentsWithResponseMutex.Lock()
+	defer fake.listEnvironmentsWithResponseMutex.Unlock()
+	fake.ListEnvironmentsWithResponseStub = nil
+	if fake.listEnvironmentsWithResponseReturnsOnCall == nil {
+		fake.listEnvironmentsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListEnvironmentsResponse
+			result2 error
+		})
+	}
+	fake.listEnvironmentsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListEnvironmentsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponse(arg1 context.Context, arg2 *client.ListProjectsParams, arg3 ...client.RequestEditorFn) (*client.ListProjectsResponse, error) {
+	fake.listProjectsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listProjectsWithResponseReturnsOnCall[len(fake.listProjectsWithResponseArgsForCall)]
+	fake.listProjectsWithResponseArgsForCall = append(fake.listProjectsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *client.ListProjectsParams
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListProjectsWithResponseStub
+	fakeReturns := fake.listProjectsWithResponseReturns
+	fake.recordInvocation("ListProjectsWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listProjectsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponseCallCount() int {
+	fake.listProjectsWithResponseMutex.RLock()
+	defer fake.listProjectsWithResponseMutex.RUnlock()
+	return len(fake.listProjectsWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponseCalls(stub func(context.Context, *client.ListProjectsParams, ...client.RequestEditorFn) (*client.ListProjectsResponse, error)) {
+	fake.listProjectsWithResponseMutex.Lock()
+	defer fake.listProjectsWithResponseMutex.Unlock()
+	fake.ListProjectsWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponseArgsForCall(i int) (context.Context, *client.ListProjectsParams, []client.RequestEditorFn) {
+	fake.listProjectsWithResponseMutex.RLock()
+	defer fake.listProjectsWithResponseMutex.RUnlock()
+	argsForCall := fake.listProjectsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponseReturns(result1 *client.ListProjectsResponse, result2 error) {
+	fake.listProjectsWithResponseMutex.Lock()
+	defer fake.listProjectsWithResponseMutex.Unlock()
+	fake.ListProjectsWithResponseStub = nil
+	fake.listProjectsWithResponseReturns = struct {
+		result1 *client.ListProjectsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) ListProjectsWithResponseReturnsOnCall(i int, result1 *client.ListProjectsResponse, result2 error) {
+	fake.listProjectsWithResponseMutex.Lock()
+	defer fake.listProjectsWithResponseMutex.Unlock()
+	fake.ListProjectsWithResponseStub = nil
+	if fake.listProjectsWithResponseReturnsOnCall == nil {
+		fake.listProjectsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListProjectsResponse
+			result2 error
+		})
+	}
+	fake.listProjectsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListProjectsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponse(arg1 context.Context, arg2 string, arg3 *client.RemoveResourcesFromEnvironmentParams, arg4 ...client.RequestEditorFn) (*client.RemoveResourcesFromEnvironmentResponse, error) {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.Lock()
+	ret, specificReturn := fake.removeResourcesFromEnvironmentWithResponseReturnsOnCall[len(fake.removeResourcesFromEnvironmentWithResponseArgsForCall)]
+	fake.removeResourcesFromEnvironmentWithResponseArgsForCall = append(fake.removeResourcesFromEnvironmentWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.RemoveResourcesFromEnvironmentParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveResourcesFromEnvironmentWithResponseStub
+	fakeReturns := fake.removeResourcesFromEnvironmentWithResponseReturns
+	fake.recordInvocation("RemoveResourcesFromEnvironmentWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeResourcesFromEnvironmentWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponseCallCount() int {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.RLock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.RUnlock()
+	return len(fake.removeResourcesFromEnvironmentWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponseCalls(stub func(context.Context, string, *client.RemoveResourcesFromEnvironmentParams, ...client.RequestEditorFn) (*client.RemoveResourcesFromEnvironmentResponse, error)) {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.Lock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.Unlock()
+	fake.RemoveResourcesFromEnvironmentWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponseArgsForCall(i int) (context.Context, string, *client.RemoveResourcesFromEnvironmentParams, []client.RequestEditorFn) {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.RLock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.RUnlock()
+	argsForCall := fake.removeResourcesFromEnvironmentWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponseReturns(result1 *client.RemoveResourcesFromEnvironmentResponse, result2 error) {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.Lock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.Unlock()
+	fake.RemoveResourcesFromEnvironmentWithResponseStub = nil
+	fake.removeResourcesFromEnvironmentWithResponseReturns = struct {
+		result1 *client.RemoveResourcesFromEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RemoveResourcesFromEnvironmentWithResponseReturnsOnCall(i int, result1 *client.RemoveResourcesFromEnvironmentResponse, result2 error) {
+	fake.removeResourcesFromEnvironmentWithResponseMutex.Lock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.Unlock()
+	fake.RemoveResourcesFromEnvironmentWithResponseStub = nil
+	if fake.removeResourcesFromEnvironmentWithResponseReturnsOnCall == nil {
+		fake.removeResourcesFromEnvironmentWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RemoveResourcesFromEnvironmentResponse
+			result2 error
+		})
+	}
+	fake.removeResourcesFromEnvironmentWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RemoveResourcesFromEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveEnvironmentResponse, error) {
+	fake.retrieveEnvironmentWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveEnvironmentWithResponseReturnsOnCall[len(fake.retrieveEnvironmentWithResponseArgsForCall)]
+	fake.retrieveEnvironmentWithResponseArgsForCall = append(fake.retrieveEnvironmentWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveEnvironmentWithResponseStub
+	fakeReturns := fake.retrieveEnvironmentWithResponseReturns
+	fake.recordInvocation("RetrieveEnvironmentWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveEnvironmentWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponseCallCount() int {
+	fake.retrieveEnvironmentWithResponseMutex.RLock()
+	defer fake.retrieveEnvironmentWithResponseMutex.RUnlock()
+	return len(fake.retrieveEnvironmentWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveEnvironmentResponse, error)) {
+	fake.retrieveEnvironmentWithResponseMutex.Lock()
+	defer fake.retrieveEnvironmentWithResponseMutex.Unlock()
+	fake.RetrieveEnvironmentWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveEnvironmentWithResponseMutex.RLock()
+	defer fake.retrieveEnvironmentWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveEnvironmentWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponseReturns(result1 *client.RetrieveEnvironmentResponse, result2 error) {
+	fake.retrieveEnvironmentWithResponseMutex.Lock()
+	defer fake.retrieveEnvironmentWithResponseMutex.Unlock()
+	fake.RetrieveEnvironmentWithResponseStub = nil
+	fake.retrieveEnvironmentWithResponseReturns = struct {
+		result1 *client.RetrieveEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveEnvironmentWithResponseReturnsOnCall(i int, result1 *client.RetrieveEnvironmentResponse, result2 error) {
+	fake.retrieveEnvironmentWithResponseMutex.Lock()
+	defer fake.retrieveEnvironmentWithResponseMutex.Unlock()
+	fake.RetrieveEnvironmentWithResponseStub = nil
+	if fake.retrieveEnvironmentWithResponseReturnsOnCall == nil {
+		fake.retrieveEnvironmentWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveEnvironmentResponse
+			result2 error
+		})
+	}
+	fake.retrieveEnvironmentWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveEnvironmentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveProjectResponse, error) {
+	fake.retrieveProjectWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveProjectWithResponseReturnsOnCall[len(fake.retrieveProjectWithResponseArgsForCall)]
+	fake.retrieveProjectWithResponseArgsForCall = append(fake.retrieveProjectWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveProjectWithResponseStub
+	fakeReturns := fake.retrieveProjectWithResponseReturns
+	fake.recordInvocation("RetrieveProjectWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveProjectWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponseCallCount() int {
+	fake.retrieveProjectWithResponseMutex.RLock()
+	defer fake.retrieveProjectWithResponseMutex.RUnlock()
+	return len(fake.retrieveProjectWithResponseArgsForCall)
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveProjectResponse, error)) {
+	fake.retrieveProjectWithResponseMutex.Lock()
+	defer fake.retrieveProjectWithResponseMutex.Unlock()
+	fake.RetrieveProjectWithResponseStub = stub
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveProjectWithResponseMutex.RLock()
+	defer fake.retrieveProjectWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveProjectWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponseReturns(result1 *client.RetrieveProjectResponse, result2 error) {
+	fake.retrieveProjectWithResponseMutex.Lock()
+	defer fake.retrieveProjectWithResponseMutex.Unlock()
+	fake.RetrieveProjectWithResponseStub = nil
+	fake.retrieveProjectWithResponseReturns = struct {
+		result1 *client.RetrieveProjectResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) RetrieveProjectWithResponseReturnsOnCall(i int, result1 *client.RetrieveProjectResponse, result2 error) {
+	fake.retrieveProjectWithResponseMutex.Lock()
+	defer fake.retrieveProjectWithResponseMutex.Unlock()
+	fake.RetrieveProjectWithResponseStub = nil
+	if fake.retrieveProjectWithResponseReturnsOnCall == nil {
+		fake.retrieveProjectWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveProjectResponse
+			result2 error
+		})
+	}
+	fake.retrieveProjectWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveProjectResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnvironmentRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addResourcesToEnvironmentWithResponseMutex.RLock()
+	defer fake.addResourcesToEnvironmentWithResponseMutex.RUnlock()
+	fake.createEnvironmentWithResponseMutex.RLock()
+	defer fake.createEnvironmentWithResponseMutex.RUnlock()
+	fake.createProjectWithResponseMutex.RLock()
+	defer fake.createProjectWithResponseMutex.RUnlock()
+	fake.listEnvironmentsWithResponseMutex.RLock()
+	defer fake.listEnvironmentsWithResponseMutex.RUnlock()
+	fake.listProjectsWithResponseMutex.RLock()
+	defer fake.listProjectsWithResponseMutex.RUnlock()
+	fake.removeResourcesFromEnvironmentWithResponseMutex.RLock()
+	defer fake.removeResourcesFromEnvironmentWithResponseMutex.RUnlock()
+	fake.retrieveEnvironmentWithResponseMutex.RLock()
+	defer fake.retrieveEnvironmentWithResponseMutex.RUnlock()
+	fake.retrieveProjectWithResponseMutex.RLock()
+	defer fake.retrieveProjectWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEnvironmentRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
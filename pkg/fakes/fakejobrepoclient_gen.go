@@ -0,0 +1,460 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	clienta "github.com/render-oss/render-mcp-server/pkg/client/jobs"
+)
+
+type FakeJobRepoClient struct {
+	CancelJobWithResponseStub        func(context.Context, client.ServiceIdParam, clienta.JobId, ...client.RequestEditorFn) (*client.CancelJobResponse, error)
+	cancelJobWithResponseMutex       sync.RWMutex
+	cancelJobWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 clienta.JobId
+		arg4 []client.RequestEditorFn
+	}
+	cancelJobWithResponseReturns struct {
+		result1 *client.CancelJobResponse
+		result2 error
+	}
+	cancelJobWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CancelJobResponse
+		result2 error
+	}
+	ListJobWithResponseStub        func(context.Context, client.ServiceIdParam, *client.ListJobParams, ...client.RequestEditorFn) (*client.ListJobResponse, error)
+	listJobWithResponseMutex       sync.RWMutex
+	listJobWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 *client.ListJobParams
+		arg4 []client.RequestEditorFn
+	}
+	listJobWithResponseReturns struct {
+		result1 *client.ListJobResponse
+		result2 error
+	}
+	listJobWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListJobResponse
+		result2 error
+	}
+	PostJobWithResponseStub        func(context.Context, client.ServiceIdParam, client.PostJobJSONRequestBody, ...client.RequestEditorFn) (*client.PostJobResponse, error)
+	postJobWithResponseMutex       sync.RWMutex
+	postJobWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 client.PostJobJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	postJobWithResponseReturns struct {
+		result1 *client.PostJobResponse
+		result2 error
+	}
+	postJobWithResponseReturnsOnCall map[int]struct {
+		result1 *client.PostJobResponse
+		result2 error
+	}
+	RetrieveJobWithResponseStub        func(context.Context, client.ServiceIdParam, clienta.JobId, ...client.RequestEditorFn) (*client.RetrieveJobResponse, error)
+	retrieveJobWithResponseMutex       sync.RWMutex
+	retrieveJobWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 clienta.JobId
+		arg4 []client.RequestEditorFn
+	}
+	retrieveJobWithResponseReturns struct {
+		result1 *client.RetrieveJobResponse
+		result2 error
+	}
+	retrieveJobWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveJobResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponse(arg1 context.Context, arg2 client.ServiceIdParam, arg3 clienta.JobId, arg4 ...client.RequestEditorFn) (*client.CancelJobResponse, error) {
+	fake.cancelJobWithResponseMutex.Lock()
+	ret, specificReturn := fake.cancelJobWithResponseReturnsOnCall[len(fake.cancelJobWithResponseArgsForCall)]
+	fake.cancelJobWithResponseArgsForCall = append(fake.cancelJobWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 clienta.JobId
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CancelJobWithResponseStub
+	fakeReturns := fake.cancelJobWithResponseReturns
+	fake.recordInvocation("CancelJobWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cancelJobWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponseCallCount() int {
+	fake.cancelJobWithResponseMutex.RLock()
+	defer fake.cancelJobWithResponseMutex.RUnlock()
+	return len(fake.cancelJobWithResponseArgsForCall)
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponseCalls(stub func(context.Context, client.ServiceIdParam, clienta.JobId, ...client.RequestEditorFn) (*client.CancelJobResponse, error)) {
+	fake.cancelJobWithResponseMutex.Lock()
+	defer fake.cancelJobWithResponseMutex.Unlock()
+	fake.CancelJobWithResponseStub = stub
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponseArgsForCall(i int) (context.Context, client.ServiceIdParam, clienta.JobId, []client.RequestEditorFn) {
+	fake.cancelJobWithResponseMutex.RLock()
+	defer fake.cancelJobWithResponseMutex.RUnlock()
+	argsForCall := fake.cancelJobWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponseReturns(result1 *client.CancelJobResponse, result2 error) {
+	fake.cancelJobWithResponseMutex.Lock()
+	defer fake.cancelJobWithResponseMutex.Unlock()
+	fake.CancelJobWithResponseStub = nil
+	fake.cancelJobWithResponseReturns = struct {
+		result1 *client.CancelJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) CancelJobWithResponseReturnsOnCall(i int, result1 *client.CancelJobResponse, result2 error) {
+	fake.cancelJobWithResponseMutex.Lock()
+	defer fake.cancelJobWithResponseMutex.Unlock()
+	fake.CancelJobWithResponseStub = nil
+	if fake.cancelJobWithResponseReturnsOnCall == nil {
+		fake.cancelJobWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CancelJobResponse
+			result2 error
+		})
+	}
+	fake.cancelJobWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CancelJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponse(arg1 context.Context, arg2 client.ServiceIdParam, arg3 *client.ListJobParams, arg4 ...client.RequestEditorFn) (*client.ListJobResponse, error) {
+	fake.listJobWithResponseMutex.Lock()
+	ret, specificReturn := fake.listJobWithResponseReturnsOnCall[len(fake.listJobWithResponseArgsForCall)]
+	fake.listJobWithResponseArgsForCall = append(fake.listJobWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 *client.ListJobParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListJobWithResponseStub
+	fakeReturns := fake.listJobWithResponseReturns
+	fake.recordInvocation("ListJobWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listJobWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponseCallCount() int {
+	fake.listJobWithResponseMutex.RLock()
+	defer fake.listJobWithResponseMutex.RUnlock()
+	return len(fake.listJobWithResponseArgsForCall)
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponseCalls(stub func(context.Context, client.ServiceIdParam, *client.ListJobParams, ...client.RequestEditorFn) (*client.ListJobResponse, error)) {
+	fake.listJobWithResponseMutex.Lock()
+	defer fake.listJobWithResponseMutex.Unlock()
+	fake.ListJobWithResponseStub = stub
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponseArgsForCall(i int) (context.Context, client.ServiceIdParam, *client.ListJobParams, []client.RequestEditorFn) {
+	fake.listJobWithResponseMutex.RLock()
+	defer fake.listJobWithResponseMutex.RUnlock()
+	argsForCall := fake.listJobWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponseReturns(result1 *client.ListJobResponse, result2 error) {
+	fake.listJobWithResponseMutex.Lock()
+	defer fake.listJobWithResponseMutex.Unlock()
+	fake.ListJobWithResponseStub = nil
+	fake.listJobWithResponseReturns = struct {
+		result1 *client.ListJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) ListJobWithResponseReturnsOnCall(i int, result1 *client.ListJobResponse, result2 error) {
+	fake.listJobWithResponseMutex.Lock()
+	defer fake.listJobWithResponseMutex.Unlock()
+	fake.ListJobWithResponseStub = nil
+	if fake.listJobWithResponseReturnsOnCall == nil {
+		fake.listJobWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListJobResponse
+			result2 error
+		})
+	}
+	fake.listJobWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponse(arg1 context.Context, arg2 client.ServiceIdParam, arg3 client.PostJobJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.PostJobResponse, error) {
+	fake.postJobWithResponseMutex.Lock()
+	ret, specificReturn := fake.postJobWithResponseReturnsOnCall[len(fake.postJobWithResponseArgsForCall)]
+	fake.postJobWithResponseArgsForCall = append(fake.postJobWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 client.PostJobJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PostJobWithResponseStub
+	fakeReturns := fake.postJobWithResponseReturns
+	fake.recordInvocation("PostJobWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.postJobWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponseCallCount() int {
+	fake.postJobWithResponseMutex.RLock()
+	defer fake.postJobWithResponseMutex.RUnlock()
+	return len(fake.postJobWithResponseArgsForCall)
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponseCalls(stub func(context.Context, client.ServiceIdParam, client.PostJobJSONRequestBody, ...client.RequestEditorFn) (*client.PostJobResponse, error)) {
+	fake.postJobWithResponseMutex.Lock()
+	defer fake.postJobWithResponseMutex.Unlock()
+	fake.PostJobWithResponseStub = stub
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponseArgsForCall(i int) (context.Context, client.ServiceIdParam, client.PostJobJSONRequestBody, []client.RequestEditorFn) {
+	fake.postJobWithResponseMutex.RLock()
+	defer fake.postJobWithResponseMutex.RUnlock()
+	argsForCall := fake.postJobWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponseReturns(result1 *client.PostJobResponse, result2 error) {
+	fake.postJobWithResponseMutex.Lock()
+	defer fake.postJobWithResponseMutex.Unlock()
+	fake.PostJobWithResponseStub = nil
+	fake.postJobWithResponseReturns = struct {
+		result1 *client.PostJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) PostJobWithResponseReturnsOnCall(i int, result1 *client.PostJobResponse, result2 error) {
+	fake.postJobWithResponseMutex.Lock()
+	defer fake.postJobWithResponseMutex.Unlock()
+	fake.PostJobWithResponseStub = nil
+	if fake.postJobWithResponseReturnsOnCall == nil {
+		fake.postJobWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.PostJobResponse
+			result2 error
+		})
+	}
+	fake.postJobWithResponseReturnsOnCall[i] = struct {
+		result1 *client.PostJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponse(arg1 context.Context, arg2 client.ServiceIdParam, arg3 clienta.JobId, arg4 ...client.RequestEditorFn) (*client.RetrieveJobResponse, error) {
+	fake.retrieveJobWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveJobWithResponseReturnsOnCall[len(fake.retrieveJobWithResponseArgsForCall)]
+	fake.retrieveJobWithResponseArgsForCall = append(fake.retrieveJobWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.ServiceIdParam
+		arg3 clienta.JobId
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RetrieveJobWithResponseStub
+	fakeReturns := fake.retrieveJobWithResponseReturns
+	fake.recordInvocation("RetrieveJobWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveJobWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponseCallCount() int {
+	fake.retrieveJobWithResponseMutex.RLock()
+	defer fake.retrieveJobWithResponseMutex.RUnlock()
+	return len(fake.retrieveJobWithResponseArgsForCall)
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponseCalls(stub func(context.Context, client.ServiceIdParam, clienta.JobId, ...client.RequestEditorFn) (*client.RetrieveJobResponse, error)) {
+	fake.retrieveJobWithResponseMutex.Lock()
+	defer fake.retrieveJobWithResponseMutex.Unlock()
+	fake.RetrieveJobWithResponseStub = stub
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponseArgsForCall(i int) (context.Context, client.ServiceIdParam, clienta.JobId, []client.RequestEditorFn) {
+	fake.retrieveJobWithResponseMutex.RLock()
+	defer fake.retrieveJobWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveJobWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponseReturns(result1 *client.RetrieveJobResponse, result2 error) {
+	fake.retrieveJobWithResponseMutex.Lock()
+	defer fake.retrieveJobWithResponseMutex.Unlock()
+	fake.RetrieveJobWithResponseStub = nil
+	fake.retrieveJobWithResponseReturns = struct {
+		result1 *client.RetrieveJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) RetrieveJobWithResponseReturnsOnCall(i int, result1 *client.RetrieveJobResponse, result2 error) {
+	fake.retrieveJobWithResponseMutex.Lock()
+	defer fake.retrieveJobWithResponseMutex.Unlock()
+	fake.RetrieveJobWithResponseStub = nil
+	if fake.retrieveJobWithResponseReturnsOnCall == nil {
+		fake.retrieveJobWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveJobResponse
+			result2 error
+		})
+	}
+	fake.retrieveJobWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cancelJobWithResponseMutex.RLock()
+	defer fake.cancelJobWithResponseMutex.RUnlock()
+	fake.listJobWithResponseMutex.RLock()
+	defer fake.listJobWithResponseMutex.RUnlock()
+	fake.postJobWithResponseMutex.RLock()
+	defer fake.postJobWithResponseMutex.RUnlock()
+	fake.retrieveJobWithResponseMutex.RLock()
+	defer fake.retrieveJobWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeJobRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -24,6 +24,21 @@ type FakeKeyValueRepoClient struct {
 		result1 *client.CreateKeyValueResponse
 		result2 error
 	}
+	DeleteKeyValueWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteKeyValueResponse, error)
+	deleteKeyValueWithResponseMutex       sync.RWMutex
+	deleteKeyValueWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	deleteKeyValueWithResponseReturns struct {
+		result1 *client.DeleteKeyValueResponse
+		result2 error
+	}
+	deleteKeyValueWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteKeyValueResponse
+		result2 error
+	}
 	ListKeyValueWithResponseStub        func(context.Context, *client.ListKeyValueParams, ...client.RequestEditorFn) (*client.ListKeyValueResponse, error)
 	listKeyValueWithResponseMutex       sync.RWMutex
 	listKeyValueWithResponseArgsForCall []struct {
@@ -69,6 +84,22 @@ type FakeKeyValueRepoClient struct {
 		result1 *client.RetrieveKeyValueResponse
 		result2 error
 	}
+	UpdateKeyValueWithResponseStub        func(context.Context, string, client.UpdateKeyValueJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateKeyValueResponse, error)
+	updateKeyValueWithResponseMutex       sync.RWMutex
+	updateKeyValueWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateKeyValueJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	updateKeyValueWithResponseReturns struct {
+		result1 *client.UpdateKeyValueResponse
+		result2 error
+	}
+	updateKeyValueWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateKeyValueResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -139,6 +170,72 @@ func (fake *FakeKeyValueRepoClient) CreateKeyValueWithResponseReturnsOnCall(i in
 	}{result1, result2}
 }
 
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.DeleteKeyValueResponse, error) {
+	fake.deleteKeyValueWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteKeyValueWithResponseReturnsOnCall[len(fake.deleteKeyValueWithResponseArgsForCall)]
+	fake.deleteKeyValueWithResponseArgsForCall = append(fake.deleteKeyValueWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteKeyValueWithResponseStub
+	fakeReturns := fake.deleteKeyValueWithResponseReturns
+	fake.recordInvocation("DeleteKeyValueWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deleteKeyValueWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponseCallCount() int {
+	fake.deleteKeyValueWithResponseMutex.RLock()
+	defer fake.deleteKeyValueWithResponseMutex.RUnlock()
+	return len(fake.deleteKeyValueWithResponseArgsForCall)
+}
+
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteKeyValueResponse, error)) {
+	fake.deleteKeyValueWithResponseMutex.Lock()
+	defer fake.deleteKeyValueWithResponseMutex.Unlock()
+	fake.DeleteKeyValueWithResponseStub = stub
+}
+
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.deleteKeyValueWithResponseMutex.RLock()
+	defer fake.deleteKeyValueWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteKeyValueWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponseReturns(result1 *client.DeleteKeyValueResponse, result2 error) {
+	fake.deleteKeyValueWithResponseMutex.Lock()
+	defer fake.deleteKeyValueWithResponseMutex.Unlock()
+	fake.DeleteKeyValueWithResponseStub = nil
+	fake.deleteKeyValueWithResponseReturns = struct {
+		result1 *client.DeleteKeyValueResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyValueRepoClient) DeleteKeyValueWithResponseReturnsOnCall(i int, result1 *client.DeleteKeyValueResponse, result2 error) {
+	fake.deleteKeyValueWithResponseMutex.Lock()
+	defer fake.deleteKeyValueWithResponseMutex.Unlock()
+	fake.DeleteKeyValueWithResponseStub = nil
+	if fake.deleteKeyValueWithResponseReturnsOnCall == nil {
+		fake.deleteKeyValueWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteKeyValueResponse
+			result2 error
+		})
+	}
+	fake.deleteKeyValueWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteKeyValueResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeKeyValueRepoClient) ListKeyValueWithResponse(arg1 context.Context, arg2 *client.ListKeyValueParams, arg3 ...client.RequestEditorFn) (*client.ListKeyValueResponse, error) {
 	fake.listKeyValueWithResponseMutex.Lock()
 	ret, specificReturn := fake.listKeyValueWithResponseReturnsOnCall[len(fake.listKeyValueWithResponseArgsForCall)]
@@ -337,17 +434,88 @@ func (fake *FakeKeyValueRepoClient) RetrieveKeyValueWithResponseReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponse(arg1 context.Context, arg2 string, arg3 client.UpdateKeyValueJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.UpdateKeyValueResponse, error) {
+	fake.updateKeyValueWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateKeyValueWithResponseReturnsOnCall[len(fake.updateKeyValueWithResponseArgsForCall)]
+	fake.updateKeyValueWithResponseArgsForCall = append(fake.updateKeyValueWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateKeyValueJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateKeyValueWithResponseStub
+	fakeReturns := fake.updateKeyValueWithResponseReturns
+	fake.recordInvocation("UpdateKeyValueWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateKeyValueWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponseCallCount() int {
+	fake.updateKeyValueWithResponseMutex.RLock()
+	defer fake.updateKeyValueWithResponseMutex.RUnlock()
+	return len(fake.updateKeyValueWithResponseArgsForCall)
+}
+
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponseCalls(stub func(context.Context, string, client.UpdateKeyValueJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateKeyValueResponse, error)) {
+	fake.updateKeyValueWithResponseMutex.Lock()
+	defer fake.updateKeyValueWithResponseMutex.Unlock()
+	fake.UpdateKeyValueWithResponseStub = stub
+}
+
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponseArgsForCall(i int) (context.Context, string, client.UpdateKeyValueJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateKeyValueWithResponseMutex.RLock()
+	defer fake.updateKeyValueWithResponseMutex.RUnlock()
+	argsForCall := fake.updateKeyValueWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponseReturns(result1 *client.UpdateKeyValueResponse, result2 error) {
+	fake.updateKeyValueWithResponseMutex.Lock()
+	defer fake.updateKeyValueWithResponseMutex.Unlock()
+	fake.UpdateKeyValueWithResponseStub = nil
+	fake.updateKeyValueWithResponseReturns = struct {
+		result1 *client.UpdateKeyValueResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyValueRepoClient) UpdateKeyValueWithResponseReturnsOnCall(i int, result1 *client.UpdateKeyValueResponse, result2 error) {
+	fake.updateKeyValueWithResponseMutex.Lock()
+	defer fake.updateKeyValueWithResponseMutex.Unlock()
+	fake.UpdateKeyValueWithResponseStub = nil
+	if fake.updateKeyValueWithResponseReturnsOnCall == nil {
+		fake.updateKeyValueWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateKeyValueResponse
+			result2 error
+		})
+	}
+	fake.updateKeyValueWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateKeyValueResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeKeyValueRepoClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createKeyValueWithResponseMutex.RLock()
 	defer fake.createKeyValueWithResponseMutex.RUnlock()
+	fake.deleteKeyValueWithResponseMutex.RLock()
+	defer fake.deleteKeyValueWithResponseMutex.RUnlock()
 	fake.listKeyValueWithResponseMutex.RLock()
 	defer fake.listKeyValueWithResponseMutex.RUnlock()
 	fake.retrieveKeyValueConnectionInfoWithResponseMutex.RLock()
 	defer fake.retrieveKeyValueConnectionInfoWithResponseMutex.RUnlock()
 	fake.retrieveKeyValueWithResponseMutex.RLock()
 	defer fake.retrieveKeyValueWithResponseMutex.RUnlock()
+	fake.updateKeyValueWithResponseMutex.RLock()
+	defer fake.updateKeyValueWithResponseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,13 +3,27 @@ package fakes
 
 import (
 	"context"
-	"net/http"
 	"sync"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
 )
 
 type FakePostgresRepoClient struct {
+	CreatePostgresExportWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.CreatePostgresExportResponse, error)
+	createPostgresExportWithResponseMutex       sync.RWMutex
+	createPostgresExportWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	createPostgresExportWithResponseReturns struct {
+		result1 *client.CreatePostgresExportResponse
+		result2 error
+	}
+	createPostgresExportWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreatePostgresExportResponse
+		result2 error
+	}
 	CreatePostgresWithResponseStub        func(context.Context, client.PostgresPOSTInput, ...client.RequestEditorFn) (*client.CreatePostgresResponse, error)
 	createPostgresWithResponseMutex       sync.RWMutex
 	createPostgresWithResponseArgsForCall []struct {
@@ -25,6 +39,51 @@ type FakePostgresRepoClient struct {
 		result1 *client.CreatePostgresResponse
 		result2 error
 	}
+	DeletePostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.DeletePostgresResponse, error)
+	deletePostgresWithResponseMutex       sync.RWMutex
+	deletePostgresWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	deletePostgresWithResponseReturns struct {
+		result1 *client.DeletePostgresResponse
+		result2 error
+	}
+	deletePostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeletePostgresResponse
+		result2 error
+	}
+	FailoverPostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.FailoverPostgresResponse, error)
+	failoverPostgresWithResponseMutex       sync.RWMutex
+	failoverPostgresWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	failoverPostgresWithResponseReturns struct {
+		result1 *client.FailoverPostgresResponse
+		result2 error
+	}
+	failoverPostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.FailoverPostgresResponse
+		result2 error
+	}
+	ListPostgresExportWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.ListPostgresExportResponse, error)
+	listPostgresExportWithResponseMutex       sync.RWMutex
+	listPostgresExportWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	listPostgresExportWithResponseReturns struct {
+		result1 *client.ListPostgresExportResponse
+		result2 error
+	}
+	listPostgresExportWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListPostgresExportResponse
+		result2 error
+	}
 	ListPostgresWithResponseStub        func(context.Context, *client.ListPostgresParams, ...client.RequestEditorFn) (*client.ListPostgresResponse, error)
 	listPostgresWithResponseMutex       sync.RWMutex
 	listPostgresWithResponseArgsForCall []struct {
@@ -40,19 +99,50 @@ type FakePostgresRepoClient struct {
 		result1 *client.ListPostgresResponse
 		result2 error
 	}
-	RestartPostgresStub        func(context.Context, string, ...client.RequestEditorFn) (*http.Response, error)
-	restartPostgresMutex       sync.RWMutex
-	restartPostgresArgsForCall []struct {
+	RecoverPostgresWithResponseStub        func(context.Context, string, client.RecoverPostgresJSONRequestBody, ...client.RequestEditorFn) (*client.RecoverPostgresResponse, error)
+	recoverPostgresWithResponseMutex       sync.RWMutex
+	recoverPostgresWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RecoverPostgresJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	recoverPostgresWithResponseReturns struct {
+		result1 *client.RecoverPostgresResponse
+		result2 error
+	}
+	recoverPostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RecoverPostgresResponse
+		result2 error
+	}
+	RestartPostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RestartPostgresResponse, error)
+	restartPostgresWithResponseMutex       sync.RWMutex
+	restartPostgresWithResponseArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 []client.RequestEditorFn
 	}
-	restartPostgresReturns struct {
-		result1 *http.Response
+	restartPostgresWithResponseReturns struct {
+		result1 *client.RestartPostgresResponse
 		result2 error
 	}
-	restartPostgresReturnsOnCall map[int]struct {
-		result1 *http.Response
+	restartPostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RestartPostgresResponse
+		result2 error
+	}
+	ResumePostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.ResumePostgresResponse, error)
+	resumePostgresWithResponseMutex       sync.RWMutex
+	resumePostgresWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	resumePostgresWithResponseReturns struct {
+		result1 *client.ResumePostgresResponse
+		result2 error
+	}
+	resumePostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ResumePostgresResponse
 		result2 error
 	}
 	RetrievePostgresConnectionInfoWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrievePostgresConnectionInfoResponse, error)
@@ -70,6 +160,21 @@ type FakePostgresRepoClient struct {
 		result1 *client.RetrievePostgresConnectionInfoResponse
 		result2 error
 	}
+	RetrievePostgresRecoveryInfoWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrievePostgresRecoveryInfoResponse, error)
+	retrievePostgresRecoveryInfoWithResponseMutex       sync.RWMutex
+	retrievePostgresRecoveryInfoWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrievePostgresRecoveryInfoWithResponseReturns struct {
+		result1 *client.RetrievePostgresRecoveryInfoResponse
+		result2 error
+	}
+	retrievePostgresRecoveryInfoWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrievePostgresRecoveryInfoResponse
+		result2 error
+	}
 	RetrievePostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrievePostgresResponse, error)
 	retrievePostgresWithResponseMutex       sync.RWMutex
 	retrievePostgresWithResponseArgsForCall []struct {
@@ -85,10 +190,91 @@ type FakePostgresRepoClient struct {
 		result1 *client.RetrievePostgresResponse
 		result2 error
 	}
+	SuspendPostgresWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.SuspendPostgresResponse, error)
+	suspendPostgresWithResponseMutex       sync.RWMutex
+	suspendPostgresWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	suspendPostgresWithResponseReturns struct {
+		result1 *client.SuspendPostgresResponse
+		result2 error
+	}
+	suspendPostgresWithResponseReturnsOnCall map[int]struct {
+		result1 *client.SuspendPostgresResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.CreatePostgresExportResponse, error) {
+	fake.createPostgresExportWithResponseMutex.Lock()
+	ret, specificReturn := fake.createPostgresExportWithResponseReturnsOnCall[len(fake.createPostgresExportWithResponseArgsForCall)]
+	fake.createPostgresExportWithResponseArgsForCall = append(fake.createPostgresExportWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CreatePostgresExportWithResponseStub
+	fakeReturns := fake.createPostgresExportWithResponseReturns
+	fake.recordInvocation("CreatePostgresExportWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.createPostgresExportWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponseCallCount() int {
+	fake.createPostgresExportWithResponseMutex.RLock()
+	defer fake.createPostgresExportWithResponseMutex.RUnlock()
+	return len(fake.createPostgresExportWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.CreatePostgresExportResponse, error)) {
+	fake.createPostgresExportWithResponseMutex.Lock()
+	defer fake.createPostgresExportWithResponseMutex.Unlock()
+	fake.CreatePostgresExportWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.createPostgresExportWithResponseMutex.RLock()
+	defer fake.createPostgresExportWithResponseMutex.RUnlock()
+	argsForCall := fake.createPostgresExportWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponseReturns(result1 *client.CreatePostgresExportResponse, result2 error) {
+	fake.createPostgresExportWithResponseMutex.Lock()
+	defer fake.createPostgresExportWithResponseMutex.Unlock()
+	fake.CreatePostgresExportWithResponseStub = nil
+	fake.createPostgresExportWithResponseReturns = struct {
+		result1 *client.CreatePostgresExportResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) CreatePostgresExportWithResponseReturnsOnCall(i int, result1 *client.CreatePostgresExportResponse, result2 error) {
+	fake.createPostgresExportWithResponseMutex.Lock()
+	defer fake.createPostgresExportWithResponseMutex.Unlock()
+	fake.CreatePostgresExportWithResponseStub = nil
+	if fake.createPostgresExportWithResponseReturnsOnCall == nil {
+		fake.createPostgresExportWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreatePostgresExportResponse
+			result2 error
+		})
+	}
+	fake.createPostgresExportWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreatePostgresExportResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePostgresRepoClient) CreatePostgresWithResponse(arg1 context.Context, arg2 client.PostgresPOSTInput, arg3 ...client.RequestEditorFn) (*client.CreatePostgresResponse, error) {
 	fake.createPostgresWithResponseMutex.Lock()
 	ret, specificReturn := fake.createPostgresWithResponseReturnsOnCall[len(fake.createPostgresWithResponseArgsForCall)]
@@ -155,6 +341,204 @@ func (fake *FakePostgresRepoClient) CreatePostgresWithResponseReturnsOnCall(i in
 	}{result1, result2}
 }
 
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.DeletePostgresResponse, error) {
+	fake.deletePostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.deletePostgresWithResponseReturnsOnCall[len(fake.deletePostgresWithResponseArgsForCall)]
+	fake.deletePostgresWithResponseArgsForCall = append(fake.deletePostgresWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeletePostgresWithResponseStub
+	fakeReturns := fake.deletePostgresWithResponseReturns
+	fake.recordInvocation("DeletePostgresWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deletePostgresWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponseCallCount() int {
+	fake.deletePostgresWithResponseMutex.RLock()
+	defer fake.deletePostgresWithResponseMutex.RUnlock()
+	return len(fake.deletePostgresWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.DeletePostgresResponse, error)) {
+	fake.deletePostgresWithResponseMutex.Lock()
+	defer fake.deletePostgresWithResponseMutex.Unlock()
+	fake.DeletePostgresWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.deletePostgresWithResponseMutex.RLock()
+	defer fake.deletePostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.deletePostgresWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponseReturns(result1 *client.DeletePostgresResponse, result2 error) {
+	fake.deletePostgresWithResponseMutex.Lock()
+	defer fake.deletePostgresWithResponseMutex.Unlock()
+	fake.DeletePostgresWithResponseStub = nil
+	fake.deletePostgresWithResponseReturns = struct {
+		result1 *client.DeletePostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) DeletePostgresWithResponseReturnsOnCall(i int, result1 *client.DeletePostgresResponse, result2 error) {
+	fake.deletePostgresWithResponseMutex.Lock()
+	defer fake.deletePostgresWithResponseMutex.Unlock()
+	fake.DeletePostgresWithResponseStub = nil
+	if fake.deletePostgresWithResponseReturnsOnCall == nil {
+		fake.deletePostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeletePostgresResponse
+			result2 error
+		})
+	}
+	fake.deletePostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeletePostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.FailoverPostgresResponse, error) {
+	fake.failoverPostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.failoverPostgresWithResponseReturnsOnCall[len(fake.failoverPostgresWithResponseArgsForCall)]
+	fake.failoverPostgresWithResponseArgsForCall = append(fake.failoverPostgresWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.FailoverPostgresWithResponseStub
+	fakeReturns := fake.failoverPostgresWithResponseReturns
+	fake.recordInvocation("FailoverPostgresWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.failoverPostgresWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponseCallCount() int {
+	fake.failoverPostgresWithResponseMutex.RLock()
+	defer fake.failoverPostgresWithResponseMutex.RUnlock()
+	return len(fake.failoverPostgresWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.FailoverPostgresResponse, error)) {
+	fake.failoverPostgresWithResponseMutex.Lock()
+	defer fake.failoverPostgresWithResponseMutex.Unlock()
+	fake.FailoverPostgresWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.failoverPostgresWithResponseMutex.RLock()
+	defer fake.failoverPostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.failoverPostgresWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponseReturns(result1 *client.FailoverPostgresResponse, result2 error) {
+	fake.failoverPostgresWithResponseMutex.Lock()
+	defer fake.failoverPostgresWithResponseMutex.Unlock()
+	fake.FailoverPostgresWithResponseStub = nil
+	fake.failoverPostgresWithResponseReturns = struct {
+		result1 *client.FailoverPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) FailoverPostgresWithResponseReturnsOnCall(i int, result1 *client.FailoverPostgresResponse, result2 error) {
+	fake.failoverPostgresWithResponseMutex.Lock()
+	defer fake.failoverPostgresWithResponseMutex.Unlock()
+	fake.FailoverPostgresWithResponseStub = nil
+	if fake.failoverPostgresWithResponseReturnsOnCall == nil {
+		fake.failoverPostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.FailoverPostgresResponse
+			result2 error
+		})
+	}
+	fake.failoverPostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.FailoverPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.ListPostgresExportResponse, error) {
+	fake.listPostgresExportWithResponseMutex.Lock()
+	ret, specificReturn := fake.listPostgresExportWithResponseReturnsOnCall[len(fake.listPostgresExportWithResponseArgsForCall)]
+	fake.listPostgresExportWithResponseArgsForCall = append(fake.listPostgresExportWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListPostgresExportWithResponseStub
+	fakeReturns := fake.listPostgresExportWithResponseReturns
+	fake.recordInvocation("ListPostgresExportWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listPostgresExportWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponseCallCount() int {
+	fake.listPostgresExportWithResponseMutex.RLock()
+	defer fake.listPostgresExportWithResponseMutex.RUnlock()
+	return len(fake.listPostgresExportWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.ListPostgresExportResponse, error)) {
+	fake.listPostgresExportWithResponseMutex.Lock()
+	defer fake.listPostgresExportWithResponseMutex.Unlock()
+	fake.ListPostgresExportWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.listPostgresExportWithResponseMutex.RLock()
+	defer fake.listPostgresExportWithResponseMutex.RUnlock()
+	argsForCall := fake.listPostgresExportWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponseReturns(result1 *client.ListPostgresExportResponse, result2 error) {
+	fake.listPostgresExportWithResponseMutex.Lock()
+	defer fake.listPostgresExportWithResponseMutex.Unlock()
+	fake.ListPostgresExportWithResponseStub = nil
+	fake.listPostgresExportWithResponseReturns = struct {
+		result1 *client.ListPostgresExportResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) ListPostgresExportWithResponseReturnsOnCall(i int, result1 *client.ListPostgresExportResponse, result2 error) {
+	fake.listPostgresExportWithResponseMutex.Lock()
+	defer fake.listPostgresExportWithResponseMutex.Unlock()
+	fake.ListPostgresExportWithResponseStub = nil
+	if fake.listPostgresExportWithResponseReturnsOnCall == nil {
+		fake.listPostgresExportWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListPostgresExportResponse
+			result2 error
+		})
+	}
+	fake.listPostgresExportWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListPostgresExportResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePostgresRepoClient) ListPostgresWithResponse(arg1 context.Context, arg2 *client.ListPostgresParams, arg3 ...client.RequestEditorFn) (*client.ListPostgresResponse, error) {
 	fake.listPostgresWithResponseMutex.Lock()
 	ret, specificReturn := fake.listPostgresWithResponseReturnsOnCall[len(fake.listPostgresWithResponseArgsForCall)]
@@ -221,18 +605,85 @@ func (fake *FakePostgresRepoClient) ListPostgresWithResponseReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgres(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*http.Response, error) {
-	fake.restartPostgresMutex.Lock()
-	ret, specificReturn := fake.restartPostgresReturnsOnCall[len(fake.restartPostgresArgsForCall)]
-	fake.restartPostgresArgsForCall = append(fake.restartPostgresArgsForCall, struct {
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponse(arg1 context.Context, arg2 string, arg3 client.RecoverPostgresJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.RecoverPostgresResponse, error) {
+	fake.recoverPostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.recoverPostgresWithResponseReturnsOnCall[len(fake.recoverPostgresWithResponseArgsForCall)]
+	fake.recoverPostgresWithResponseArgsForCall = append(fake.recoverPostgresWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.RecoverPostgresJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecoverPostgresWithResponseStub
+	fakeReturns := fake.recoverPostgresWithResponseReturns
+	fake.recordInvocation("RecoverPostgresWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recoverPostgresWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponseCallCount() int {
+	fake.recoverPostgresWithResponseMutex.RLock()
+	defer fake.recoverPostgresWithResponseMutex.RUnlock()
+	return len(fake.recoverPostgresWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponseCalls(stub func(context.Context, string, client.RecoverPostgresJSONRequestBody, ...client.RequestEditorFn) (*client.RecoverPostgresResponse, error)) {
+	fake.recoverPostgresWithResponseMutex.Lock()
+	defer fake.recoverPostgresWithResponseMutex.Unlock()
+	fake.RecoverPostgresWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponseArgsForCall(i int) (context.Context, string, client.RecoverPostgresJSONRequestBody, []client.RequestEditorFn) {
+	fake.recoverPostgresWithResponseMutex.RLock()
+	defer fake.recoverPostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.recoverPostgresWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponseReturns(result1 *client.RecoverPostgresResponse, result2 error) {
+	fake.recoverPostgresWithResponseMutex.Lock()
+	defer fake.recoverPostgresWithResponseMutex.Unlock()
+	fake.RecoverPostgresWithResponseStub = nil
+	fake.recoverPostgresWithResponseReturns = struct {
+		result1 *client.RecoverPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) RecoverPostgresWithResponseReturnsOnCall(i int, result1 *client.RecoverPostgresResponse, result2 error) {
+	fake.recoverPostgresWithResponseMutex.Lock()
+	defer fake.recoverPostgresWithResponseMutex.Unlock()
+	fake.RecoverPostgresWithResponseStub = nil
+	if fake.recoverPostgresWithResponseReturnsOnCall == nil {
+		fake.recoverPostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RecoverPostgresResponse
+			result2 error
+		})
+	}
+	fake.recoverPostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RecoverPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RestartPostgresResponse, error) {
+	fake.restartPostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.restartPostgresWithResponseReturnsOnCall[len(fake.restartPostgresWithResponseArgsForCall)]
+	fake.restartPostgresWithResponseArgsForCall = append(fake.restartPostgresWithResponseArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 []client.RequestEditorFn
 	}{arg1, arg2, arg3})
-	stub := fake.RestartPostgresStub
-	fakeReturns := fake.restartPostgresReturns
-	fake.recordInvocation("RestartPostgres", []interface{}{arg1, arg2, arg3})
-	fake.restartPostgresMutex.Unlock()
+	stub := fake.RestartPostgresWithResponseStub
+	fakeReturns := fake.restartPostgresWithResponseReturns
+	fake.recordInvocation("RestartPostgresWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.restartPostgresWithResponseMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -242,47 +693,113 @@ func (fake *FakePostgresRepoClient) RestartPostgres(arg1 context.Context, arg2 s
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgresCallCount() int {
-	fake.restartPostgresMutex.RLock()
-	defer fake.restartPostgresMutex.RUnlock()
-	return len(fake.restartPostgresArgsForCall)
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponseCallCount() int {
+	fake.restartPostgresWithResponseMutex.RLock()
+	defer fake.restartPostgresWithResponseMutex.RUnlock()
+	return len(fake.restartPostgresWithResponseArgsForCall)
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgresCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*http.Response, error)) {
-	fake.restartPostgresMutex.Lock()
-	defer fake.restartPostgresMutex.Unlock()
-	fake.RestartPostgresStub = stub
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RestartPostgresResponse, error)) {
+	fake.restartPostgresWithResponseMutex.Lock()
+	defer fake.restartPostgresWithResponseMutex.Unlock()
+	fake.RestartPostgresWithResponseStub = stub
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgresArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
-	fake.restartPostgresMutex.RLock()
-	defer fake.restartPostgresMutex.RUnlock()
-	argsForCall := fake.restartPostgresArgsForCall[i]
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.restartPostgresWithResponseMutex.RLock()
+	defer fake.restartPostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.restartPostgresWithResponseArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgresReturns(result1 *http.Response, result2 error) {
-	fake.restartPostgresMutex.Lock()
-	defer fake.restartPostgresMutex.Unlock()
-	fake.RestartPostgresStub = nil
-	fake.restartPostgresReturns = struct {
-		result1 *http.Response
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponseReturns(result1 *client.RestartPostgresResponse, result2 error) {
+	fake.restartPostgresWithResponseMutex.Lock()
+	defer fake.restartPostgresWithResponseMutex.Unlock()
+	fake.RestartPostgresWithResponseStub = nil
+	fake.restartPostgresWithResponseReturns = struct {
+		result1 *client.RestartPostgresResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePostgresRepoClient) RestartPostgresReturnsOnCall(i int, result1 *http.Response, result2 error) {
-	fake.restartPostgresMutex.Lock()
-	defer fake.restartPostgresMutex.Unlock()
-	fake.RestartPostgresStub = nil
-	if fake.restartPostgresReturnsOnCall == nil {
-		fake.restartPostgresReturnsOnCall = make(map[int]struct {
-			result1 *http.Response
+func (fake *FakePostgresRepoClient) RestartPostgresWithResponseReturnsOnCall(i int, result1 *client.RestartPostgresResponse, result2 error) {
+	fake.restartPostgresWithResponseMutex.Lock()
+	defer fake.restartPostgresWithResponseMutex.Unlock()
+	fake.RestartPostgresWithResponseStub = nil
+	if fake.restartPostgresWithResponseReturnsOnCall == nil {
+		fake.restartPostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RestartPostgresResponse
 			result2 error
 		})
 	}
-	fake.restartPostgresReturnsOnCall[i] = struct {
-		result1 *http.Response
+	fake.restartPostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RestartPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.ResumePostgresResponse, error) {
+	fake.resumePostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.resumePostgresWithResponseReturnsOnCall[len(fake.resumePostgresWithResponseArgsForCall)]
+	fake.resumePostgresWithResponseArgsForCall = append(fake.resumePostgresWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ResumePostgresWithResponseStub
+	fakeReturns := fake.resumePostgresWithResponseReturns
+	fake.recordInvocation("ResumePostgresWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.resumePostgresWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponseCallCount() int {
+	fake.resumePostgresWithResponseMutex.RLock()
+	defer fake.resumePostgresWithResponseMutex.RUnlock()
+	return len(fake.resumePostgresWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.ResumePostgresResponse, error)) {
+	fake.resumePostgresWithResponseMutex.Lock()
+	defer fake.resumePostgresWithResponseMutex.Unlock()
+	fake.ResumePostgresWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.resumePostgresWithResponseMutex.RLock()
+	defer fake.resumePostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.resumePostgresWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponseReturns(result1 *client.ResumePostgresResponse, result2 error) {
+	fake.resumePostgresWithResponseMutex.Lock()
+	defer fake.resumePostgresWithResponseMutex.Unlock()
+	fake.ResumePostgresWithResponseStub = nil
+	fake.resumePostgresWithResponseReturns = struct {
+		result1 *client.ResumePostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) ResumePostgresWithResponseReturnsOnCall(i int, result1 *client.ResumePostgresResponse, result2 error) {
+	fake.resumePostgresWithResponseMutex.Lock()
+	defer fake.resumePostgresWithResponseMutex.Unlock()
+	fake.ResumePostgresWithResponseStub = nil
+	if fake.resumePostgresWithResponseReturnsOnCall == nil {
+		fake.resumePostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ResumePostgresResponse
+			result2 error
+		})
+	}
+	fake.resumePostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ResumePostgresResponse
 		result2 error
 	}{result1, result2}
 }
@@ -353,6 +870,72 @@ func (fake *FakePostgresRepoClient) RetrievePostgresConnectionInfoWithResponseRe
 	}{result1, result2}
 }
 
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrievePostgresRecoveryInfoResponse, error) {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrievePostgresRecoveryInfoWithResponseReturnsOnCall[len(fake.retrievePostgresRecoveryInfoWithResponseArgsForCall)]
+	fake.retrievePostgresRecoveryInfoWithResponseArgsForCall = append(fake.retrievePostgresRecoveryInfoWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrievePostgresRecoveryInfoWithResponseStub
+	fakeReturns := fake.retrievePostgresRecoveryInfoWithResponseReturns
+	fake.recordInvocation("RetrievePostgresRecoveryInfoWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponseCallCount() int {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.RLock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.RUnlock()
+	return len(fake.retrievePostgresRecoveryInfoWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrievePostgresRecoveryInfoResponse, error)) {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.Lock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.Unlock()
+	fake.RetrievePostgresRecoveryInfoWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.RLock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.RUnlock()
+	argsForCall := fake.retrievePostgresRecoveryInfoWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponseReturns(result1 *client.RetrievePostgresRecoveryInfoResponse, result2 error) {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.Lock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.Unlock()
+	fake.RetrievePostgresRecoveryInfoWithResponseStub = nil
+	fake.retrievePostgresRecoveryInfoWithResponseReturns = struct {
+		result1 *client.RetrievePostgresRecoveryInfoResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) RetrievePostgresRecoveryInfoWithResponseReturnsOnCall(i int, result1 *client.RetrievePostgresRecoveryInfoResponse, result2 error) {
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.Lock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.Unlock()
+	fake.RetrievePostgresRecoveryInfoWithResponseStub = nil
+	if fake.retrievePostgresRecoveryInfoWithResponseReturnsOnCall == nil {
+		fake.retrievePostgresRecoveryInfoWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrievePostgresRecoveryInfoResponse
+			result2 error
+		})
+	}
+	fake.retrievePostgresRecoveryInfoWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrievePostgresRecoveryInfoResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePostgresRepoClient) RetrievePostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrievePostgresResponse, error) {
 	fake.retrievePostgresWithResponseMutex.Lock()
 	ret, specificReturn := fake.retrievePostgresWithResponseReturnsOnCall[len(fake.retrievePostgresWithResponseArgsForCall)]
@@ -419,19 +1002,101 @@ func (fake *FakePostgresRepoClient) RetrievePostgresWithResponseReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.SuspendPostgresResponse, error) {
+	fake.suspendPostgresWithResponseMutex.Lock()
+	ret, specificReturn := fake.suspendPostgresWithResponseReturnsOnCall[len(fake.suspendPostgresWithResponseArgsForCall)]
+	fake.suspendPostgresWithResponseArgsForCall = append(fake.suspendPostgresWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.SuspendPostgresWithResponseStub
+	fakeReturns := fake.suspendPostgresWithResponseReturns
+	fake.recordInvocation("SuspendPostgresWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.suspendPostgresWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponseCallCount() int {
+	fake.suspendPostgresWithResponseMutex.RLock()
+	defer fake.suspendPostgresWithResponseMutex.RUnlock()
+	return len(fake.suspendPostgresWithResponseArgsForCall)
+}
+
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.SuspendPostgresResponse, error)) {
+	fake.suspendPostgresWithResponseMutex.Lock()
+	defer fake.suspendPostgresWithResponseMutex.Unlock()
+	fake.SuspendPostgresWithResponseStub = stub
+}
+
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.suspendPostgresWithResponseMutex.RLock()
+	defer fake.suspendPostgresWithResponseMutex.RUnlock()
+	argsForCall := fake.suspendPostgresWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponseReturns(result1 *client.SuspendPostgresResponse, result2 error) {
+	fake.suspendPostgresWithResponseMutex.Lock()
+	defer fake.suspendPostgresWithResponseMutex.Unlock()
+	fake.SuspendPostgresWithResponseStub = nil
+	fake.suspendPostgresWithResponseReturns = struct {
+		result1 *client.SuspendPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePostgresRepoClient) SuspendPostgresWithResponseReturnsOnCall(i int, result1 *client.SuspendPostgresResponse, result2 error) {
+	fake.suspendPostgresWithResponseMutex.Lock()
+	defer fake.suspendPostgresWithResponseMutex.Unlock()
+	fake.SuspendPostgresWithResponseStub = nil
+	if fake.suspendPostgresWithResponseReturnsOnCall == nil {
+		fake.suspendPostgresWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.SuspendPostgresResponse
+			result2 error
+		})
+	}
+	fake.suspendPostgresWithResponseReturnsOnCall[i] = struct {
+		result1 *client.SuspendPostgresResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePostgresRepoClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createPostgresExportWithResponseMutex.RLock()
+	defer fake.createPostgresExportWithResponseMutex.RUnlock()
 	fake.createPostgresWithResponseMutex.RLock()
 	defer fake.createPostgresWithResponseMutex.RUnlock()
+	fake.deletePostgresWithResponseMutex.RLock()
+	defer fake.deletePostgresWithResponseMutex.RUnlock()
+	fake.failoverPostgresWithResponseMutex.RLock()
+	defer fake.failoverPostgresWithResponseMutex.RUnlock()
+	fake.listPostgresExportWithResponseMutex.RLock()
+	defer fake.listPostgresExportWithResponseMutex.RUnlock()
 	fake.listPostgresWithResponseMutex.RLock()
 	defer fake.listPostgresWithResponseMutex.RUnlock()
-	fake.restartPostgresMutex.RLock()
-	defer fake.restartPostgresMutex.RUnlock()
+	fake.recoverPostgresWithResponseMutex.RLock()
+	defer fake.recoverPostgresWithResponseMutex.RUnlock()
+	fake.restartPostgresWithResponseMutex.RLock()
+	defer fake.restartPostgresWithResponseMutex.RUnlock()
+	fake.resumePostgresWithResponseMutex.RLock()
+	defer fake.resumePostgresWithResponseMutex.RUnlock()
 	fake.retrievePostgresConnectionInfoWithResponseMutex.RLock()
 	defer fake.retrievePostgresConnectionInfoWithResponseMutex.RUnlock()
+	fake.retrievePostgresRecoveryInfoWithResponseMutex.RLock()
+	defer fake.retrievePostgresRecoveryInfoWithResponseMutex.RUnlock()
 	fake.retrievePostgresWithResponseMutex.RLock()
 	defer fake.retrievePostgresWithResponseMutex.RUnlock()
+	fake.suspendPostgresWithResponseMutex.RLock()
+	defer fake.suspendPostgresWithResponseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
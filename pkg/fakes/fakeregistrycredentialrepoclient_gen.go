@@ -0,0 +1,285 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeRegistryCredentialRepoClient struct {
+	CreateRegistryCredentialWithResponseStub        func(context.Context, client.CreateRegistryCredentialJSONRequestBody, ...client.RequestEditorFn) (*client.CreateRegistryCredentialResponse, error)
+	createRegistryCredentialWithResponseMutex       sync.RWMutex
+	createRegistryCredentialWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CreateRegistryCredentialJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}
+	createRegistryCredentialWithResponseReturns struct {
+		result1 *client.CreateRegistryCredentialResponse
+		result2 error
+	}
+	createRegistryCredentialWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CreateRegistryCredentialResponse
+		result2 error
+	}
+	DeleteRegistryCredentialWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteRegistryCredentialResponse, error)
+	deleteRegistryCredentialWithResponseMutex       sync.RWMutex
+	deleteRegistryCredentialWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	deleteRegistryCredentialWithResponseReturns struct {
+		result1 *client.DeleteRegistryCredentialResponse
+		result2 error
+	}
+	deleteRegistryCredentialWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteRegistryCredentialResponse
+		result2 error
+	}
+	ListRegistryCredentialsWithResponseStub        func(context.Context, *client.ListRegistryCredentialsParams, ...client.RequestEditorFn) (*client.ListRegistryCredentialsResponse, error)
+	listRegistryCredentialsWithResponseMutex       sync.RWMutex
+	listRegistryCredentialsWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *client.ListRegistryCredentialsParams
+		arg3 []client.RequestEditorFn
+	}
+	listRegistryCredentialsWithResponseReturns struct {
+		result1 *client.ListRegistryCredentialsResponse
+		result2 error
+	}
+	listRegistryCredentialsWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListRegistryCredentialsResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponse(arg1 context.Context, arg2 client.CreateRegistryCredentialJSONRequestBody, arg3 ...client.RequestEditorFn) (*client.CreateRegistryCredentialResponse, error) {
+	fake.createRegistryCredentialWithResponseMutex.Lock()
+	ret, specificReturn := fake.createRegistryCredentialWithResponseReturnsOnCall[len(fake.createRegistryCredentialWithResponseArgsForCall)]
+	fake.createRegistryCredentialWithResponseArgsForCall = append(fake.createRegistryCredentialWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CreateRegistryCredentialJSONRequestBody
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CreateRegistryCredentialWithResponseStub
+	fakeReturns := fake.createRegistryCredentialWithResponseReturns
+	fake.recordInvocation("CreateRegistryCredentialWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.createRegistryCredentialWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponseCallCount() int {
+	fake.createRegistryCredentialWithResponseMutex.RLock()
+	defer fake.createRegistryCredentialWithResponseMutex.RUnlock()
+	return len(fake.createRegistryCredentialWithResponseArgsForCall)
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponseCalls(stub func(context.Context, client.CreateRegistryCredentialJSONRequestBody, ...client.RequestEditorFn) (*client.CreateRegistryCredentialResponse, error)) {
+	fake.createRegistryCredentialWithResponseMutex.Lock()
+	defer fake.createRegistryCredentialWithResponseMutex.Unlock()
+	fake.CreateRegistryCredentialWithResponseStub = stub
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponseArgsForCall(i int) (context.Context, client.CreateRegistryCredentialJSONRequestBody, []client.RequestEditorFn) {
+	fake.createRegistryCredentialWithResponseMutex.RLock()
+	defer fake.createRegistryCredentialWithResponseMutex.RUnlock()
+	argsForCall := fake.createRegistryCredentialWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponseReturns(result1 *client.CreateRegistryCredentialResponse, result2 error) {
+	fake.createRegistryCredentialWithResponseMutex.Lock()
+	defer fake.createRegistryCredentialWithResponseMutex.Unlock()
+	fake.CreateRegistryCredentialWithResponseStub = nil
+	fake.createRegistryCredentialWithResponseReturns = struct {
+		result1 *client.CreateRegistryCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) CreateRegistryCredentialWithResponseReturnsOnCall(i int, result1 *client.CreateRegistryCredentialResponse, result2 error) {
+	fake.createRegistryCredentialWithResponseMutex.Lock()
+	defer fake.createRegistryCredentialWithResponseMutex.Unlock()
+	fake.CreateRegistryCredentialWithResponseStub = nil
+	if fake.createRegistryCredentialWithResponseReturnsOnCall == nil {
+		fake.createRegistryCredentialWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CreateRegistryCredentialResponse
+			result2 error
+		})
+	}
+	fake.createRegistryCredentialWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CreateRegistryCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.DeleteRegistryCredentialResponse, error) {
+	fake.deleteRegistryCredentialWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteRegistryCredentialWithResponseReturnsOnCall[len(fake.deleteRegistryCredentialWithResponseArgsForCall)]
+	fake.deleteRegistryCredentialWithResponseArgsForCall = append(fake.deleteRegistryCredentialWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteRegistryCredentialWithResponseStub
+	fakeReturns := fake.deleteRegistryCredentialWithResponseReturns
+	fake.recordInvocation("DeleteRegistryCredentialWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deleteRegistryCredentialWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponseCallCount() int {
+	fake.deleteRegistryCredentialWithResponseMutex.RLock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.RUnlock()
+	return len(fake.deleteRegistryCredentialWithResponseArgsForCall)
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteRegistryCredentialResponse, error)) {
+	fake.deleteRegistryCredentialWithResponseMutex.Lock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.Unlock()
+	fake.DeleteRegistryCredentialWithResponseStub = stub
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.deleteRegistryCredentialWithResponseMutex.RLock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteRegistryCredentialWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponseReturns(result1 *client.DeleteRegistryCredentialResponse, result2 error) {
+	fake.deleteRegistryCredentialWithResponseMutex.Lock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.Unlock()
+	fake.DeleteRegistryCredentialWithResponseStub = nil
+	fake.deleteRegistryCredentialWithResponseReturns = struct {
+		result1 *client.DeleteRegistryCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) DeleteRegistryCredentialWithResponseReturnsOnCall(i int, result1 *client.DeleteRegistryCredentialResponse, result2 error) {
+	fake.deleteRegistryCredentialWithResponseMutex.Lock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.Unlock()
+	fake.DeleteRegistryCredentialWithResponseStub = nil
+	if fake.deleteRegistryCredentialWithResponseReturnsOnCall == nil {
+		fake.deleteRegistryCredentialWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteRegistryCredentialResponse
+			result2 error
+		})
+	}
+	fake.deleteRegistryCredentialWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteRegistryCredentialResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponse(arg1 context.Context, arg2 *client.ListRegistryCredentialsParams, arg3 ...client.RequestEditorFn) (*client.ListRegistryCredentialsResponse, error) {
+	fake.listRegistryCredentialsWithResponseMutex.Lock()
+	ret, specificReturn := fake.listRegistryCredentialsWithResponseReturnsOnCall[len(fake.listRegistryCredentialsWithResponseArgsForCall)]
+	fake.listRegistryCredentialsWithResponseArgsForCall = append(fake.listRegistryCredentialsWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *client.ListRegistryCredentialsParams
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ListRegistryCredentialsWithResponseStub
+	fakeReturns := fake.listRegistryCredentialsWithResponseReturns
+	fake.recordInvocation("ListRegistryCredentialsWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.listRegistryCredentialsWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponseCallCount() int {
+	fake.listRegistryCredentialsWithResponseMutex.RLock()
+	defer fake.listRegistryCredentialsWithResponseMutex.RUnlock()
+	return len(fake.listRegistryCredentialsWithResponseArgsForCall)
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponseCalls(stub func(context.Context, *client.ListRegistryCredentialsParams, ...client.RequestEditorFn) (*client.ListRegistryCredentialsResponse, error)) {
+	fake.listRegistryCredentialsWithResponseMutex.Lock()
+	defer fake.listRegistryCredentialsWithResponseMutex.Unlock()
+	fake.ListRegistryCredentialsWithResponseStub = stub
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponseArgsForCall(i int) (context.Context, *client.ListRegistryCredentialsParams, []client.RequestEditorFn) {
+	fake.listRegistryCredentialsWithResponseMutex.RLock()
+	defer fake.listRegistryCredentialsWithResponseMutex.RUnlock()
+	argsForCall := fake.listRegistryCredentialsWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponseReturns(result1 *client.ListRegistryCredentialsResponse, result2 error) {
+	fake.listRegistryCredentialsWithResponseMutex.Lock()
+	defer fake.listRegistryCredentialsWithResponseMutex.Unlock()
+	fake.ListRegistryCredentialsWithResponseStub = nil
+	fake.listRegistryCredentialsWithResponseReturns = struct {
+		result1 *client.ListRegistryCredentialsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) ListRegistryCredentialsWithResponseReturnsOnCall(i int, result1 *client.ListRegistryCredentialsResponse, result2 error) {
+	fake.listRegistryCredentialsWithResponseMutex.Lock()
+	defer fake.listRegistryCredentialsWithResponseMutex.Unlock()
+	fake.ListRegistryCredentialsWithResponseStub = nil
+	if fake.listRegistryCredentialsWithResponseReturnsOnCall == nil {
+		fake.listRegistryCredentialsWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListRegistryCredentialsResponse
+			result2 error
+		})
+	}
+	fake.listRegistryCredentialsWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListRegistryCredentialsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRegistryCredentialRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createRegistryCredentialWithResponseMutex.RLock()
+	defer fake.createRegistryCredentialWithResponseMutex.RUnlock()
+	fake.deleteRegistryCredentialWithResponseMutex.RLock()
+	defer fake.deleteRegistryCredentialWithResponseMutex.RUnlock()
+	fake.listRegistryCredentialsWithResponseMutex.RLock()
+	defer fake.listRegistryCredentialsWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRegistryCredentialRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
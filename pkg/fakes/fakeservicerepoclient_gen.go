@@ -9,6 +9,54 @@ import (
 )
 
 type FakeServiceRepoClient struct {
+	AddOrUpdateSecretFileWithResponseStub        func(context.Context, string, string, client.AddOrUpdateSecretFileJSONRequestBody, ...client.RequestEditorFn) (*client.AddOrUpdateSecretFileResponse, error)
+	addOrUpdateSecretFileWithResponseMutex       sync.RWMutex
+	addOrUpdateSecretFileWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 client.AddOrUpdateSecretFileJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}
+	addOrUpdateSecretFileWithResponseReturns struct {
+		result1 *client.AddOrUpdateSecretFileResponse
+		result2 error
+	}
+	addOrUpdateSecretFileWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AddOrUpdateSecretFileResponse
+		result2 error
+	}
+	AutoscaleServiceWithResponseStub        func(context.Context, string, client.AutoscaleServiceJSONRequestBody, ...client.RequestEditorFn) (*client.AutoscaleServiceResponse, error)
+	autoscaleServiceWithResponseMutex       sync.RWMutex
+	autoscaleServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AutoscaleServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	autoscaleServiceWithResponseReturns struct {
+		result1 *client.AutoscaleServiceResponse
+		result2 error
+	}
+	autoscaleServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AutoscaleServiceResponse
+		result2 error
+	}
+	CancelCronJobRunWithResponseStub        func(context.Context, client.CronJobIdParam, ...client.RequestEditorFn) (*client.CancelCronJobRunResponse, error)
+	cancelCronJobRunWithResponseMutex       sync.RWMutex
+	cancelCronJobRunWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CronJobIdParam
+		arg3 []client.RequestEditorFn
+	}
+	cancelCronJobRunWithResponseReturns struct {
+		result1 *client.CancelCronJobRunResponse
+		result2 error
+	}
+	cancelCronJobRunWithResponseReturnsOnCall map[int]struct {
+		result1 *client.CancelCronJobRunResponse
+		result2 error
+	}
 	CreateDeployWithResponseStub        func(context.Context, string, client.CreateDeployJSONRequestBody, ...client.RequestEditorFn) (*client.CreateDeployResponse, error)
 	createDeployWithResponseMutex       sync.RWMutex
 	createDeployWithResponseArgsForCall []struct {
@@ -40,6 +88,68 @@ type FakeServiceRepoClient struct {
 		result1 *client.CreateServiceResponse
 		result2 error
 	}
+	DeleteAutoscalingConfigWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteAutoscalingConfigResponse, error)
+	deleteAutoscalingConfigWithResponseMutex       sync.RWMutex
+	deleteAutoscalingConfigWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	deleteAutoscalingConfigWithResponseReturns struct {
+		result1 *client.DeleteAutoscalingConfigResponse
+		result2 error
+	}
+	deleteAutoscalingConfigWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteAutoscalingConfigResponse
+		result2 error
+	}
+	DeleteEnvVarWithResponseStub        func(context.Context, string, client.EnvVarKeyParam, ...client.RequestEditorFn) (*client.DeleteEnvVarResponse, error)
+	deleteEnvVarWithResponseMutex       sync.RWMutex
+	deleteEnvVarWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.EnvVarKeyParam
+		arg4 []client.RequestEditorFn
+	}
+	deleteEnvVarWithResponseReturns struct {
+		result1 *client.DeleteEnvVarResponse
+		result2 error
+	}
+	deleteEnvVarWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteEnvVarResponse
+		result2 error
+	}
+	DeleteSecretFileWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteSecretFileResponse, error)
+	deleteSecretFileWithResponseMutex       sync.RWMutex
+	deleteSecretFileWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	deleteSecretFileWithResponseReturns struct {
+		result1 *client.DeleteSecretFileResponse
+		result2 error
+	}
+	deleteSecretFileWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteSecretFileResponse
+		result2 error
+	}
+	DeleteServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteServiceResponse, error)
+	deleteServiceWithResponseMutex       sync.RWMutex
+	deleteServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	deleteServiceWithResponseReturns struct {
+		result1 *client.DeleteServiceResponse
+		result2 error
+	}
+	deleteServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteServiceResponse
+		result2 error
+	}
 	GetEnvVarsForServiceWithResponseStub        func(context.Context, string, *client.GetEnvVarsForServiceParams, ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error)
 	getEnvVarsForServiceWithResponseMutex       sync.RWMutex
 	getEnvVarsForServiceWithResponseArgsForCall []struct {
@@ -56,6 +166,22 @@ type FakeServiceRepoClient struct {
 		result1 *client.GetEnvVarsForServiceResponse
 		result2 error
 	}
+	ListSecretFilesForServiceWithResponseStub        func(context.Context, string, *client.ListSecretFilesForServiceParams, ...client.RequestEditorFn) (*client.ListSecretFilesForServiceResponse, error)
+	listSecretFilesForServiceWithResponseMutex       sync.RWMutex
+	listSecretFilesForServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListSecretFilesForServiceParams
+		arg4 []client.RequestEditorFn
+	}
+	listSecretFilesForServiceWithResponseReturns struct {
+		result1 *client.ListSecretFilesForServiceResponse
+		result2 error
+	}
+	listSecretFilesForServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListSecretFilesForServiceResponse
+		result2 error
+	}
 	ListServicesWithResponseStub        func(context.Context, *client.ListServicesParams, ...client.RequestEditorFn) (*client.ListServicesResponse, error)
 	listServicesWithResponseMutex       sync.RWMutex
 	listServicesWithResponseArgsForCall []struct {
@@ -71,6 +197,52 @@ type FakeServiceRepoClient struct {
 		result1 *client.ListServicesResponse
 		result2 error
 	}
+	RestartServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RestartServiceResponse, error)
+	restartServiceWithResponseMutex       sync.RWMutex
+	restartServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	restartServiceWithResponseReturns struct {
+		result1 *client.RestartServiceResponse
+		result2 error
+	}
+	restartServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RestartServiceResponse
+		result2 error
+	}
+	ResumeServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.ResumeServiceResponse, error)
+	resumeServiceWithResponseMutex       sync.RWMutex
+	resumeServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	resumeServiceWithResponseReturns struct {
+		result1 *client.ResumeServiceResponse
+		result2 error
+	}
+	resumeServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ResumeServiceResponse
+		result2 error
+	}
+	RetrieveSecretFileWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveSecretFileResponse, error)
+	retrieveSecretFileWithResponseMutex       sync.RWMutex
+	retrieveSecretFileWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	retrieveSecretFileWithResponseReturns struct {
+		result1 *client.RetrieveSecretFileResponse
+		result2 error
+	}
+	retrieveSecretFileWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveSecretFileResponse
+		result2 error
+	}
 	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
 	retrieveServiceWithResponseMutex       sync.RWMutex
 	retrieveServiceWithResponseArgsForCall []struct {
@@ -86,6 +258,52 @@ type FakeServiceRepoClient struct {
 		result1 *client.RetrieveServiceResponse
 		result2 error
 	}
+	RunCronJobWithResponseStub        func(context.Context, client.CronJobIdParam, ...client.RequestEditorFn) (*client.RunCronJobResponse, error)
+	runCronJobWithResponseMutex       sync.RWMutex
+	runCronJobWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.CronJobIdParam
+		arg3 []client.RequestEditorFn
+	}
+	runCronJobWithResponseReturns struct {
+		result1 *client.RunCronJobResponse
+		result2 error
+	}
+	runCronJobWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RunCronJobResponse
+		result2 error
+	}
+	ScaleServiceWithResponseStub        func(context.Context, string, client.ScaleServiceJSONRequestBody, ...client.RequestEditorFn) (*client.ScaleServiceResponse, error)
+	scaleServiceWithResponseMutex       sync.RWMutex
+	scaleServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.ScaleServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	scaleServiceWithResponseReturns struct {
+		result1 *client.ScaleServiceResponse
+		result2 error
+	}
+	scaleServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ScaleServiceResponse
+		result2 error
+	}
+	SuspendServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.SuspendServiceResponse, error)
+	suspendServiceWithResponseMutex       sync.RWMutex
+	suspendServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	suspendServiceWithResponseReturns struct {
+		result1 *client.SuspendServiceResponse
+		result2 error
+	}
+	suspendServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.SuspendServiceResponse
+		result2 error
+	}
 	UpdateEnvVarsForServiceWithResponseStub        func(context.Context, string, []client.EnvVarInput, ...client.RequestEditorFn) (*client.UpdateEnvVarsForServiceResponse, error)
 	updateEnvVarsForServiceWithResponseMutex       sync.RWMutex
 	updateEnvVarsForServiceWithResponseArgsForCall []struct {
@@ -102,10 +320,243 @@ type FakeServiceRepoClient struct {
 		result1 *client.UpdateEnvVarsForServiceResponse
 		result2 error
 	}
+	UpdateSecretFilesForServiceWithResponseStub        func(context.Context, string, client.UpdateSecretFilesForServiceJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateSecretFilesForServiceResponse, error)
+	updateSecretFilesForServiceWithResponseMutex       sync.RWMutex
+	updateSecretFilesForServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateSecretFilesForServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	updateSecretFilesForServiceWithResponseReturns struct {
+		result1 *client.UpdateSecretFilesForServiceResponse
+		result2 error
+	}
+	updateSecretFilesForServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateSecretFilesForServiceResponse
+		result2 error
+	}
+	UpdateServiceWithResponseStub        func(context.Context, string, client.UpdateServiceJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateServiceResponse, error)
+	updateServiceWithResponseMutex       sync.RWMutex
+	updateServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	updateServiceWithResponseReturns struct {
+		result1 *client.UpdateServiceResponse
+		result2 error
+	}
+	updateServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateServiceResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 client.AddOrUpdateSecretFileJSONRequestBody, arg5 ...client.RequestEditorFn) (*client.AddOrUpdateSecretFileResponse, error) {
+	fake.addOrUpdateSecretFileWithResponseMutex.Lock()
+	ret, specificReturn := fake.addOrUpdateSecretFileWithResponseReturnsOnCall[len(fake.addOrUpdateSecretFileWithResponseArgsForCall)]
+	fake.addOrUpdateSecretFileWithResponseArgsForCall = append(fake.addOrUpdateSecretFileWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 client.AddOrUpdateSecretFileJSONRequestBody
+		arg5 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.AddOrUpdateSecretFileWithResponseStub
+	fakeReturns := fake.addOrUpdateSecretFileWithResponseReturns
+	fake.recordInvocation("AddOrUpdateSecretFileWithResponse", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.addOrUpdateSecretFileWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponseCallCount() int {
+	fake.addOrUpdateSecretFileWithResponseMutex.RLock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.RUnlock()
+	return len(fake.addOrUpdateSecretFileWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponseCalls(stub func(context.Context, string, string, client.AddOrUpdateSecretFileJSONRequestBody, ...client.RequestEditorFn) (*client.AddOrUpdateSecretFileResponse, error)) {
+	fake.addOrUpdateSecretFileWithResponseMutex.Lock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.Unlock()
+	fake.AddOrUpdateSecretFileWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponseArgsForCall(i int) (context.Context, string, string, client.AddOrUpdateSecretFileJSONRequestBody, []client.RequestEditorFn) {
+	fake.addOrUpdateSecretFileWithResponseMutex.RLock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.RUnlock()
+	argsForCall := fake.addOrUpdateSecretFileWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponseReturns(result1 *client.AddOrUpdateSecretFileResponse, result2 error) {
+	fake.addOrUpdateSecretFileWithResponseMutex.Lock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.Unlock()
+	fake.AddOrUpdateSecretFileWithResponseStub = nil
+	fake.addOrUpdateSecretFileWithResponseReturns = struct {
+		result1 *client.AddOrUpdateSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) AddOrUpdateSecretFileWithResponseReturnsOnCall(i int, result1 *client.AddOrUpdateSecretFileResponse, result2 error) {
+	fake.addOrUpdateSecretFileWithResponseMutex.Lock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.Unlock()
+	fake.AddOrUpdateSecretFileWithResponseStub = nil
+	if fake.addOrUpdateSecretFileWithResponseReturnsOnCall == nil {
+		fake.addOrUpdateSecretFileWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AddOrUpdateSecretFileResponse
+			result2 error
+		})
+	}
+	fake.addOrUpdateSecretFileWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AddOrUpdateSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponse(arg1 context.Context, arg2 string, arg3 client.AutoscaleServiceJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.AutoscaleServiceResponse, error) {
+	fake.autoscaleServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.autoscaleServiceWithResponseReturnsOnCall[len(fake.autoscaleServiceWithResponseArgsForCall)]
+	fake.autoscaleServiceWithResponseArgsForCall = append(fake.autoscaleServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AutoscaleServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AutoscaleServiceWithResponseStub
+	fakeReturns := fake.autoscaleServiceWithResponseReturns
+	fake.recordInvocation("AutoscaleServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.autoscaleServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponseCallCount() int {
+	fake.autoscaleServiceWithResponseMutex.RLock()
+	defer fake.autoscaleServiceWithResponseMutex.RUnlock()
+	return len(fake.autoscaleServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponseCalls(stub func(context.Context, string, client.AutoscaleServiceJSONRequestBody, ...client.RequestEditorFn) (*client.AutoscaleServiceResponse, error)) {
+	fake.autoscaleServiceWithResponseMutex.Lock()
+	defer fake.autoscaleServiceWithResponseMutex.Unlock()
+	fake.AutoscaleServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponseArgsForCall(i int) (context.Context, string, client.AutoscaleServiceJSONRequestBody, []client.RequestEditorFn) {
+	fake.autoscaleServiceWithResponseMutex.RLock()
+	defer fake.autoscaleServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.autoscaleServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponseReturns(result1 *client.AutoscaleServiceResponse, result2 error) {
+	fake.autoscaleServiceWithResponseMutex.Lock()
+	defer fake.autoscaleServiceWithResponseMutex.Unlock()
+	fake.AutoscaleServiceWithResponseStub = nil
+	fake.autoscaleServiceWithResponseReturns = struct {
+		result1 *client.AutoscaleServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) AutoscaleServiceWithResponseReturnsOnCall(i int, result1 *client.AutoscaleServiceResponse, result2 error) {
+	fake.autoscaleServiceWithResponseMutex.Lock()
+	defer fake.autoscaleServiceWithResponseMutex.Unlock()
+	fake.AutoscaleServiceWithResponseStub = nil
+	if fake.autoscaleServiceWithResponseReturnsOnCall == nil {
+		fake.autoscaleServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AutoscaleServiceResponse
+			result2 error
+		})
+	}
+	fake.autoscaleServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AutoscaleServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponse(arg1 context.Context, arg2 client.CronJobIdParam, arg3 ...client.RequestEditorFn) (*client.CancelCronJobRunResponse, error) {
+	fake.cancelCronJobRunWithResponseMutex.Lock()
+	ret, specificReturn := fake.cancelCronJobRunWithResponseReturnsOnCall[len(fake.cancelCronJobRunWithResponseArgsForCall)]
+	fake.cancelCronJobRunWithResponseArgsForCall = append(fake.cancelCronJobRunWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CronJobIdParam
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.CancelCronJobRunWithResponseStub
+	fakeReturns := fake.cancelCronJobRunWithResponseReturns
+	fake.recordInvocation("CancelCronJobRunWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.cancelCronJobRunWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponseCallCount() int {
+	fake.cancelCronJobRunWithResponseMutex.RLock()
+	defer fake.cancelCronJobRunWithResponseMutex.RUnlock()
+	return len(fake.cancelCronJobRunWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponseCalls(stub func(context.Context, client.CronJobIdParam, ...client.RequestEditorFn) (*client.CancelCronJobRunResponse, error)) {
+	fake.cancelCronJobRunWithResponseMutex.Lock()
+	defer fake.cancelCronJobRunWithResponseMutex.Unlock()
+	fake.CancelCronJobRunWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponseArgsForCall(i int) (context.Context, client.CronJobIdParam, []client.RequestEditorFn) {
+	fake.cancelCronJobRunWithResponseMutex.RLock()
+	defer fake.cancelCronJobRunWithResponseMutex.RUnlock()
+	argsForCall := fake.cancelCronJobRunWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponseReturns(result1 *client.CancelCronJobRunResponse, result2 error) {
+	fake.cancelCronJobRunWithResponseMutex.Lock()
+	defer fake.cancelCronJobRunWithResponseMutex.Unlock()
+	fake.CancelCronJobRunWithResponseStub = nil
+	fake.cancelCronJobRunWithResponseReturns = struct {
+		result1 *client.CancelCronJobRunResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) CancelCronJobRunWithResponseReturnsOnCall(i int, result1 *client.CancelCronJobRunResponse, result2 error) {
+	fake.cancelCronJobRunWithResponseMutex.Lock()
+	defer fake.cancelCronJobRunWithResponseMutex.Unlock()
+	fake.CancelCronJobRunWithResponseStub = nil
+	if fake.cancelCronJobRunWithResponseReturnsOnCall == nil {
+		fake.cancelCronJobRunWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.CancelCronJobRunResponse
+			result2 error
+		})
+	}
+	fake.cancelCronJobRunWithResponseReturnsOnCall[i] = struct {
+		result1 *client.CancelCronJobRunResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepoClient) CreateDeployWithResponse(arg1 context.Context, arg2 string, arg3 client.CreateDeployJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.CreateDeployResponse, error) {
 	fake.createDeployWithResponseMutex.Lock()
 	ret, specificReturn := fake.createDeployWithResponseReturnsOnCall[len(fake.createDeployWithResponseArgsForCall)]
@@ -239,21 +690,20 @@ func (fake *FakeServiceRepoClient) CreateServiceWithResponseReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponse(arg1 context.Context, arg2 string, arg3 *client.GetEnvVarsForServiceParams, arg4 ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error) {
-	fake.getEnvVarsForServiceWithResponseMutex.Lock()
-	ret, specificReturn := fake.getEnvVarsForServiceWithResponseReturnsOnCall[len(fake.getEnvVarsForServiceWithResponseArgsForCall)]
-	fake.getEnvVarsForServiceWithResponseArgsForCall = append(fake.getEnvVarsForServiceWithResponseArgsForCall, struct {
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.DeleteAutoscalingConfigResponse, error) {
+	fake.deleteAutoscalingConfigWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteAutoscalingConfigWithResponseReturnsOnCall[len(fake.deleteAutoscalingConfigWithResponseArgsForCall)]
+	fake.deleteAutoscalingConfigWithResponseArgsForCall = append(fake.deleteAutoscalingConfigWithResponseArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 *client.GetEnvVarsForServiceParams
-		arg4 []client.RequestEditorFn
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.GetEnvVarsForServiceWithResponseStub
-	fakeReturns := fake.getEnvVarsForServiceWithResponseReturns
-	fake.recordInvocation("GetEnvVarsForServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
-	fake.getEnvVarsForServiceWithResponseMutex.Unlock()
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAutoscalingConfigWithResponseStub
+	fakeReturns := fake.deleteAutoscalingConfigWithResponseReturns
+	fake.recordInvocation("DeleteAutoscalingConfigWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deleteAutoscalingConfigWithResponseMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4...)
+		return stub(arg1, arg2, arg3...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -261,51 +711,385 @@ func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponse(arg1 context
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseCallCount() int {
-	fake.getEnvVarsForServiceWithResponseMutex.RLock()
-	defer fake.getEnvVarsForServiceWithResponseMutex.RUnlock()
-	return len(fake.getEnvVarsForServiceWithResponseArgsForCall)
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponseCallCount() int {
+	fake.deleteAutoscalingConfigWithResponseMutex.RLock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.RUnlock()
+	return len(fake.deleteAutoscalingConfigWithResponseArgsForCall)
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseCalls(stub func(context.Context, string, *client.GetEnvVarsForServiceParams, ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error)) {
-	fake.getEnvVarsForServiceWithResponseMutex.Lock()
-	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
-	fake.GetEnvVarsForServiceWithResponseStub = stub
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteAutoscalingConfigResponse, error)) {
+	fake.deleteAutoscalingConfigWithResponseMutex.Lock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.Unlock()
+	fake.DeleteAutoscalingConfigWithResponseStub = stub
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseArgsForCall(i int) (context.Context, string, *client.GetEnvVarsForServiceParams, []client.RequestEditorFn) {
-	fake.getEnvVarsForServiceWithResponseMutex.RLock()
-	defer fake.getEnvVarsForServiceWithResponseMutex.RUnlock()
-	argsForCall := fake.getEnvVarsForServiceWithResponseArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.deleteAutoscalingConfigWithResponseMutex.RLock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteAutoscalingConfigWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseReturns(result1 *client.GetEnvVarsForServiceResponse, result2 error) {
-	fake.getEnvVarsForServiceWithResponseMutex.Lock()
-	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
-	fake.GetEnvVarsForServiceWithResponseStub = nil
-	fake.getEnvVarsForServiceWithResponseReturns = struct {
-		result1 *client.GetEnvVarsForServiceResponse
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponseReturns(result1 *client.DeleteAutoscalingConfigResponse, result2 error) {
+	fake.deleteAutoscalingConfigWithResponseMutex.Lock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.Unlock()
+	fake.DeleteAutoscalingConfigWithResponseStub = nil
+	fake.deleteAutoscalingConfigWithResponseReturns = struct {
+		result1 *client.DeleteAutoscalingConfigResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseReturnsOnCall(i int, result1 *client.GetEnvVarsForServiceResponse, result2 error) {
-	fake.getEnvVarsForServiceWithResponseMutex.Lock()
-	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
-	fake.GetEnvVarsForServiceWithResponseStub = nil
-	if fake.getEnvVarsForServiceWithResponseReturnsOnCall == nil {
-		fake.getEnvVarsForServiceWithResponseReturnsOnCall = make(map[int]struct {
-			result1 *client.GetEnvVarsForServiceResponse
+func (fake *FakeServiceRepoClient) DeleteAutoscalingConfigWithResponseReturnsOnCall(i int, result1 *client.DeleteAutoscalingConfigResponse, result2 error) {
+	fake.deleteAutoscalingConfigWithResponseMutex.Lock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.Unlock()
+	fake.DeleteAutoscalingConfigWithResponseStub = nil
+	if fake.deleteAutoscalingConfigWithResponseReturnsOnCall == nil {
+		fake.deleteAutoscalingConfigWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteAutoscalingConfigResponse
 			result2 error
 		})
 	}
-	fake.getEnvVarsForServiceWithResponseReturnsOnCall[i] = struct {
+	fake.deleteAutoscalingConfigWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteAutoscalingConfigResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponse(arg1 context.Context, arg2 string, arg3 client.EnvVarKeyParam, arg4 ...client.RequestEditorFn) (*client.DeleteEnvVarResponse, error) {
+	fake.deleteEnvVarWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteEnvVarWithResponseReturnsOnCall[len(fake.deleteEnvVarWithResponseArgsForCall)]
+	fake.deleteEnvVarWithResponseArgsForCall = append(fake.deleteEnvVarWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.EnvVarKeyParam
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEnvVarWithResponseStub
+	fakeReturns := fake.deleteEnvVarWithResponseReturns
+	fake.recordInvocation("DeleteEnvVarWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEnvVarWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponseCallCount() int {
+	fake.deleteEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvVarWithResponseMutex.RUnlock()
+	return len(fake.deleteEnvVarWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponseCalls(stub func(context.Context, string, client.EnvVarKeyParam, ...client.RequestEditorFn) (*client.DeleteEnvVarResponse, error)) {
+	fake.deleteEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvVarWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponseArgsForCall(i int) (context.Context, string, client.EnvVarKeyParam, []client.RequestEditorFn) {
+	fake.deleteEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvVarWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteEnvVarWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponseReturns(result1 *client.DeleteEnvVarResponse, result2 error) {
+	fake.deleteEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvVarWithResponseStub = nil
+	fake.deleteEnvVarWithResponseReturns = struct {
+		result1 *client.DeleteEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteEnvVarWithResponseReturnsOnCall(i int, result1 *client.DeleteEnvVarResponse, result2 error) {
+	fake.deleteEnvVarWithResponseMutex.Lock()
+	defer fake.deleteEnvVarWithResponseMutex.Unlock()
+	fake.DeleteEnvVarWithResponseStub = nil
+	if fake.deleteEnvVarWithResponseReturnsOnCall == nil {
+		fake.deleteEnvVarWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteEnvVarResponse
+			result2 error
+		})
+	}
+	fake.deleteEnvVarWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteEnvVarResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.DeleteSecretFileResponse, error) {
+	fake.deleteSecretFileWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteSecretFileWithResponseReturnsOnCall[len(fake.deleteSecretFileWithResponseArgsForCall)]
+	fake.deleteSecretFileWithResponseArgsForCall = append(fake.deleteSecretFileWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteSecretFileWithResponseStub
+	fakeReturns := fake.deleteSecretFileWithResponseReturns
+	fake.recordInvocation("DeleteSecretFileWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteSecretFileWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponseCallCount() int {
+	fake.deleteSecretFileWithResponseMutex.RLock()
+	defer fake.deleteSecretFileWithResponseMutex.RUnlock()
+	return len(fake.deleteSecretFileWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteSecretFileResponse, error)) {
+	fake.deleteSecretFileWithResponseMutex.Lock()
+	defer fake.deleteSecretFileWithResponseMutex.Unlock()
+	fake.DeleteSecretFileWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.deleteSecretFileWithResponseMutex.RLock()
+	defer fake.deleteSecretFileWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteSecretFileWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponseReturns(result1 *client.DeleteSecretFileResponse, result2 error) {
+	fake.deleteSecretFileWithResponseMutex.Lock()
+	defer fake.deleteSecretFileWithResponseMutex.Unlock()
+	fake.DeleteSecretFileWithResponseStub = nil
+	fake.deleteSecretFileWithResponseReturns = struct {
+		result1 *client.DeleteSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteSecretFileWithResponseReturnsOnCall(i int, result1 *client.DeleteSecretFileResponse, result2 error) {
+	fake.deleteSecretFileWithResponseMutex.Lock()
+	defer fake.deleteSecretFileWithResponseMutex.Unlock()
+	fake.DeleteSecretFileWithResponseStub = nil
+	if fake.deleteSecretFileWithResponseReturnsOnCall == nil {
+		fake.deleteSecretFileWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteSecretFileResponse
+			result2 error
+		})
+	}
+	fake.deleteSecretFileWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.DeleteServiceResponse, error) {
+	fake.deleteServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteServiceWithResponseReturnsOnCall[len(fake.deleteServiceWithResponseArgsForCall)]
+	fake.deleteServiceWithResponseArgsForCall = append(fake.deleteServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteServiceWithResponseStub
+	fakeReturns := fake.deleteServiceWithResponseReturns
+	fake.recordInvocation("DeleteServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.deleteServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponseCallCount() int {
+	fake.deleteServiceWithResponseMutex.RLock()
+	defer fake.deleteServiceWithResponseMutex.RUnlock()
+	return len(fake.deleteServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.DeleteServiceResponse, error)) {
+	fake.deleteServiceWithResponseMutex.Lock()
+	defer fake.deleteServiceWithResponseMutex.Unlock()
+	fake.DeleteServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.deleteServiceWithResponseMutex.RLock()
+	defer fake.deleteServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponseReturns(result1 *client.DeleteServiceResponse, result2 error) {
+	fake.deleteServiceWithResponseMutex.Lock()
+	defer fake.deleteServiceWithResponseMutex.Unlock()
+	fake.DeleteServiceWithResponseStub = nil
+	fake.deleteServiceWithResponseReturns = struct {
+		result1 *client.DeleteServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) DeleteServiceWithResponseReturnsOnCall(i int, result1 *client.DeleteServiceResponse, result2 error) {
+	fake.deleteServiceWithResponseMutex.Lock()
+	defer fake.deleteServiceWithResponseMutex.Unlock()
+	fake.DeleteServiceWithResponseStub = nil
+	if fake.deleteServiceWithResponseReturnsOnCall == nil {
+		fake.deleteServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteServiceResponse
+			result2 error
+		})
+	}
+	fake.deleteServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponse(arg1 context.Context, arg2 string, arg3 *client.GetEnvVarsForServiceParams, arg4 ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error) {
+	fake.getEnvVarsForServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.getEnvVarsForServiceWithResponseReturnsOnCall[len(fake.getEnvVarsForServiceWithResponseArgsForCall)]
+	fake.getEnvVarsForServiceWithResponseArgsForCall = append(fake.getEnvVarsForServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.GetEnvVarsForServiceParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetEnvVarsForServiceWithResponseStub
+	fakeReturns := fake.getEnvVarsForServiceWithResponseReturns
+	fake.recordInvocation("GetEnvVarsForServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getEnvVarsForServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseCallCount() int {
+	fake.getEnvVarsForServiceWithResponseMutex.RLock()
+	defer fake.getEnvVarsForServiceWithResponseMutex.RUnlock()
+	return len(fake.getEnvVarsForServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseCalls(stub func(context.Context, string, *client.GetEnvVarsForServiceParams, ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error)) {
+	fake.getEnvVarsForServiceWithResponseMutex.Lock()
+	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
+	fake.GetEnvVarsForServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseArgsForCall(i int) (context.Context, string, *client.GetEnvVarsForServiceParams, []client.RequestEditorFn) {
+	fake.getEnvVarsForServiceWithResponseMutex.RLock()
+	defer fake.getEnvVarsForServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.getEnvVarsForServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseReturns(result1 *client.GetEnvVarsForServiceResponse, result2 error) {
+	fake.getEnvVarsForServiceWithResponseMutex.Lock()
+	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
+	fake.GetEnvVarsForServiceWithResponseStub = nil
+	fake.getEnvVarsForServiceWithResponseReturns = struct {
 		result1 *client.GetEnvVarsForServiceResponse
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepoClient) GetEnvVarsForServiceWithResponseReturnsOnCall(i int, result1 *client.GetEnvVarsForServiceResponse, result2 error) {
+	fake.getEnvVarsForServiceWithResponseMutex.Lock()
+	defer fake.getEnvVarsForServiceWithResponseMutex.Unlock()
+	fake.GetEnvVarsForServiceWithResponseStub = nil
+	if fake.getEnvVarsForServiceWithResponseReturnsOnCall == nil {
+		fake.getEnvVarsForServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.GetEnvVarsForServiceResponse
+			result2 error
+		})
+	}
+	fake.getEnvVarsForServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.GetEnvVarsForServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponse(arg1 context.Context, arg2 string, arg3 *client.ListSecretFilesForServiceParams, arg4 ...client.RequestEditorFn) (*client.ListSecretFilesForServiceResponse, error) {
+	fake.listSecretFilesForServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.listSecretFilesForServiceWithResponseReturnsOnCall[len(fake.listSecretFilesForServiceWithResponseArgsForCall)]
+	fake.listSecretFilesForServiceWithResponseArgsForCall = append(fake.listSecretFilesForServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListSecretFilesForServiceParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListSecretFilesForServiceWithResponseStub
+	fakeReturns := fake.listSecretFilesForServiceWithResponseReturns
+	fake.recordInvocation("ListSecretFilesForServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listSecretFilesForServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponseCallCount() int {
+	fake.listSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.RUnlock()
+	return len(fake.listSecretFilesForServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponseCalls(stub func(context.Context, string, *client.ListSecretFilesForServiceParams, ...client.RequestEditorFn) (*client.ListSecretFilesForServiceResponse, error)) {
+	fake.listSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.ListSecretFilesForServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponseArgsForCall(i int) (context.Context, string, *client.ListSecretFilesForServiceParams, []client.RequestEditorFn) {
+	fake.listSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.listSecretFilesForServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponseReturns(result1 *client.ListSecretFilesForServiceResponse, result2 error) {
+	fake.listSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.ListSecretFilesForServiceWithResponseStub = nil
+	fake.listSecretFilesForServiceWithResponseReturns = struct {
+		result1 *client.ListSecretFilesForServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ListSecretFilesForServiceWithResponseReturnsOnCall(i int, result1 *client.ListSecretFilesForServiceResponse, result2 error) {
+	fake.listSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.ListSecretFilesForServiceWithResponseStub = nil
+	if fake.listSecretFilesForServiceWithResponseReturnsOnCall == nil {
+		fake.listSecretFilesForServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListSecretFilesForServiceResponse
+			result2 error
+		})
+	}
+	fake.listSecretFilesForServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListSecretFilesForServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepoClient) ListServicesWithResponse(arg1 context.Context, arg2 *client.ListServicesParams, arg3 ...client.RequestEditorFn) (*client.ListServicesResponse, error) {
 	fake.listServicesWithResponseMutex.Lock()
 	ret, specificReturn := fake.listServicesWithResponseReturnsOnCall[len(fake.listServicesWithResponseArgsForCall)]
@@ -372,6 +1156,205 @@ func (fake *FakeServiceRepoClient) ListServicesWithResponseReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepoClient) RestartServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RestartServiceResponse, error) {
+	fake.restartServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.restartServiceWithResponseReturnsOnCall[len(fake.restartServiceWithResponseArgsForCall)]
+	fake.restartServiceWithResponseArgsForCall = append(fake.restartServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RestartServiceWithResponseStub
+	fakeReturns := fake.restartServiceWithResponseReturns
+	fake.recordInvocation("RestartServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.restartServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) RestartServiceWithResponseCallCount() int {
+	fake.restartServiceWithResponseMutex.RLock()
+	defer fake.restartServiceWithResponseMutex.RUnlock()
+	return len(fake.restartServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) RestartServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RestartServiceResponse, error)) {
+	fake.restartServiceWithResponseMutex.Lock()
+	defer fake.restartServiceWithResponseMutex.Unlock()
+	fake.RestartServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) RestartServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.restartServiceWithResponseMutex.RLock()
+	defer fake.restartServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.restartServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) RestartServiceWithResponseReturns(result1 *client.RestartServiceResponse, result2 error) {
+	fake.restartServiceWithResponseMutex.Lock()
+	defer fake.restartServiceWithResponseMutex.Unlock()
+	fake.RestartServiceWithResponseStub = nil
+	fake.restartServiceWithResponseReturns = struct {
+		result1 *client.RestartServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) RestartServiceWithResponseReturnsOnCall(i int, result1 *client.RestartServiceResponse, result2 error) {
+	fake.restartServiceWithResponseMutex.Lock()
+	defer fake.restartServiceWithResponseMutex.Unlock()
+	fake.RestartServiceWithResponseStub = nil
+	if fake.restartServiceWithResponseReturnsOnCall == nil {
+		fake.restartServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RestartServiceResponse
+			result2 error
+		})
+	}
+	fake.restartServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RestartServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.ResumeServiceResponse, error) {
+	fake.resumeServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.resumeServiceWithResponseReturnsOnCall[len(fake.resumeServiceWithResponseArgsForCall)]
+	fake.resumeServiceWithResponseArgsForCall = append(fake.resumeServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeServiceWithResponseStub
+	fakeReturns := fake.resumeServiceWithResponseReturns
+	fake.recordInvocation("ResumeServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.resumeServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponseCallCount() int {
+	fake.resumeServiceWithResponseMutex.RLock()
+	defer fake.resumeServiceWithResponseMutex.RUnlock()
+	return len(fake.resumeServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.ResumeServiceResponse, error)) {
+	fake.resumeServiceWithResponseMutex.Lock()
+	defer fake.resumeServiceWithResponseMutex.Unlock()
+	fake.ResumeServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.resumeServiceWithResponseMutex.RLock()
+	defer fake.resumeServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.resumeServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponseReturns(result1 *client.ResumeServiceResponse, result2 error) {
+	fake.resumeServiceWithResponseMutex.Lock()
+	defer fake.resumeServiceWithResponseMutex.Unlock()
+	fake.ResumeServiceWithResponseStub = nil
+	fake.resumeServiceWithResponseReturns = struct {
+		result1 *client.ResumeServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ResumeServiceWithResponseReturnsOnCall(i int, result1 *client.ResumeServiceResponse, result2 error) {
+	fake.resumeServiceWithResponseMutex.Lock()
+	defer fake.resumeServiceWithResponseMutex.Unlock()
+	fake.ResumeServiceWithResponseStub = nil
+	if fake.resumeServiceWithResponseReturnsOnCall == nil {
+		fake.resumeServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ResumeServiceResponse
+			result2 error
+		})
+	}
+	fake.resumeServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ResumeServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.RetrieveSecretFileResponse, error) {
+	fake.retrieveSecretFileWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveSecretFileWithResponseReturnsOnCall[len(fake.retrieveSecretFileWithResponseArgsForCall)]
+	fake.retrieveSecretFileWithResponseArgsForCall = append(fake.retrieveSecretFileWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RetrieveSecretFileWithResponseStub
+	fakeReturns := fake.retrieveSecretFileWithResponseReturns
+	fake.recordInvocation("RetrieveSecretFileWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveSecretFileWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponseCallCount() int {
+	fake.retrieveSecretFileWithResponseMutex.RLock()
+	defer fake.retrieveSecretFileWithResponseMutex.RUnlock()
+	return len(fake.retrieveSecretFileWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.RetrieveSecretFileResponse, error)) {
+	fake.retrieveSecretFileWithResponseMutex.Lock()
+	defer fake.retrieveSecretFileWithResponseMutex.Unlock()
+	fake.RetrieveSecretFileWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.retrieveSecretFileWithResponseMutex.RLock()
+	defer fake.retrieveSecretFileWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveSecretFileWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponseReturns(result1 *client.RetrieveSecretFileResponse, result2 error) {
+	fake.retrieveSecretFileWithResponseMutex.Lock()
+	defer fake.retrieveSecretFileWithResponseMutex.Unlock()
+	fake.RetrieveSecretFileWithResponseStub = nil
+	fake.retrieveSecretFileWithResponseReturns = struct {
+		result1 *client.RetrieveSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) RetrieveSecretFileWithResponseReturnsOnCall(i int, result1 *client.RetrieveSecretFileResponse, result2 error) {
+	fake.retrieveSecretFileWithResponseMutex.Lock()
+	defer fake.retrieveSecretFileWithResponseMutex.Unlock()
+	fake.RetrieveSecretFileWithResponseStub = nil
+	if fake.retrieveSecretFileWithResponseReturnsOnCall == nil {
+		fake.retrieveSecretFileWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveSecretFileResponse
+			result2 error
+		})
+	}
+	fake.retrieveSecretFileWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveSecretFileResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
 	fake.retrieveServiceWithResponseMutex.Lock()
 	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
@@ -438,6 +1421,205 @@ func (fake *FakeServiceRepoClient) RetrieveServiceWithResponseReturnsOnCall(i in
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepoClient) RunCronJobWithResponse(arg1 context.Context, arg2 client.CronJobIdParam, arg3 ...client.RequestEditorFn) (*client.RunCronJobResponse, error) {
+	fake.runCronJobWithResponseMutex.Lock()
+	ret, specificReturn := fake.runCronJobWithResponseReturnsOnCall[len(fake.runCronJobWithResponseArgsForCall)]
+	fake.runCronJobWithResponseArgsForCall = append(fake.runCronJobWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.CronJobIdParam
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RunCronJobWithResponseStub
+	fakeReturns := fake.runCronJobWithResponseReturns
+	fake.recordInvocation("RunCronJobWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.runCronJobWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) RunCronJobWithResponseCallCount() int {
+	fake.runCronJobWithResponseMutex.RLock()
+	defer fake.runCronJobWithResponseMutex.RUnlock()
+	return len(fake.runCronJobWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) RunCronJobWithResponseCalls(stub func(context.Context, client.CronJobIdParam, ...client.RequestEditorFn) (*client.RunCronJobResponse, error)) {
+	fake.runCronJobWithResponseMutex.Lock()
+	defer fake.runCronJobWithResponseMutex.Unlock()
+	fake.RunCronJobWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) RunCronJobWithResponseArgsForCall(i int) (context.Context, client.CronJobIdParam, []client.RequestEditorFn) {
+	fake.runCronJobWithResponseMutex.RLock()
+	defer fake.runCronJobWithResponseMutex.RUnlock()
+	argsForCall := fake.runCronJobWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) RunCronJobWithResponseReturns(result1 *client.RunCronJobResponse, result2 error) {
+	fake.runCronJobWithResponseMutex.Lock()
+	defer fake.runCronJobWithResponseMutex.Unlock()
+	fake.RunCronJobWithResponseStub = nil
+	fake.runCronJobWithResponseReturns = struct {
+		result1 *client.RunCronJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) RunCronJobWithResponseReturnsOnCall(i int, result1 *client.RunCronJobResponse, result2 error) {
+	fake.runCronJobWithResponseMutex.Lock()
+	defer fake.runCronJobWithResponseMutex.Unlock()
+	fake.RunCronJobWithResponseStub = nil
+	if fake.runCronJobWithResponseReturnsOnCall == nil {
+		fake.runCronJobWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RunCronJobResponse
+			result2 error
+		})
+	}
+	fake.runCronJobWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RunCronJobResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponse(arg1 context.Context, arg2 string, arg3 client.ScaleServiceJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.ScaleServiceResponse, error) {
+	fake.scaleServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.scaleServiceWithResponseReturnsOnCall[len(fake.scaleServiceWithResponseArgsForCall)]
+	fake.scaleServiceWithResponseArgsForCall = append(fake.scaleServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.ScaleServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ScaleServiceWithResponseStub
+	fakeReturns := fake.scaleServiceWithResponseReturns
+	fake.recordInvocation("ScaleServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.scaleServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponseCallCount() int {
+	fake.scaleServiceWithResponseMutex.RLock()
+	defer fake.scaleServiceWithResponseMutex.RUnlock()
+	return len(fake.scaleServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponseCalls(stub func(context.Context, string, client.ScaleServiceJSONRequestBody, ...client.RequestEditorFn) (*client.ScaleServiceResponse, error)) {
+	fake.scaleServiceWithResponseMutex.Lock()
+	defer fake.scaleServiceWithResponseMutex.Unlock()
+	fake.ScaleServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponseArgsForCall(i int) (context.Context, string, client.ScaleServiceJSONRequestBody, []client.RequestEditorFn) {
+	fake.scaleServiceWithResponseMutex.RLock()
+	defer fake.scaleServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.scaleServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponseReturns(result1 *client.ScaleServiceResponse, result2 error) {
+	fake.scaleServiceWithResponseMutex.Lock()
+	defer fake.scaleServiceWithResponseMutex.Unlock()
+	fake.ScaleServiceWithResponseStub = nil
+	fake.scaleServiceWithResponseReturns = struct {
+		result1 *client.ScaleServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) ScaleServiceWithResponseReturnsOnCall(i int, result1 *client.ScaleServiceResponse, result2 error) {
+	fake.scaleServiceWithResponseMutex.Lock()
+	defer fake.scaleServiceWithResponseMutex.Unlock()
+	fake.ScaleServiceWithResponseStub = nil
+	if fake.scaleServiceWithResponseReturnsOnCall == nil {
+		fake.scaleServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ScaleServiceResponse
+			result2 error
+		})
+	}
+	fake.scaleServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ScaleServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.SuspendServiceResponse, error) {
+	fake.suspendServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.suspendServiceWithResponseReturnsOnCall[len(fake.suspendServiceWithResponseArgsForCall)]
+	fake.suspendServiceWithResponseArgsForCall = append(fake.suspendServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.SuspendServiceWithResponseStub
+	fakeReturns := fake.suspendServiceWithResponseReturns
+	fake.recordInvocation("SuspendServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.suspendServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponseCallCount() int {
+	fake.suspendServiceWithResponseMutex.RLock()
+	defer fake.suspendServiceWithResponseMutex.RUnlock()
+	return len(fake.suspendServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.SuspendServiceResponse, error)) {
+	fake.suspendServiceWithResponseMutex.Lock()
+	defer fake.suspendServiceWithResponseMutex.Unlock()
+	fake.SuspendServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.suspendServiceWithResponseMutex.RLock()
+	defer fake.suspendServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.suspendServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponseReturns(result1 *client.SuspendServiceResponse, result2 error) {
+	fake.suspendServiceWithResponseMutex.Lock()
+	defer fake.suspendServiceWithResponseMutex.Unlock()
+	fake.SuspendServiceWithResponseStub = nil
+	fake.suspendServiceWithResponseReturns = struct {
+		result1 *client.SuspendServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) SuspendServiceWithResponseReturnsOnCall(i int, result1 *client.SuspendServiceResponse, result2 error) {
+	fake.suspendServiceWithResponseMutex.Lock()
+	defer fake.suspendServiceWithResponseMutex.Unlock()
+	fake.SuspendServiceWithResponseStub = nil
+	if fake.suspendServiceWithResponseReturnsOnCall == nil {
+		fake.suspendServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.SuspendServiceResponse
+			result2 error
+		})
+	}
+	fake.suspendServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.SuspendServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepoClient) UpdateEnvVarsForServiceWithResponse(arg1 context.Context, arg2 string, arg3 []client.EnvVarInput, arg4 ...client.RequestEditorFn) (*client.UpdateEnvVarsForServiceResponse, error) {
 	var arg3Copy []client.EnvVarInput
 	if arg3 != nil {
@@ -510,21 +1692,187 @@ func (fake *FakeServiceRepoClient) UpdateEnvVarsForServiceWithResponseReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponse(arg1 context.Context, arg2 string, arg3 client.UpdateSecretFilesForServiceJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.UpdateSecretFilesForServiceResponse, error) {
+	fake.updateSecretFilesForServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateSecretFilesForServiceWithResponseReturnsOnCall[len(fake.updateSecretFilesForServiceWithResponseArgsForCall)]
+	fake.updateSecretFilesForServiceWithResponseArgsForCall = append(fake.updateSecretFilesForServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateSecretFilesForServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateSecretFilesForServiceWithResponseStub
+	fakeReturns := fake.updateSecretFilesForServiceWithResponseReturns
+	fake.recordInvocation("UpdateSecretFilesForServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateSecretFilesForServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponseCallCount() int {
+	fake.updateSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.RUnlock()
+	return len(fake.updateSecretFilesForServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponseCalls(stub func(context.Context, string, client.UpdateSecretFilesForServiceJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateSecretFilesForServiceResponse, error)) {
+	fake.updateSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.UpdateSecretFilesForServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponseArgsForCall(i int) (context.Context, string, client.UpdateSecretFilesForServiceJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.updateSecretFilesForServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponseReturns(result1 *client.UpdateSecretFilesForServiceResponse, result2 error) {
+	fake.updateSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.UpdateSecretFilesForServiceWithResponseStub = nil
+	fake.updateSecretFilesForServiceWithResponseReturns = struct {
+		result1 *client.UpdateSecretFilesForServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) UpdateSecretFilesForServiceWithResponseReturnsOnCall(i int, result1 *client.UpdateSecretFilesForServiceResponse, result2 error) {
+	fake.updateSecretFilesForServiceWithResponseMutex.Lock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.Unlock()
+	fake.UpdateSecretFilesForServiceWithResponseStub = nil
+	if fake.updateSecretFilesForServiceWithResponseReturnsOnCall == nil {
+		fake.updateSecretFilesForServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateSecretFilesForServiceResponse
+			result2 error
+		})
+	}
+	fake.updateSecretFilesForServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateSecretFilesForServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponse(arg1 context.Context, arg2 string, arg3 client.UpdateServiceJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.UpdateServiceResponse, error) {
+	fake.updateServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateServiceWithResponseReturnsOnCall[len(fake.updateServiceWithResponseArgsForCall)]
+	fake.updateServiceWithResponseArgsForCall = append(fake.updateServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateServiceJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateServiceWithResponseStub
+	fakeReturns := fake.updateServiceWithResponseReturns
+	fake.recordInvocation("UpdateServiceWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponseCallCount() int {
+	fake.updateServiceWithResponseMutex.RLock()
+	defer fake.updateServiceWithResponseMutex.RUnlock()
+	return len(fake.updateServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponseCalls(stub func(context.Context, string, client.UpdateServiceJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateServiceResponse, error)) {
+	fake.updateServiceWithResponseMutex.Lock()
+	defer fake.updateServiceWithResponseMutex.Unlock()
+	fake.UpdateServiceWithResponseStub = stub
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponseArgsForCall(i int) (context.Context, string, client.UpdateServiceJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateServiceWithResponseMutex.RLock()
+	defer fake.updateServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.updateServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponseReturns(result1 *client.UpdateServiceResponse, result2 error) {
+	fake.updateServiceWithResponseMutex.Lock()
+	defer fake.updateServiceWithResponseMutex.Unlock()
+	fake.UpdateServiceWithResponseStub = nil
+	fake.updateServiceWithResponseReturns = struct {
+		result1 *client.UpdateServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepoClient) UpdateServiceWithResponseReturnsOnCall(i int, result1 *client.UpdateServiceResponse, result2 error) {
+	fake.updateServiceWithResponseMutex.Lock()
+	defer fake.updateServiceWithResponseMutex.Unlock()
+	fake.UpdateServiceWithResponseStub = nil
+	if fake.updateServiceWithResponseReturnsOnCall == nil {
+		fake.updateServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateServiceResponse
+			result2 error
+		})
+	}
+	fake.updateServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepoClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addOrUpdateSecretFileWithResponseMutex.RLock()
+	defer fake.addOrUpdateSecretFileWithResponseMutex.RUnlock()
+	fake.autoscaleServiceWithResponseMutex.RLock()
+	defer fake.autoscaleServiceWithResponseMutex.RUnlock()
+	fake.cancelCronJobRunWithResponseMutex.RLock()
+	defer fake.cancelCronJobRunWithResponseMutex.RUnlock()
 	fake.createDeployWithResponseMutex.RLock()
 	defer fake.createDeployWithResponseMutex.RUnlock()
 	fake.createServiceWithResponseMutex.RLock()
 	defer fake.createServiceWithResponseMutex.RUnlock()
+	fake.deleteAutoscalingConfigWithResponseMutex.RLock()
+	defer fake.deleteAutoscalingConfigWithResponseMutex.RUnlock()
+	fake.deleteEnvVarWithResponseMutex.RLock()
+	defer fake.deleteEnvVarWithResponseMutex.RUnlock()
+	fake.deleteSecretFileWithResponseMutex.RLock()
+	defer fake.deleteSecretFileWithResponseMutex.RUnlock()
+	fake.deleteServiceWithResponseMutex.RLock()
+	defer fake.deleteServiceWithResponseMutex.RUnlock()
 	fake.getEnvVarsForServiceWithResponseMutex.RLock()
 	defer fake.getEnvVarsForServiceWithResponseMutex.RUnlock()
+	fake.listSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.listSecretFilesForServiceWithResponseMutex.RUnlock()
 	fake.listServicesWithResponseMutex.RLock()
 	defer fake.listServicesWithResponseMutex.RUnlock()
+	fake.restartServiceWithResponseMutex.RLock()
+	defer fake.restartServiceWithResponseMutex.RUnlock()
+	fake.resumeServiceWithResponseMutex.RLock()
+	defer fake.resumeServiceWithResponseMutex.RUnlock()
+	fake.retrieveSecretFileWithResponseMutex.RLock()
+	defer fake.retrieveSecretFileWithResponseMutex.RUnlock()
 	fake.retrieveServiceWithResponseMutex.RLock()
 	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	fake.runCronJobWithResponseMutex.RLock()
+	defer fake.runCronJobWithResponseMutex.RUnlock()
+	fake.scaleServiceWithResponseMutex.RLock()
+	defer fake.scaleServiceWithResponseMutex.RUnlock()
+	fake.suspendServiceWithResponseMutex.RLock()
+	defer fake.suspendServiceWithResponseMutex.RUnlock()
 	fake.updateEnvVarsForServiceWithResponseMutex.RLock()
 	defer fake.updateEnvVarsForServiceWithResponseMutex.RUnlock()
+	fake.updateSecretFilesForServiceWithResponseMutex.RLock()
+	defer fake.updateSecretFilesForServiceWithResponseMutex.RUnlock()
+	fake.updateServiceWithResponseMutex.RLock()
+	defer fake.updateServiceWithResponseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
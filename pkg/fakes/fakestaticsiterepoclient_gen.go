@@ -0,0 +1,799 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+type FakeStaticSiteRepoClient struct {
+	AddHeadersWithResponseStub        func(context.Context, string, client.AddHeadersJSONRequestBody, ...client.RequestEditorFn) (*client.AddHeadersResponse, error)
+	addHeadersWithResponseMutex       sync.RWMutex
+	addHeadersWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddHeadersJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	addHeadersWithResponseReturns struct {
+		result1 *client.AddHeadersResponse
+		result2 error
+	}
+	addHeadersWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AddHeadersResponse
+		result2 error
+	}
+	AddRouteWithResponseStub        func(context.Context, string, client.AddRouteJSONRequestBody, ...client.RequestEditorFn) (*client.AddRouteResponse, error)
+	addRouteWithResponseMutex       sync.RWMutex
+	addRouteWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddRouteJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	addRouteWithResponseReturns struct {
+		result1 *client.AddRouteResponse
+		result2 error
+	}
+	addRouteWithResponseReturnsOnCall map[int]struct {
+		result1 *client.AddRouteResponse
+		result2 error
+	}
+	DeleteHeaderWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteHeaderResponse, error)
+	deleteHeaderWithResponseMutex       sync.RWMutex
+	deleteHeaderWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	deleteHeaderWithResponseReturns struct {
+		result1 *client.DeleteHeaderResponse
+		result2 error
+	}
+	deleteHeaderWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteHeaderResponse
+		result2 error
+	}
+	DeleteRouteWithResponseStub        func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteRouteResponse, error)
+	deleteRouteWithResponseMutex       sync.RWMutex
+	deleteRouteWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}
+	deleteRouteWithResponseReturns struct {
+		result1 *client.DeleteRouteResponse
+		result2 error
+	}
+	deleteRouteWithResponseReturnsOnCall map[int]struct {
+		result1 *client.DeleteRouteResponse
+		result2 error
+	}
+	ListHeadersWithResponseStub        func(context.Context, string, *client.ListHeadersParams, ...client.RequestEditorFn) (*client.ListHeadersResponse, error)
+	listHeadersWithResponseMutex       sync.RWMutex
+	listHeadersWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListHeadersParams
+		arg4 []client.RequestEditorFn
+	}
+	listHeadersWithResponseReturns struct {
+		result1 *client.ListHeadersResponse
+		result2 error
+	}
+	listHeadersWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListHeadersResponse
+		result2 error
+	}
+	ListRoutesWithResponseStub        func(context.Context, string, *client.ListRoutesParams, ...client.RequestEditorFn) (*client.ListRoutesResponse, error)
+	listRoutesWithResponseMutex       sync.RWMutex
+	listRoutesWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListRoutesParams
+		arg4 []client.RequestEditorFn
+	}
+	listRoutesWithResponseReturns struct {
+		result1 *client.ListRoutesResponse
+		result2 error
+	}
+	listRoutesWithResponseReturnsOnCall map[int]struct {
+		result1 *client.ListRoutesResponse
+		result2 error
+	}
+	PutRoutesWithResponseStub        func(context.Context, string, client.PutRoutesJSONRequestBody, ...client.RequestEditorFn) (*client.PutRoutesResponse, error)
+	putRoutesWithResponseMutex       sync.RWMutex
+	putRoutesWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.PutRoutesJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	putRoutesWithResponseReturns struct {
+		result1 *client.PutRoutesResponse
+		result2 error
+	}
+	putRoutesWithResponseReturnsOnCall map[int]struct {
+		result1 *client.PutRoutesResponse
+		result2 error
+	}
+	RetrieveServiceWithResponseStub        func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	retrieveServiceWithResponseMutex       sync.RWMutex
+	retrieveServiceWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}
+	retrieveServiceWithResponseReturns struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	retrieveServiceWithResponseReturnsOnCall map[int]struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}
+	UpdateHeadersWithResponseStub        func(context.Context, string, client.UpdateHeadersJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateHeadersResponse, error)
+	updateHeadersWithResponseMutex       sync.RWMutex
+	updateHeadersWithResponseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateHeadersJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}
+	updateHeadersWithResponseReturns struct {
+		result1 *client.UpdateHeadersResponse
+		result2 error
+	}
+	updateHeadersWithResponseReturnsOnCall map[int]struct {
+		result1 *client.UpdateHeadersResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponse(arg1 context.Context, arg2 string, arg3 client.AddHeadersJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.AddHeadersResponse, error) {
+	fake.addHeadersWithResponseMutex.Lock()
+	ret, specificReturn := fake.addHeadersWithResponseReturnsOnCall[len(fake.addHeadersWithResponseArgsForCall)]
+	fake.addHeadersWithResponseArgsForCall = append(fake.addHeadersWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddHeadersJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddHeadersWithResponseStub
+	fakeReturns := fake.addHeadersWithResponseReturns
+	fake.recordInvocation("AddHeadersWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addHeadersWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponseCallCount() int {
+	fake.addHeadersWithResponseMutex.RLock()
+	defer fake.addHeadersWithResponseMutex.RUnlock()
+	return len(fake.addHeadersWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponseCalls(stub func(context.Context, string, client.AddHeadersJSONRequestBody, ...client.RequestEditorFn) (*client.AddHeadersResponse, error)) {
+	fake.addHeadersWithResponseMutex.Lock()
+	defer fake.addHeadersWithResponseMutex.Unlock()
+	fake.AddHeadersWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponseArgsForCall(i int) (context.Context, string, client.AddHeadersJSONRequestBody, []client.RequestEditorFn) {
+	fake.addHeadersWithResponseMutex.RLock()
+	defer fake.addHeadersWithResponseMutex.RUnlock()
+	argsForCall := fake.addHeadersWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponseReturns(result1 *client.AddHeadersResponse, result2 error) {
+	fake.addHeadersWithResponseMutex.Lock()
+	defer fake.addHeadersWithResponseMutex.Unlock()
+	fake.AddHeadersWithResponseStub = nil
+	fake.addHeadersWithResponseReturns = struct {
+		result1 *client.AddHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) AddHeadersWithResponseReturnsOnCall(i int, result1 *client.AddHeadersResponse, result2 error) {
+	fake.addHeadersWithResponseMutex.Lock()
+	defer fake.addHeadersWithResponseMutex.Unlock()
+	fake.AddHeadersWithResponseStub = nil
+	if fake.addHeadersWithResponseReturnsOnCall == nil {
+		fake.addHeadersWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AddHeadersResponse
+			result2 error
+		})
+	}
+	fake.addHeadersWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AddHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponse(arg1 context.Context, arg2 string, arg3 client.AddRouteJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.AddRouteResponse, error) {
+	fake.addRouteWithResponseMutex.Lock()
+	ret, specificReturn := fake.addRouteWithResponseReturnsOnCall[len(fake.addRouteWithResponseArgsForCall)]
+	fake.addRouteWithResponseArgsForCall = append(fake.addRouteWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.AddRouteJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddRouteWithResponseStub
+	fakeReturns := fake.addRouteWithResponseReturns
+	fake.recordInvocation("AddRouteWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addRouteWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponseCallCount() int {
+	fake.addRouteWithResponseMutex.RLock()
+	defer fake.addRouteWithResponseMutex.RUnlock()
+	return len(fake.addRouteWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponseCalls(stub func(context.Context, string, client.AddRouteJSONRequestBody, ...client.RequestEditorFn) (*client.AddRouteResponse, error)) {
+	fake.addRouteWithResponseMutex.Lock()
+	defer fake.addRouteWithResponseMutex.Unlock()
+	fake.AddRouteWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponseArgsForCall(i int) (context.Context, string, client.AddRouteJSONRequestBody, []client.RequestEditorFn) {
+	fake.addRouteWithResponseMutex.RLock()
+	defer fake.addRouteWithResponseMutex.RUnlock()
+	argsForCall := fake.addRouteWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponseReturns(result1 *client.AddRouteResponse, result2 error) {
+	fake.addRouteWithResponseMutex.Lock()
+	defer fake.addRouteWithResponseMutex.Unlock()
+	fake.AddRouteWithResponseStub = nil
+	fake.addRouteWithResponseReturns = struct {
+		result1 *client.AddRouteResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) AddRouteWithResponseReturnsOnCall(i int, result1 *client.AddRouteResponse, result2 error) {
+	fake.addRouteWithResponseMutex.Lock()
+	defer fake.addRouteWithResponseMutex.Unlock()
+	fake.AddRouteWithResponseStub = nil
+	if fake.addRouteWithResponseReturnsOnCall == nil {
+		fake.addRouteWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.AddRouteResponse
+			result2 error
+		})
+	}
+	fake.addRouteWithResponseReturnsOnCall[i] = struct {
+		result1 *client.AddRouteResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.DeleteHeaderResponse, error) {
+	fake.deleteHeaderWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteHeaderWithResponseReturnsOnCall[len(fake.deleteHeaderWithResponseArgsForCall)]
+	fake.deleteHeaderWithResponseArgsForCall = append(fake.deleteHeaderWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteHeaderWithResponseStub
+	fakeReturns := fake.deleteHeaderWithResponseReturns
+	fake.recordInvocation("DeleteHeaderWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteHeaderWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponseCallCount() int {
+	fake.deleteHeaderWithResponseMutex.RLock()
+	defer fake.deleteHeaderWithResponseMutex.RUnlock()
+	return len(fake.deleteHeaderWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteHeaderResponse, error)) {
+	fake.deleteHeaderWithResponseMutex.Lock()
+	defer fake.deleteHeaderWithResponseMutex.Unlock()
+	fake.DeleteHeaderWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.deleteHeaderWithResponseMutex.RLock()
+	defer fake.deleteHeaderWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteHeaderWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponseReturns(result1 *client.DeleteHeaderResponse, result2 error) {
+	fake.deleteHeaderWithResponseMutex.Lock()
+	defer fake.deleteHeaderWithResponseMutex.Unlock()
+	fake.DeleteHeaderWithResponseStub = nil
+	fake.deleteHeaderWithResponseReturns = struct {
+		result1 *client.DeleteHeaderResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteHeaderWithResponseReturnsOnCall(i int, result1 *client.DeleteHeaderResponse, result2 error) {
+	fake.deleteHeaderWithResponseMutex.Lock()
+	defer fake.deleteHeaderWithResponseMutex.Unlock()
+	fake.DeleteHeaderWithResponseStub = nil
+	if fake.deleteHeaderWithResponseReturnsOnCall == nil {
+		fake.deleteHeaderWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteHeaderResponse
+			result2 error
+		})
+	}
+	fake.deleteHeaderWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteHeaderResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponse(arg1 context.Context, arg2 string, arg3 string, arg4 ...client.RequestEditorFn) (*client.DeleteRouteResponse, error) {
+	fake.deleteRouteWithResponseMutex.Lock()
+	ret, specificReturn := fake.deleteRouteWithResponseReturnsOnCall[len(fake.deleteRouteWithResponseArgsForCall)]
+	fake.deleteRouteWithResponseArgsForCall = append(fake.deleteRouteWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteRouteWithResponseStub
+	fakeReturns := fake.deleteRouteWithResponseReturns
+	fake.recordInvocation("DeleteRouteWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteRouteWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponseCallCount() int {
+	fake.deleteRouteWithResponseMutex.RLock()
+	defer fake.deleteRouteWithResponseMutex.RUnlock()
+	return len(fake.deleteRouteWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponseCalls(stub func(context.Context, string, string, ...client.RequestEditorFn) (*client.DeleteRouteResponse, error)) {
+	fake.deleteRouteWithResponseMutex.Lock()
+	defer fake.deleteRouteWithResponseMutex.Unlock()
+	fake.DeleteRouteWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponseArgsForCall(i int) (context.Context, string, string, []client.RequestEditorFn) {
+	fake.deleteRouteWithResponseMutex.RLock()
+	defer fake.deleteRouteWithResponseMutex.RUnlock()
+	argsForCall := fake.deleteRouteWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponseReturns(result1 *client.DeleteRouteResponse, result2 error) {
+	fake.deleteRouteWithResponseMutex.Lock()
+	defer fake.deleteRouteWithResponseMutex.Unlock()
+	fake.DeleteRouteWithResponseStub = nil
+	fake.deleteRouteWithResponseReturns = struct {
+		result1 *client.DeleteRouteResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) DeleteRouteWithResponseReturnsOnCall(i int, result1 *client.DeleteRouteResponse, result2 error) {
+	fake.deleteRouteWithResponseMutex.Lock()
+	defer fake.deleteRouteWithResponseMutex.Unlock()
+	fake.DeleteRouteWithResponseStub = nil
+	if fake.deleteRouteWithResponseReturnsOnCall == nil {
+		fake.deleteRouteWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.DeleteRouteResponse
+			result2 error
+		})
+	}
+	fake.deleteRouteWithResponseReturnsOnCall[i] = struct {
+		result1 *client.DeleteRouteResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponse(arg1 context.Context, arg2 string, arg3 *client.ListHeadersParams, arg4 ...client.RequestEditorFn) (*client.ListHeadersResponse, error) {
+	fake.listHeadersWithResponseMutex.Lock()
+	ret, specificReturn := fake.listHeadersWithResponseReturnsOnCall[len(fake.listHeadersWithResponseArgsForCall)]
+	fake.listHeadersWithResponseArgsForCall = append(fake.listHeadersWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListHeadersParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListHeadersWithResponseStub
+	fakeReturns := fake.listHeadersWithResponseReturns
+	fake.recordInvocation("ListHeadersWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listHeadersWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponseCallCount() int {
+	fake.listHeadersWithResponseMutex.RLock()
+	defer fake.listHeadersWithResponseMutex.RUnlock()
+	return len(fake.listHeadersWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponseCalls(stub func(context.Context, string, *client.ListHeadersParams, ...client.RequestEditorFn) (*client.ListHeadersResponse, error)) {
+	fake.listHeadersWithResponseMutex.Lock()
+	defer fake.listHeadersWithResponseMutex.Unlock()
+	fake.ListHeadersWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponseArgsForCall(i int) (context.Context, string, *client.ListHeadersParams, []client.RequestEditorFn) {
+	fake.listHeadersWithResponseMutex.RLock()
+	defer fake.listHeadersWithResponseMutex.RUnlock()
+	argsForCall := fake.listHeadersWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponseReturns(result1 *client.ListHeadersResponse, result2 error) {
+	fake.listHeadersWithResponseMutex.Lock()
+	defer fake.listHeadersWithResponseMutex.Unlock()
+	fake.ListHeadersWithResponseStub = nil
+	fake.listHeadersWithResponseReturns = struct {
+		result1 *client.ListHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) ListHeadersWithResponseReturnsOnCall(i int, result1 *client.ListHeadersResponse, result2 error) {
+	fake.listHeadersWithResponseMutex.Lock()
+	defer fake.listHeadersWithResponseMutex.Unlock()
+	fake.ListHeadersWithResponseStub = nil
+	if fake.listHeadersWithResponseReturnsOnCall == nil {
+		fake.listHeadersWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListHeadersResponse
+			result2 error
+		})
+	}
+	fake.listHeadersWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponse(arg1 context.Context, arg2 string, arg3 *client.ListRoutesParams, arg4 ...client.RequestEditorFn) (*client.ListRoutesResponse, error) {
+	fake.listRoutesWithResponseMutex.Lock()
+	ret, specificReturn := fake.listRoutesWithResponseReturnsOnCall[len(fake.listRoutesWithResponseArgsForCall)]
+	fake.listRoutesWithResponseArgsForCall = append(fake.listRoutesWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 *client.ListRoutesParams
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListRoutesWithResponseStub
+	fakeReturns := fake.listRoutesWithResponseReturns
+	fake.recordInvocation("ListRoutesWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listRoutesWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponseCallCount() int {
+	fake.listRoutesWithResponseMutex.RLock()
+	defer fake.listRoutesWithResponseMutex.RUnlock()
+	return len(fake.listRoutesWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponseCalls(stub func(context.Context, string, *client.ListRoutesParams, ...client.RequestEditorFn) (*client.ListRoutesResponse, error)) {
+	fake.listRoutesWithResponseMutex.Lock()
+	defer fake.listRoutesWithResponseMutex.Unlock()
+	fake.ListRoutesWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponseArgsForCall(i int) (context.Context, string, *client.ListRoutesParams, []client.RequestEditorFn) {
+	fake.listRoutesWithResponseMutex.RLock()
+	defer fake.listRoutesWithResponseMutex.RUnlock()
+	argsForCall := fake.listRoutesWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponseReturns(result1 *client.ListRoutesResponse, result2 error) {
+	fake.listRoutesWithResponseMutex.Lock()
+	defer fake.listRoutesWithResponseMutex.Unlock()
+	fake.ListRoutesWithResponseStub = nil
+	fake.listRoutesWithResponseReturns = struct {
+		result1 *client.ListRoutesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) ListRoutesWithResponseReturnsOnCall(i int, result1 *client.ListRoutesResponse, result2 error) {
+	fake.listRoutesWithResponseMutex.Lock()
+	defer fake.listRoutesWithResponseMutex.Unlock()
+	fake.ListRoutesWithResponseStub = nil
+	if fake.listRoutesWithResponseReturnsOnCall == nil {
+		fake.listRoutesWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.ListRoutesResponse
+			result2 error
+		})
+	}
+	fake.listRoutesWithResponseReturnsOnCall[i] = struct {
+		result1 *client.ListRoutesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponse(arg1 context.Context, arg2 string, arg3 client.PutRoutesJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.PutRoutesResponse, error) {
+	fake.putRoutesWithResponseMutex.Lock()
+	ret, specificReturn := fake.putRoutesWithResponseReturnsOnCall[len(fake.putRoutesWithResponseArgsForCall)]
+	fake.putRoutesWithResponseArgsForCall = append(fake.putRoutesWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.PutRoutesJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PutRoutesWithResponseStub
+	fakeReturns := fake.putRoutesWithResponseReturns
+	fake.recordInvocation("PutRoutesWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.putRoutesWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponseCallCount() int {
+	fake.putRoutesWithResponseMutex.RLock()
+	defer fake.putRoutesWithResponseMutex.RUnlock()
+	return len(fake.putRoutesWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponseCalls(stub func(context.Context, string, client.PutRoutesJSONRequestBody, ...client.RequestEditorFn) (*client.PutRoutesResponse, error)) {
+	fake.putRoutesWithResponseMutex.Lock()
+	defer fake.putRoutesWithResponseMutex.Unlock()
+	fake.PutRoutesWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponseArgsForCall(i int) (context.Context, string, client.PutRoutesJSONRequestBody, []client.RequestEditorFn) {
+	fake.putRoutesWithResponseMutex.RLock()
+	defer fake.putRoutesWithResponseMutex.RUnlock()
+	argsForCall := fake.putRoutesWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponseReturns(result1 *client.PutRoutesResponse, result2 error) {
+	fake.putRoutesWithResponseMutex.Lock()
+	defer fake.putRoutesWithResponseMutex.Unlock()
+	fake.PutRoutesWithResponseStub = nil
+	fake.putRoutesWithResponseReturns = struct {
+		result1 *client.PutRoutesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) PutRoutesWithResponseReturnsOnCall(i int, result1 *client.PutRoutesResponse, result2 error) {
+	fake.putRoutesWithResponseMutex.Lock()
+	defer fake.putRoutesWithResponseMutex.Unlock()
+	fake.PutRoutesWithResponseStub = nil
+	if fake.putRoutesWithResponseReturnsOnCall == nil {
+		fake.putRoutesWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.PutRoutesResponse
+			result2 error
+		})
+	}
+	fake.putRoutesWithResponseReturnsOnCall[i] = struct {
+		result1 *client.PutRoutesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponse(arg1 context.Context, arg2 string, arg3 ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	ret, specificReturn := fake.retrieveServiceWithResponseReturnsOnCall[len(fake.retrieveServiceWithResponseArgsForCall)]
+	fake.retrieveServiceWithResponseArgsForCall = append(fake.retrieveServiceWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.RequestEditorFn
+	}{arg1, arg2, arg3})
+	stub := fake.RetrieveServiceWithResponseStub
+	fakeReturns := fake.retrieveServiceWithResponseReturns
+	fake.recordInvocation("RetrieveServiceWithResponse", []interface{}{arg1, arg2, arg3})
+	fake.retrieveServiceWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponseCallCount() int {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	return len(fake.retrieveServiceWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponseCalls(stub func(context.Context, string, ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponseArgsForCall(i int) (context.Context, string, []client.RequestEditorFn) {
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	argsForCall := fake.retrieveServiceWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponseReturns(result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	fake.retrieveServiceWithResponseReturns = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) RetrieveServiceWithResponseReturnsOnCall(i int, result1 *client.RetrieveServiceResponse, result2 error) {
+	fake.retrieveServiceWithResponseMutex.Lock()
+	defer fake.retrieveServiceWithResponseMutex.Unlock()
+	fake.RetrieveServiceWithResponseStub = nil
+	if fake.retrieveServiceWithResponseReturnsOnCall == nil {
+		fake.retrieveServiceWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.RetrieveServiceResponse
+			result2 error
+		})
+	}
+	fake.retrieveServiceWithResponseReturnsOnCall[i] = struct {
+		result1 *client.RetrieveServiceResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponse(arg1 context.Context, arg2 string, arg3 client.UpdateHeadersJSONRequestBody, arg4 ...client.RequestEditorFn) (*client.UpdateHeadersResponse, error) {
+	fake.updateHeadersWithResponseMutex.Lock()
+	ret, specificReturn := fake.updateHeadersWithResponseReturnsOnCall[len(fake.updateHeadersWithResponseArgsForCall)]
+	fake.updateHeadersWithResponseArgsForCall = append(fake.updateHeadersWithResponseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 client.UpdateHeadersJSONRequestBody
+		arg4 []client.RequestEditorFn
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateHeadersWithResponseStub
+	fakeReturns := fake.updateHeadersWithResponseReturns
+	fake.recordInvocation("UpdateHeadersWithResponse", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateHeadersWithResponseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponseCallCount() int {
+	fake.updateHeadersWithResponseMutex.RLock()
+	defer fake.updateHeadersWithResponseMutex.RUnlock()
+	return len(fake.updateHeadersWithResponseArgsForCall)
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponseCalls(stub func(context.Context, string, client.UpdateHeadersJSONRequestBody, ...client.RequestEditorFn) (*client.UpdateHeadersResponse, error)) {
+	fake.updateHeadersWithResponseMutex.Lock()
+	defer fake.updateHeadersWithResponseMutex.Unlock()
+	fake.UpdateHeadersWithResponseStub = stub
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponseArgsForCall(i int) (context.Context, string, client.UpdateHeadersJSONRequestBody, []client.RequestEditorFn) {
+	fake.updateHeadersWithResponseMutex.RLock()
+	defer fake.updateHeadersWithResponseMutex.RUnlock()
+	argsForCall := fake.updateHeadersWithResponseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponseReturns(result1 *client.UpdateHeadersResponse, result2 error) {
+	fake.updateHeadersWithResponseMutex.Lock()
+	defer fake.updateHeadersWithResponseMutex.Unlock()
+	fake.UpdateHeadersWithResponseStub = nil
+	fake.updateHeadersWithResponseReturns = struct {
+		result1 *client.UpdateHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) UpdateHeadersWithResponseReturnsOnCall(i int, result1 *client.UpdateHeadersResponse, result2 error) {
+	fake.updateHeadersWithResponseMutex.Lock()
+	defer fake.updateHeadersWithResponseMutex.Unlock()
+	fake.UpdateHeadersWithResponseStub = nil
+	if fake.updateHeadersWithResponseReturnsOnCall == nil {
+		fake.updateHeadersWithResponseReturnsOnCall = make(map[int]struct {
+			result1 *client.UpdateHeadersResponse
+			result2 error
+		})
+	}
+	fake.updateHeadersWithResponseReturnsOnCall[i] = struct {
+		result1 *client.UpdateHeadersResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStaticSiteRepoClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addHeadersWithResponseMutex.RLock()
+	defer fake.addHeadersWithResponseMutex.RUnlock()
+	fake.addRouteWithResponseMutex.RLock()
+	defer fake.addRouteWithResponseMutex.RUnlock()
+	fake.deleteHeaderWithResponseMutex.RLock()
+	defer fake.deleteHeaderWithResponseMutex.RUnlock()
+	fake.deleteRouteWithResponseMutex.RLock()
+	defer fake.deleteRouteWithResponseMutex.RUnlock()
+	fake.listHeadersWithResponseMutex.RLock()
+	defer fake.listHeadersWithResponseMutex.RUnlock()
+	fake.listRoutesWithResponseMutex.RLock()
+	defer fake.listRoutesWithResponseMutex.RUnlock()
+	fake.putRoutesWithResponseMutex.RLock()
+	defer fake.putRoutesWithResponseMutex.RUnlock()
+	fake.retrieveServiceWithResponseMutex.RLock()
+	defer fake.retrieveServiceWithResponseMutex.RUnlock()
+	fake.updateHeadersWithResponseMutex.RLock()
+	defer fake.updateHeadersWithResponseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStaticSiteRepoClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
@@ -0,0 +1,116 @@
+package job
+
+import (
+	"context"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	jobtypes "github.com/render-oss/render-mcp-server/pkg/client/jobs"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+//go:generate go tool counterfeiter -o ../fakes/fakejobrepoclient_gen.go . jobRepoClient
+type jobRepoClient interface {
+	PostJobWithResponse(ctx context.Context, serviceId client.ServiceIdParam, body client.PostJobJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.PostJobResponse, error)
+	ListJobWithResponse(ctx context.Context, serviceId client.ServiceIdParam, params *client.ListJobParams, reqEditors ...client.RequestEditorFn) (*client.ListJobResponse, error)
+	RetrieveJobWithResponse(ctx context.Context, serviceId client.ServiceIdParam, jobId jobtypes.JobId, reqEditors ...client.RequestEditorFn) (*client.RetrieveJobResponse, error)
+	CancelJobWithResponse(ctx context.Context, serviceId client.ServiceIdParam, jobId jobtypes.JobId, reqEditors ...client.RequestEditorFn) (*client.CancelJobResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
+// Repo calls the job API. Methods that take a service ID don't check that the service belongs to the
+// current workspace, so callers must look it up with GetService first.
+type Repo struct {
+	client jobRepoClient
+}
+
+func NewRepo(c jobRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+// GetService returns a service after checking that it belongs to the current workspace. Jobs don't
+// have an owner of their own, so their workspace is that of their service.
+func (r *Repo) GetService(ctx context.Context, serviceId string) (*client.Service, error) {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) RunJob(ctx context.Context, serviceId string, body client.PostJobJSONRequestBody) (*jobtypes.Job, error) {
+	resp, err := r.client.PostJobWithResponse(ctx, serviceId, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+func (r *Repo) GetJob(ctx context.Context, serviceId string, jobId string) (*jobtypes.Job, error) {
+	resp, err := r.client.RetrieveJobWithResponse(ctx, serviceId, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) ListJobs(ctx context.Context, serviceId string, params *client.ListJobParams) ([]*jobtypes.Job, error) {
+	return client.ListAll(ctx, params, func(ctx context.Context, params *client.ListJobParams) ([]*jobtypes.Job, *client.Cursor, error) {
+		return r.listPage(ctx, serviceId, params)
+	})
+}
+
+func (r *Repo) listPage(ctx context.Context, serviceId string, params *client.ListJobParams) ([]*jobtypes.Job, *client.Cursor, error) {
+	resp, err := r.client.ListJobWithResponse(ctx, serviceId, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	jobs := make([]*jobtypes.Job, 0, len(res))
+	for _, jobWithCursor := range res {
+		jobs = append(jobs, &jobWithCursor.Job)
+	}
+
+	return jobs, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) CancelJob(ctx context.Context, serviceId string, jobId string) (*jobtypes.Job, error) {
+	resp, err := r.client.CancelJobWithResponse(ctx, serviceId, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,354 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	jobtypes "github.com/render-oss/render-mcp-server/pkg/client/jobs"
+	logsclient "github.com/render-oss/render-mcp-server/pkg/client/logs"
+	"github.com/render-oss/render-mcp-server/pkg/logs"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+const (
+	defaultWaitTimeoutSeconds = 300
+	maxWaitTimeoutSeconds     = 900
+
+	// maxJobLogs bounds the logs returned with a job, so a chatty job doesn't flood the response.
+	maxJobLogs = 500
+)
+
+var (
+	// jobPollInterval is how often run_job checks on a job it waits for. It's a variable so tests
+	// don't have to wait.
+	jobPollInterval = 5 * time.Second
+	// jobTimeoutUnit is the length of one unit of timeoutSeconds. It's a variable so tests can time
+	// out without waiting a whole second.
+	jobTimeoutUnit = time.Second
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	jobRepo := NewRepo(c)
+	logRepo := logs.NewLogRepo(c)
+
+	tool, handler := runJob(jobRepo, logRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listJobs(jobRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = cancelJob(jobRepo)
+	s.AddTool(*tool, handler)
+}
+
+// RunJobResult is a job, and if run_job waited for it, the logs it wrote.
+type RunJobResult struct {
+	Job *jobtypes.Job `json:"job"`
+	// Finished is true once the job has succeeded, failed or been canceled. It's false if the wait
+	// timed out first, in which case the logs are those written so far.
+	Finished bool             `json:"finished"`
+	Logs     []logsclient.Log `json:"logs,omitempty"`
+	// LogsTruncated is true if older logs were left out to keep only the most recent maxJobLogs.
+	LogsTruncated bool `json:"logsTruncated,omitempty"`
+	// LogsError is set if the job's logs couldn't be fetched. The job's status is still accurate.
+	LogsError string `json:"logsError,omitempty"`
+}
+
+func runJob(jobRepo *Repo, logRepo *logs.LogRepo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("run_job",
+		mcp.WithDescription("Run a one-off job, such as a database migration, using a service's latest successful build and environment. "+
+			"The job runs the given command on its own instance, separate from the instances serving traffic. "+
+			"Set 'wait' to 'true' to wait until the job succeeds, fails or is canceled, and get its logs along with its final status. "+
+			"Otherwise the job is returned as soon as it's created, and its progress can be checked with list_jobs, "+
+			"and its logs with list_logs using the job's ID as the resource."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Run job",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service whose build and environment the job uses"),
+		),
+		mcp.WithString("startCommand",
+			mcp.Required(),
+			mcp.Description("The command the job runs. For example, 'npm run migrate' or 'python manage.py migrate'."),
+		),
+		mcp.WithBoolean("wait",
+			mcp.Description(fmt.Sprintf("If true, wait for the job to finish and return its logs. Only the most recent %d lines are returned. Defaults to false.", maxJobLogs)),
+			mcp.DefaultBool(false),
+		),
+		mcp.WithNumber("timeoutSeconds",
+			mcp.Description("How long to wait for the job to finish before returning. The job keeps running if the wait times out."),
+			mcp.DefaultNumber(defaultWaitTimeoutSeconds),
+			mcp.Min(1),
+			mcp.Max(maxWaitTimeoutSeconds),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			startCommand, err := validate.RequiredToolParam[string](request, "startCommand")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			wait, _, err := validate.OptionalToolParam[bool](request, "wait")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			timeout := defaultWaitTimeoutSeconds * jobTimeoutUnit
+			if timeoutSeconds, ok, err := validate.OptionalToolParam[float64](request, "timeoutSeconds"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if timeoutSeconds < 1 || timeoutSeconds > maxWaitTimeoutSeconds {
+					return mcp.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between 1 and %d", maxWaitTimeoutSeconds)), nil
+				}
+				timeout = time.Duration(timeoutSeconds * float64(jobTimeoutUnit))
+			}
+
+			service, err := jobRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			job, err := jobRepo.RunJob(ctx, serviceId, client.PostJobJSONRequestBody{StartCommand: startCommand})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result := RunJobResult{Job: job, Finished: isFinished(job)}
+			if wait {
+				waitCtx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				result.Job, err = waitForJob(waitCtx, jobRepo, serviceId, job, func(job *jobtypes.Job) {
+					mcpserver.SendProgress(ctx, request, time.Since(job.CreatedAt).Seconds(), nil, fmt.Sprintf("Job %s is %s", job.Id, jobStatus(job)))
+				})
+				// Running out of time only ends the wait. The caller cancelling the request is an error.
+				if err != nil && (ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded)) {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				result.Finished = isFinished(result.Job)
+
+				result.Logs, result.LogsTruncated, err = jobLogs(ctx, logRepo, service.OwnerId, result.Job)
+				if err != nil {
+					result.LogsError = err.Error()
+				}
+			}
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+// waitForJob polls a job until it finishes or the context is done, and returns the latest state of
+// the job either way.
+func waitForJob(ctx context.Context, jobRepo *Repo, serviceId string, job *jobtypes.Job, onPoll func(*jobtypes.Job)) (*jobtypes.Job, error) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for !isFinished(job) {
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+
+		latest, err := jobRepo.GetJob(ctx, serviceId, job.Id)
+		if err != nil {
+			return job, err
+		}
+		job = latest
+		onPoll(job)
+	}
+
+	return job, nil
+}
+
+func isFinished(job *jobtypes.Job) bool {
+	switch jobStatus(job) {
+	case jobtypes.Succeeded, jobtypes.Failed, jobtypes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
+
+func jobStatus(job *jobtypes.Job) jobtypes.JobStatus {
+	if job.Status == nil {
+		return jobtypes.Pending
+	}
+	return *job.Status
+}
+
+// jobLogs fetches the logs a job wrote between starting and finishing, or until now if it's still
+// running, oldest first. When there are more than maxJobLogs, the most recent are kept, since that's
+// where a failing job reports why it failed. A job that hasn't started has no logs.
+func jobLogs(ctx context.Context, logRepo *logs.LogRepo, ownerId string, job *jobtypes.Job) ([]logsclient.Log, bool, error) {
+	if job.StartedAt == nil {
+		return []logsclient.Log{}, false, nil
+	}
+
+	endTime := time.Now()
+	if job.FinishedAt != nil {
+		endTime = *job.FinishedAt
+	}
+
+	params := &client.ListLogsParams{
+		OwnerId:   ownerId,
+		Resource:  []string{job.Id},
+		StartTime: job.StartedAt,
+		EndTime:   &endTime,
+		Direction: pointers.From(logsclient.Backward),
+		Limit:     pointers.From(100),
+	}
+
+	collected := []logsclient.Log{}
+	for {
+		resp, err := logRepo.ListLogs(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+
+		collected = append(collected, resp.Logs...)
+		if len(collected) >= maxJobLogs || !resp.HasMore {
+			truncated := len(collected) > maxJobLogs || resp.HasMore
+			if len(collected) > maxJobLogs {
+				collected = collected[:maxJobLogs]
+			}
+			slices.SortStableFunc(collected, func(a, b logsclient.Log) int {
+				return a.Timestamp.Compare(b.Timestamp)
+			})
+			return collected, truncated, nil
+		}
+
+		params.StartTime = &resp.NextStartTime
+		params.EndTime = &resp.NextEndTime
+	}
+}
+
+func listJobs(jobRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_jobs",
+		mcp.WithDescription("List the one-off jobs that have run on a service, with their commands, statuses and start and finish times."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List jobs",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service whose jobs to list"),
+		),
+		mcp.WithArray("status",
+			mcp.Description("Only list jobs with these statuses"),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+				"enum": mcpserver.EnumValuesFromClientType(jobtypes.Pending, jobtypes.Running, jobtypes.Succeeded, jobtypes.Failed, jobtypes.Canceled),
+			}),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			params := &client.ListJobParams{}
+			if statuses, ok, err := validate.OptionalToolArrayParam[string](request, "status"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				jobStatuses := make([]jobtypes.JobStatus, 0, len(statuses))
+				for _, status := range statuses {
+					jobStatuses = append(jobStatuses, jobtypes.JobStatus(status))
+				}
+				params.Status = &jobStatuses
+			}
+
+			if _, err := jobRepo.GetService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			jobs, err := jobRepo.ListJobs(ctx, serviceId, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(jobs)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func cancelJob(jobRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("cancel_job",
+		mcp.WithDescription("Cancel a one-off job that is pending or running. "+
+			"A job that is canceled partway through may leave its work incomplete, so check what it was doing before canceling it."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Cancel job",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service the job runs on"),
+		),
+		mcp.WithString("jobId",
+			mcp.Required(),
+			mcp.Description("The ID of the job to cancel"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			jobId, err := validate.RequiredToolParam[string](request, "jobId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := jobRepo.GetService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			job, err := jobRepo.CancelJob(ctx, serviceId, jobId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(job)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
@@ -0,0 +1,340 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	jobtypes "github.com/render-oss/render-mcp-server/pkg/client/jobs"
+	logsclient "github.com/render-oss/render-mcp-server/pkg/client/logs"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/logs"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunJobTool(t *testing.T) {
+	jobPollInterval = time.Millisecond
+	jobTimeoutUnit = 10 * time.Millisecond
+	t.Cleanup(func() {
+		jobPollInterval = 5 * time.Second
+		jobTimeoutUnit = time.Second
+	})
+
+	startedAt := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(42 * time.Second)
+
+	tests := []struct {
+		name             string
+		arguments        map[string]interface{}
+		ownerId          string
+		polledJobs       []*jobtypes.Job
+		expectedStatus   jobtypes.JobStatus
+		expectedFinished bool
+		expectedLogs     int
+		expectedError    string
+	}{
+		{
+			name:           "Returns the job without waiting",
+			arguments:      map[string]interface{}{},
+			expectedStatus: jobtypes.Pending,
+		},
+		{
+			name:      "Waits for the job and returns its logs",
+			arguments: map[string]interface{}{"wait": true},
+			polledJobs: []*jobtypes.Job{
+				job(jobtypes.Running, &startedAt, nil),
+				job(jobtypes.Succeeded, &startedAt, &finishedAt),
+			},
+			expectedStatus:   jobtypes.Succeeded,
+			expectedFinished: true,
+			expectedLogs:     2,
+		},
+		{
+			name:      "Returns failed jobs with their logs",
+			arguments: map[string]interface{}{"wait": true},
+			polledJobs: []*jobtypes.Job{
+				job(jobtypes.Failed, &startedAt, &finishedAt),
+			},
+			expectedStatus:   jobtypes.Failed,
+			expectedFinished: true,
+			expectedLogs:     2,
+		},
+		{
+			name:      "Returns the logs so far when the wait times out",
+			arguments: map[string]interface{}{"wait": true, "timeoutSeconds": float64(5)},
+			polledJobs: []*jobtypes.Job{
+				job(jobtypes.Running, &startedAt, nil),
+			},
+			expectedStatus: jobtypes.Running,
+			expectedLogs:   2,
+		},
+		{
+			name:          "Rejects out of range timeouts",
+			arguments:     map[string]interface{}{"wait": true, "timeoutSeconds": float64(3600)},
+			expectedError: "timeoutSeconds must be between 1 and 900",
+		},
+		{
+			name:          "Rejects timeouts under a second",
+			arguments:     map[string]interface{}{"wait": true, "timeoutSeconds": 0.05},
+			expectedError: "timeoutSeconds must be between 1 and 900",
+		},
+		{
+			name:          "Does not run a job on a service in another workspace",
+			arguments:     map[string]interface{}{},
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerId := tt.ownerId
+			if ownerId == "" {
+				ownerId = "own-123"
+			}
+
+			fakeClient := fakeClientWithService(ownerId)
+			fakeClient.PostJobWithResponseReturns(&client.PostJobResponse{
+				JSON201:      job(jobtypes.Pending, nil, nil),
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+			for i, polledJob := range tt.polledJobs {
+				fakeClient.RetrieveJobWithResponseReturnsOnCall(i, &client.RetrieveJobResponse{
+					JSON200:      polledJob,
+					HTTPResponse: &http.Response{StatusCode: 200},
+				}, nil)
+			}
+			if len(tt.polledJobs) > 0 {
+				// Keep returning the last state of the job once the scripted ones run out.
+				fakeClient.RetrieveJobWithResponseReturns(&client.RetrieveJobResponse{
+					JSON200:      tt.polledJobs[len(tt.polledJobs)-1],
+					HTTPResponse: &http.Response{StatusCode: 200},
+				}, nil)
+			}
+
+			var logsQuery url.Values
+			logRepo := logRepoWithLogs(t, &logsQuery, "Running migrations", "Migrated 3 tables")
+
+			request := mcp.CallToolRequest{}
+			arguments := map[string]interface{}{
+				"serviceId":    "srv-123",
+				"startCommand": "npm run migrate",
+			}
+			for k, v := range tt.arguments {
+				arguments[k] = v
+			}
+			request.Params.Arguments = arguments
+
+			_, handler := runJob(NewRepo(fakeClient), logRepo)
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.PostJobWithResponseCallCount())
+				return
+			}
+
+			require.False(t, result.IsError, result.Content)
+			var runResult RunJobResult
+			require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &runResult))
+			assert.Equal(t, tt.expectedStatus, *runResult.Job.Status)
+			assert.Equal(t, tt.expectedFinished, runResult.Finished)
+			assert.Len(t, runResult.Logs, tt.expectedLogs)
+			assert.Empty(t, runResult.LogsError)
+
+			_, serviceId, body, _ := fakeClient.PostJobWithResponseArgsForCall(0)
+			assert.Equal(t, "srv-123", serviceId)
+			assert.Equal(t, "npm run migrate", body.StartCommand)
+
+			if tt.expectedLogs == 0 {
+				assert.Equal(t, 0, fakeClient.RetrieveJobWithResponseCallCount())
+				assert.Nil(t, logsQuery)
+				return
+			}
+
+			assert.Equal(t, []string{"own-123"}, logsQuery["ownerId"])
+			assert.Equal(t, []string{"job-123"}, logsQuery["resource"])
+			assert.Equal(t, []string{"backward"}, logsQuery["direction"])
+			assert.Equal(t, []string{startedAt.Format(time.RFC3339)}, logsQuery["startTime"])
+			if tt.expectedFinished {
+				assert.Equal(t, []string{finishedAt.Format(time.RFC3339)}, logsQuery["endTime"])
+			}
+		})
+	}
+}
+
+func TestJobLogsKeepsMostRecentLogs(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// The server has 700 logs, one a second, and pages backward from the newest 300 at a time.
+	pages := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "backward", r.URL.Query().Get("direction"))
+
+		newest := 700 - pages*300
+		pages++
+		response := client.Logs200Response{Logs: []logsclient.Log{}, HasMore: newest > 300}
+		for i := newest; i > newest-300 && i > 0; i-- {
+			response.Logs = append(response.Logs, logsclient.Log{
+				Id:        fmt.Sprintf("log-%d", i),
+				Timestamp: start.Add(time.Duration(i) * time.Second),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClientWithResponses(srv.URL)
+	require.NoError(t, err)
+
+	finishedAt := start.Add(time.Hour)
+	collected, truncated, err := jobLogs(context.Background(), logs.NewLogRepo(c), "own-123", &jobtypes.Job{
+		Id:         "job-123",
+		StartedAt:  &start,
+		FinishedAt: &finishedAt,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, pages)
+	assert.True(t, truncated)
+	require.Len(t, collected, maxJobLogs)
+	assert.Equal(t, "log-201", collected[0].Id)
+	assert.Equal(t, "log-700", collected[len(collected)-1].Id)
+}
+
+func TestListJobsTool(t *testing.T) {
+	fakeClient := fakeClientWithService("own-123")
+	fakeClient.ListJobWithResponseReturns(&client.ListJobResponse{
+		JSON200: &[]client.JobWithCursor{
+			{Cursor: "cursor-1", Job: *job(jobtypes.Failed, nil, nil)},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId": "srv-123",
+		"status":    []interface{}{"failed"},
+	}
+
+	_, handler := listJobs(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content)
+
+	var jobs []jobtypes.Job
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &jobs))
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "job-123", jobs[0].Id)
+
+	_, serviceId, params, _ := fakeClient.ListJobWithResponseArgsForCall(0)
+	assert.Equal(t, "srv-123", serviceId)
+	assert.Equal(t, &[]jobtypes.JobStatus{jobtypes.Failed}, params.Status)
+}
+
+func TestCancelJobTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		ownerId       string
+		expectedError string
+	}{
+		{name: "Cancels a job"},
+		{
+			name:          "Does not cancel a job on a service in another workspace",
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerId := tt.ownerId
+			if ownerId == "" {
+				ownerId = "own-123"
+			}
+
+			fakeClient := fakeClientWithService(ownerId)
+			fakeClient.CancelJobWithResponseReturns(&client.CancelJobResponse{
+				JSON200:      job(jobtypes.Canceled, nil, nil),
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"serviceId": "srv-123",
+				"jobId":     "job-123",
+			}
+
+			_, handler := cancelJob(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.CancelJobWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			require.Equal(t, 1, fakeClient.CancelJobWithResponseCallCount())
+			_, serviceId, jobId, _ := fakeClient.CancelJobWithResponseArgsForCall(0)
+			assert.Equal(t, "srv-123", serviceId)
+			assert.Equal(t, "job-123", jobId)
+		})
+	}
+}
+
+func job(status jobtypes.JobStatus, startedAt, finishedAt *time.Time) *jobtypes.Job {
+	return &jobtypes.Job{
+		Id:           "job-123",
+		ServiceId:    "srv-123",
+		StartCommand: "npm run migrate",
+		CreatedAt:    time.Now(),
+		StartedAt:    startedAt,
+		FinishedAt:   finishedAt,
+		Status:       pointers.From(status),
+	}
+}
+
+func fakeClientWithService(ownerId string) *fakes.FakeJobRepoClient {
+	fakeClient := &fakes.FakeJobRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200:      &client.Service{Id: "srv-123", OwnerId: ownerId},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
+
+// logRepoWithLogs returns a log repo backed by a server that answers every query with the given
+// messages, and records the query it was sent.
+func logRepoWithLogs(t *testing.T, query *url.Values, messages ...string) *logs.LogRepo {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.Query()
+
+		response := client.Logs200Response{Logs: []logsclient.Log{}}
+		for _, message := range messages {
+			response.Logs = append(response.Logs, logsclient.Log{Id: message, Message: message, Timestamp: time.Now()})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClientWithResponses(srv.URL)
+	require.NoError(t, err)
+	return logs.NewLogRepo(c)
+}
@@ -0,0 +1,29 @@
+package keyvalue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+)
+
+// UpdateResult is returned by update_key_value. KeyValue is nil when the update was a dry run.
+type UpdateResult struct {
+	DryRun   bool                   `json:"dryRun"`
+	Changes  []diff.FieldChange     `json:"changes"`
+	KeyValue *client.KeyValueDetail `json:"keyValue,omitempty"`
+}
+
+// formatIPAllowList formats an IP allow list for a diff.FieldChange, one entry per CIDR block.
+func formatIPAllowList(ipAllowList []client.CidrBlockAndDescription) string {
+	entries := make([]string, 0, len(ipAllowList))
+	for _, entry := range ipAllowList {
+		if entry.Description == "" {
+			entries = append(entries, entry.CidrBlock)
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("%s (%s)", entry.CidrBlock, entry.Description))
+	}
+	return strings.Join(entries, ", ")
+}
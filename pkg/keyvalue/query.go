@@ -0,0 +1,338 @@
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultScanCount    = 100
+	maxScanCount        = 1000
+	defaultSlowlogCount = 10
+	maxSlowlogCount     = 128
+
+	// maxQueryResultBytes caps the total size of the strings in a result, so that a large value or a
+	// large hash doesn't flood the response.
+	maxQueryResultBytes = 64 * 1024
+
+	queryDialTimeout = 5 * time.Second
+	queryReadTimeout = 10 * time.Second
+)
+
+// queryCommands are the commands query_key_value runs. All of them are read-only, and none of them
+// return an unbounded amount of data once their results are capped.
+var queryCommands = []string{
+	"GET",
+	"MGET",
+	"HGETALL",
+	"TTL",
+	"TYPE",
+	"SCAN",
+	"INFO",
+	"MEMORY USAGE",
+	"SLOWLOG GET",
+}
+
+// QueryResult is the result of a command. Truncated is set if strings or entries were left out of
+// Result to keep it under the size limit.
+type QueryResult struct {
+	Command   string `json:"command"`
+	Result    any    `json:"result"`
+	Truncated bool   `json:"truncated"`
+}
+
+type ScanResult struct {
+	Cursor uint64   `json:"cursor"`
+	Keys   []string `json:"keys"`
+}
+
+type SlowlogEntry struct {
+	ID             int64    `json:"id"`
+	Time           string   `json:"time"`
+	DurationMicros int64    `json:"durationMicros"`
+	Args           []string `json:"args"`
+	ClientAddr     string   `json:"clientAddr,omitempty"`
+	ClientName     string   `json:"clientName,omitempty"`
+}
+
+// newQueryClient returns a client for a Key Value connection string. The connection string includes
+// the credentials and whether TLS is used.
+func newQueryClient(connString string) (*redis.Client, error) {
+	opts, err := redis.ParseURL(connString)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing connection string: %w", err)
+	}
+	opts.DialTimeout = queryDialTimeout
+	opts.ReadTimeout = queryReadTimeout
+	opts.PoolSize = 1
+
+	return redis.NewClient(opts), nil
+}
+
+// runQuery runs one of the allowed commands. Commands that aren't in queryCommands are refused
+// before anything is sent to the instance.
+func runQuery(ctx context.Context, rdb *redis.Client, command string, args []string) (*QueryResult, error) {
+	command = strings.ToUpper(strings.Join(strings.Fields(command), " "))
+	limit := &resultLimit{remaining: maxQueryResultBytes}
+
+	var result any
+	var err error
+	switch command {
+	case "GET":
+		var key string
+		if key, err = singleKey(command, args); err != nil {
+			return nil, err
+		}
+		var value string
+		value, err = rdb.Get(ctx, key).Result()
+		if errors.Is(err, redis.Nil) {
+			result, err = nil, nil
+		} else if err == nil {
+			result = limit.str(value)
+		}
+	case "MGET":
+		if len(args) == 0 {
+			return nil, errors.New("MGET requires at least one key")
+		}
+		var values []any
+		if values, err = rdb.MGet(ctx, args...).Result(); err == nil {
+			result = limitValues(limit, values)
+		}
+	case "HGETALL":
+		var key string
+		if key, err = singleKey(command, args); err != nil {
+			return nil, err
+		}
+		var fields map[string]string
+		if fields, err = rdb.HGetAll(ctx, key).Result(); err == nil {
+			result = limitFields(limit, fields)
+		}
+	case "TTL":
+		var key string
+		if key, err = singleKey(command, args); err != nil {
+			return nil, err
+		}
+		// TTL is run directly, since the typed command converts -1 (no expiry) and -2 (no key) into
+		// durations.
+		result, err = rdb.Do(ctx, "TTL", key).Int64()
+	case "TYPE":
+		var key string
+		if key, err = singleKey(command, args); err != nil {
+			return nil, err
+		}
+		result, err = rdb.Type(ctx, key).Result()
+	case "SCAN":
+		var scan *scanArgs
+		if scan, err = parseScanArgs(args); err != nil {
+			return nil, err
+		}
+		var keys []string
+		var cursor uint64
+		if keys, cursor, err = rdb.ScanType(ctx, scan.cursor, scan.match, scan.count, scan.keyType).Result(); err == nil {
+			result = ScanResult{Cursor: cursor, Keys: limitStrings(limit, keys)}
+		}
+	case "INFO":
+		var info string
+		if info, err = rdb.Info(ctx, args...).Result(); err == nil {
+			result = limit.str(info)
+		}
+	case "MEMORY USAGE":
+		var key string
+		if key, err = singleKey(command, args); err != nil {
+			return nil, err
+		}
+		result, err = rdb.MemoryUsage(ctx, key).Result()
+		if errors.Is(err, redis.Nil) {
+			result, err = nil, nil
+		}
+	case "SLOWLOG GET":
+		var count int64
+		if count, err = parseSlowlogCount(args); err != nil {
+			return nil, err
+		}
+		var logs []redis.SlowLog
+		if logs, err = rdb.SlowLogGet(ctx, count).Result(); err == nil {
+			result = slowlogEntries(limit, logs)
+		}
+	default:
+		return nil, fmt.Errorf("command refused: only %s can be run", strings.Join(queryCommands, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error running %s: %w", command, err)
+	}
+
+	return &QueryResult{
+		Command:   command,
+		Result:    result,
+		Truncated: limit.truncated,
+	}, nil
+}
+
+func singleKey(command string, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("%s takes exactly one key", command)
+	}
+	return args[0], nil
+}
+
+type scanArgs struct {
+	cursor  uint64
+	match   string
+	count   int64
+	keyType string
+}
+
+// parseScanArgs parses the arguments of SCAN cursor [MATCH pattern] [COUNT count] [TYPE type]. The
+// count defaults to defaultScanCount and can't be above maxScanCount.
+func parseScanArgs(args []string) (*scanArgs, error) {
+	scan := &scanArgs{count: defaultScanCount}
+	if len(args) == 0 {
+		return scan, nil
+	}
+
+	cursor, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCAN cursor %q", args[0])
+	}
+	scan.cursor = cursor
+
+	rest := args[1:]
+	for len(rest) > 0 {
+		if len(rest) < 2 {
+			return nil, fmt.Errorf("SCAN option %s is missing a value", rest[0])
+		}
+		option, value := strings.ToUpper(rest[0]), rest[1]
+		switch option {
+		case "MATCH":
+			scan.match = value
+		case "COUNT":
+			count, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || count < 1 || count > maxScanCount {
+				return nil, fmt.Errorf("SCAN COUNT must be between 1 and %d", maxScanCount)
+			}
+			scan.count = count
+		case "TYPE":
+			scan.keyType = value
+		default:
+			return nil, fmt.Errorf("unsupported SCAN option %s", rest[0])
+		}
+		rest = rest[2:]
+	}
+
+	return scan, nil
+}
+
+func parseSlowlogCount(args []string) (int64, error) {
+	switch len(args) {
+	case 0:
+		return defaultSlowlogCount, nil
+	case 1:
+		count, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || count < 1 || count > maxSlowlogCount {
+			return 0, fmt.Errorf("SLOWLOG GET count must be between 1 and %d", maxSlowlogCount)
+		}
+		return count, nil
+	default:
+		return 0, errors.New("SLOWLOG GET takes at most one argument")
+	}
+}
+
+// resultLimit tracks how many bytes of strings can still be added to a result.
+type resultLimit struct {
+	remaining int
+	truncated bool
+}
+
+// str returns s, cut short if it doesn't fit in the remaining budget.
+func (l *resultLimit) str(s string) string {
+	if len(s) <= l.remaining {
+		l.remaining -= len(s)
+		return s
+	}
+
+	l.truncated = true
+	cut := l.remaining
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	l.remaining = 0
+	return s[:cut]
+}
+
+// full reports whether the budget is used up, marking the result as truncated if it is.
+func (l *resultLimit) full() bool {
+	if l.remaining == 0 {
+		l.truncated = true
+		return true
+	}
+	return false
+}
+
+func limitValues(limit *resultLimit, values []any) []any {
+	limited := make([]any, 0, len(values))
+	for _, value := range values {
+		if limit.full() {
+			break
+		}
+		if s, ok := value.(string); ok {
+			value = limit.str(s)
+		}
+		limited = append(limited, value)
+	}
+	return limited
+}
+
+func limitFields(limit *resultLimit, fields map[string]string) map[string]string {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	// Fields are added in order, so that the same fields are left out every time.
+	sort.Strings(names)
+
+	limited := make(map[string]string, len(fields))
+	for _, field := range names {
+		if limit.full() {
+			break
+		}
+		limited[limit.str(field)] = limit.str(fields[field])
+	}
+	return limited
+}
+
+func limitStrings(limit *resultLimit, values []string) []string {
+	limited := make([]string, 0, len(values))
+	for _, value := range values {
+		if limit.full() {
+			break
+		}
+		limited = append(limited, limit.str(value))
+	}
+	return limited
+}
+
+func slowlogEntries(limit *resultLimit, logs []redis.SlowLog) []SlowlogEntry {
+	entries := make([]SlowlogEntry, 0, len(logs))
+	for _, log := range logs {
+		if limit.full() {
+			break
+		}
+		entries = append(entries, SlowlogEntry{
+			ID:             log.ID,
+			Time:           log.Time.UTC().Format(time.RFC3339),
+			DurationMicros: log.Duration.Microseconds(),
+			Args:           limitStrings(limit, log.Args),
+			ClientAddr:     log.ClientAddr,
+			ClientName:     log.ClientName,
+		})
+	}
+	return entries
+}
@@ -0,0 +1,114 @@
+package keyvalue
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	mr := miniredis.RunT(t)
+	rdb, err := newQueryClient("redis://" + mr.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = rdb.Close() })
+	return mr, rdb
+}
+
+func TestRunQuery(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	require.NoError(t, mr.Set("greeting", "hello"))
+	require.NoError(t, mr.Set("name", "render"))
+	mr.HSet("user:1", "name", "Ada", "plan", "pro")
+	mr.SetTTL("greeting", 90*time.Second)
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected any
+	}{
+		{name: "GET", command: "GET", args: []string{"greeting"}, expected: "hello"},
+		{name: "GET of a missing key", command: "get", args: []string{"missing"}, expected: nil},
+		{name: "MGET", command: "MGET", args: []string{"greeting", "missing", "name"}, expected: []any{"hello", nil, "render"}},
+		{name: "HGETALL", command: "HGETALL", args: []string{"user:1"}, expected: map[string]string{"name": "Ada", "plan": "pro"}},
+		{name: "TTL", command: "TTL", args: []string{"greeting"}, expected: int64(90)},
+		{name: "TTL without expiry", command: "TTL", args: []string{"name"}, expected: int64(-1)},
+		{name: "TTL of a missing key", command: "TTL", args: []string{"missing"}, expected: int64(-2)},
+		{name: "TYPE", command: "TYPE", args: []string{"user:1"}, expected: "hash"},
+		{name: "SCAN", command: "SCAN", args: []string{"0", "MATCH", "user:*"}, expected: ScanResult{Cursor: 0, Keys: []string{"user:1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runQuery(context.Background(), rdb, tt.command, tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Result)
+			assert.False(t, result.Truncated)
+		})
+	}
+}
+
+func TestRunQueryMemoryUsage(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	require.NoError(t, mr.Set("greeting", "hello"))
+
+	result, err := runQuery(context.Background(), rdb, "memory  usage", []string{"greeting"})
+	require.NoError(t, err)
+	assert.Equal(t, "MEMORY USAGE", result.Command)
+	assert.Greater(t, result.Result, int64(0))
+}
+
+func TestRunQueryRefusesCommands(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	require.NoError(t, mr.Set("greeting", "hello"))
+
+	tests := []struct {
+		name          string
+		command       string
+		args          []string
+		expectedError string
+	}{
+		{name: "Writes", command: "SET", args: []string{"greeting", "bye"}, expectedError: "command refused"},
+		{name: "Deletes", command: "DEL", args: []string{"greeting"}, expectedError: "command refused"},
+		{name: "Administrative commands", command: "FLUSHALL", expectedError: "command refused"},
+		{name: "Other subcommands", command: "SLOWLOG RESET", expectedError: "command refused"},
+		{name: "Large SCAN counts", command: "SCAN", args: []string{"0", "COUNT", "100000"}, expectedError: "SCAN COUNT must be between 1 and 1000"},
+		{name: "Unsupported SCAN options", command: "SCAN", args: []string{"0", "NOVALUES", "1"}, expectedError: "unsupported SCAN option"},
+		{name: "Large SLOWLOG counts", command: "SLOWLOG GET", args: []string{"-1"}, expectedError: "SLOWLOG GET count must be between 1 and 128"},
+		{name: "Extra keys", command: "GET", args: []string{"greeting", "name"}, expectedError: "GET takes exactly one key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runQuery(context.Background(), rdb, tt.command, tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+
+	value, err := mr.Get("greeting")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", value)
+}
+
+func TestRunQueryCapsResultSize(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	require.NoError(t, mr.Set("large", strings.Repeat("x", maxQueryResultBytes+10)))
+	require.NoError(t, mr.Set("small", "y"))
+
+	result, err := runQuery(context.Background(), rdb, "GET", []string{"large"})
+	require.NoError(t, err)
+	assert.True(t, result.Truncated)
+	assert.Len(t, result.Result, maxQueryResultBytes)
+
+	result, err = runQuery(context.Background(), rdb, "MGET", []string{"large", "small"})
+	require.NoError(t, err)
+	assert.True(t, result.Truncated)
+	assert.Len(t, result.Result, 1)
+}
@@ -2,6 +2,7 @@ package keyvalue
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
 	"github.com/render-oss/render-mcp-server/pkg/session"
@@ -14,6 +15,8 @@ type keyValueRepoClient interface {
 	RetrieveKeyValueWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveKeyValueResponse, error)
 	RetrieveKeyValueConnectionInfoWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveKeyValueConnectionInfoResponse, error)
 	CreateKeyValueWithResponse(ctx context.Context, body client.KeyValuePOSTInput, reqEditors ...client.RequestEditorFn) (*client.CreateKeyValueResponse, error)
+	UpdateKeyValueWithResponse(ctx context.Context, keyValueId string, body client.UpdateKeyValueJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateKeyValueResponse, error)
+	DeleteKeyValueWithResponse(ctx context.Context, keyValueId string, reqEditors ...client.RequestEditorFn) (*client.DeleteKeyValueResponse, error)
 }
 
 type Repo struct {
@@ -80,3 +83,72 @@ func (r *Repo) CreateKeyValue(ctx context.Context, input client.KeyValuePOSTInpu
 
 	return resp.JSON201, nil
 }
+
+// getKeyValueInWorkspace returns a Key Value instance, or an error if it isn't in the current
+// workspace.
+func (r *Repo) getKeyValueInWorkspace(ctx context.Context, id string) (*client.KeyValueDetail, error) {
+	keyValue, err := r.GetKeyValue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, keyValue.Owner.Id); err != nil {
+		return nil, err
+	}
+
+	return keyValue, nil
+}
+
+func (r *Repo) UpdateKeyValue(ctx context.Context, id string, input client.KeyValuePATCHInput) (*client.KeyValueDetail, error) {
+	// Skip validation of the instance belonging to the workspace because it should be done against
+	// the current instance before the call to UpdateKeyValue.
+	resp, err := r.client.UpdateKeyValueWithResponse(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// DeleteKeyValue deletes the Key Value instance only if confirmationName matches its name, so that a
+// caller can't delete an instance it hasn't looked up first.
+func (r *Repo) DeleteKeyValue(ctx context.Context, id string, confirmationName string) error {
+	keyValue, err := r.getKeyValueInWorkspace(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if confirmationName != keyValue.Name {
+		return fmt.Errorf("confirmation name %q does not match the name of Key Value instance %s", confirmationName, id)
+	}
+
+	resp, err := r.client.DeleteKeyValueWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// GetKeyValueConnectionInfo returns the connection info of a Key Value instance in the current
+// workspace.
+func (r *Repo) GetKeyValueConnectionInfo(ctx context.Context, id string) (*client.KeyValueConnectionInfo, error) {
+	if _, err := r.getKeyValueInWorkspace(ctx, id); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.RetrieveKeyValueConnectionInfoWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -3,10 +3,13 @@ package keyvalue
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+	"github.com/render-oss/render-mcp-server/pkg/environment"
 	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
 	"github.com/render-oss/render-mcp-server/pkg/session"
@@ -15,16 +18,23 @@ import (
 
 func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
 	keyValueRepo := NewRepo(c)
+	environmentRepo := environment.NewRepo(c)
 
-	tool, handler := listKeyValue(keyValueRepo)
+	tool, handler := listKeyValue(keyValueRepo, environmentRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = getKeyValue(keyValueRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = createKeyValue(keyValueRepo)
 	s.AddTool(*tool, handler)
+	tool, handler = updateKeyValue(keyValueRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteKeyValue(keyValueRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = queryKeyValue(keyValueRepo)
+	s.AddTool(*tool, handler)
 }
 
-func listKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+func listKeyValue(keyValueRepo *Repo, environmentRepo *environment.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("list_key_value",
 		mcp.WithDescription("List all Key Value instances in your Render account"),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -33,10 +43,22 @@ func listKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 			IdempotentHint: pointers.From(true),
 			OpenWorldHint:  pointers.From(true),
 		}),
+		environment.WithProjectFilter(),
+		environment.WithEnvironmentFilter(),
 	)
 	return &tool,
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			keyValues, err := keyValueRepo.ListKeyValue(ctx, &client.ListKeyValueParams{})
+			params := &client.ListKeyValueParams{}
+			if environmentIds, ok, err := environmentRepo.EnvironmentFilter(ctx, request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if len(environmentIds) == 0 {
+					return mcp.NewToolResultText("No Key Value instances found"), nil
+				}
+				params.EnvironmentId = &environmentIds
+			}
+
+			keyValues, err := keyValueRepo.ListKeyValue(ctx, params)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -169,4 +191,247 @@ func createKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 
 			return mcp.NewToolResultText(string(respJSON)), nil
 		}
-}
\ No newline at end of file
+}
+
+func queryKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("query_key_value",
+		mcp.WithDescription("Run a read-only command against a Render Key Value instance. "+
+			"Only GET, MGET, HGETALL, TTL, TYPE, SCAN, INFO, MEMORY USAGE and SLOWLOG GET can be run. "+
+			"Large results are truncated and marked as such."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Query Key Value instance",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("keyValueId",
+			mcp.Required(),
+			mcp.Description("The ID of the Key Value instance to query"),
+		),
+		mcp.WithString("command",
+			mcp.Required(),
+			mcp.Description("The command to run"),
+			mcp.Enum(queryCommands...),
+		),
+		mcp.WithArray("args",
+			mcp.Description("The arguments of the command, for example the key for GET. "+
+				"SCAN takes a cursor followed by optional MATCH, COUNT and TYPE options, and COUNT defaults to 100 and can't be above 1000. "+
+				"SLOWLOG GET takes an optional number of entries, which defaults to 10 and can't be above 128."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			keyValueId, err := validate.RequiredToolParam[string](request, "keyValueId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			command, err := validate.RequiredToolParam[string](request, "command")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			args, _, err := validate.OptionalToolArrayParam[string](request, "args")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			connectionInfo, err := keyValueRepo.GetKeyValueConnectionInfo(ctx, keyValueId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			rdb, err := newQueryClient(connectionInfo.ExternalConnectionString)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			defer rdb.Close()
+
+			result, err := runQuery(ctx, rdb, command, args)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func updateKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_key_value",
+		mcp.WithDescription("Update an existing Key Value instance in your Render account. "+
+			"Only the fields that are provided will be changed. "+
+			"The result lists each field that changes along with its current and new value. "+
+			"Set 'dryRun' to 'true' to preview the changes without applying them; this is "+
+			"recommended so the user can confirm the changes before they are made."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update Key Value instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("keyValueId",
+			mcp.Required(),
+			mcp.Description("The ID of the Key Value instance to update"),
+		),
+		mcp.WithBoolean("dryRun",
+			mcp.Description("If true, return the changes that would be made without applying them. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		mcp.WithString("plan",
+			mcp.Description("Pricing plan for the Key Value instance. Changing the plan changes the memory available to the instance and its cost."),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.KeyValuePlanFree, client.KeyValuePlanStarter, client.KeyValuePlanStandard, client.KeyValuePlanPro, client.KeyValuePlanProPlus)...),
+		),
+		mcp.WithString("maxmemoryPolicy",
+			mcp.Description("The eviction policy for the Key Value store"),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.Noeviction, client.AllkeysLfu, client.AllkeysLru, client.AllkeysRandom, client.VolatileLfu, client.VolatileLru, client.VolatileRandom, client.VolatileTtl)...),
+		),
+		mcp.WithArray("ipAllowList",
+			mcp.Description("The complete list of CIDR blocks allowed to connect to the instance from outside Render. "+
+				"This replaces the current list, so include the existing entries to keep them. An empty list blocks all external connections."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"cidrBlock"},
+					"properties": map[string]interface{}{
+						"cidrBlock": map[string]interface{}{
+							"type":        "string",
+							"description": "The CIDR block, for example 203.0.113.0/24. Use 0.0.0.0/0 to allow all addresses.",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "A description of the CIDR block",
+						},
+					},
+				},
+			),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			keyValueId, err := validate.RequiredToolParam[string](request, "keyValueId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			current, err := keyValueRepo.getKeyValueInWorkspace(ctx, keyValueId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			requestBody, changes, err := updateValidatedKeyValueRequest(request, current)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(changes) == 0 {
+				return mcp.NewToolResultText("No changes to apply. The provided values match the current configuration of the Key Value instance."), nil
+			}
+
+			dryRun, _, err := validate.OptionalToolParam[bool](request, "dryRun")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result := UpdateResult{
+				DryRun:  dryRun,
+				Changes: changes,
+			}
+
+			if !dryRun {
+				result.KeyValue, err = keyValueRepo.UpdateKeyValue(ctx, keyValueId, *requestBody)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func updateValidatedKeyValueRequest(request mcp.CallToolRequest, current *client.KeyValueDetail) (*client.KeyValuePATCHInput, []diff.FieldChange, error) {
+	requestBody := &client.KeyValuePATCHInput{}
+	var changes []diff.FieldChange
+
+	if plan, ok, err := validate.OptionalToolParam[string](request, "plan"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		keyValuePlan, err := validate.KeyValuePlan(plan)
+		if err != nil {
+			return nil, nil, err
+		}
+		requestBody.Plan = keyValuePlan
+		changes = diff.AppendChange(changes, "plan", string(current.Plan), &plan)
+	}
+
+	if maxmemoryPolicy, ok, err := validate.OptionalToolParam[string](request, "maxmemoryPolicy"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		requestBody.MaxmemoryPolicy = pointers.From(client.MaxmemoryPolicy(maxmemoryPolicy))
+		changes = diff.AppendChange(changes, "maxmemoryPolicy", diff.DerefString(current.Options.MaxmemoryPolicy), &maxmemoryPolicy)
+	}
+
+	if ipAllowList, ok, err := validate.IPAllowList(request); err != nil {
+		return nil, nil, err
+	} else if ok {
+		requestBody.IpAllowList = &ipAllowList
+		changes = diff.AppendChange(changes, "ipAllowList", formatIPAllowList(current.IpAllowList), pointers.From(formatIPAllowList(ipAllowList)))
+	}
+
+	return requestBody, changes, nil
+}
+
+func deleteKeyValue(keyValueRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_key_value",
+		mcp.WithDescription("Permanently delete a Key Value instance and all of its data. This cannot be undone. "+
+			"To confirm the deletion, confirmName must exactly match the name of the instance, which you can look up with the get_key_value tool. "+
+			"Always ask the user to confirm before deleting a Key Value instance."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete Key Value instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("keyValueId",
+			mcp.Required(),
+			mcp.Description("The ID of the Key Value instance to delete"),
+		),
+		mcp.WithString("confirmName",
+			mcp.Required(),
+			mcp.Description("The name of the Key Value instance, to confirm that it is the one to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			keyValueId, err := validate.RequiredToolParam[string](request, "keyValueId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			confirmName, err := validate.RequiredToolParam[string](request, "confirmName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := keyValueRepo.DeleteKeyValue(ctx, keyValueId, confirmName); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Key Value instance %s has been deleted", keyValueId)), nil
+		}
+}
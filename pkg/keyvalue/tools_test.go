@@ -0,0 +1,287 @@
+package keyvalue
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryKeyValueTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		ownerId       string
+		command       string
+		args          []any
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "Runs allowed commands",
+			ownerId:  "own-123",
+			command:  "GET",
+			args:     []any{"greeting"},
+			expected: `{"command":"GET","result":"hello","truncated":false}`,
+		},
+		{
+			name:          "Refuses commands outside the allow-list",
+			ownerId:       "own-123",
+			command:       "DEL",
+			args:          []any{"greeting"},
+			expectedError: "command refused",
+		},
+		{
+			name:          "Rejects instances outside the current workspace",
+			ownerId:       "own-other",
+			command:       "GET",
+			args:          []any{"greeting"},
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr, _ := newTestRedis(t)
+			require.NoError(t, mr.Set("greeting", "hello"))
+
+			fakeClient := &fakes.FakeKeyValueRepoClient{}
+			fakeClient.RetrieveKeyValueWithResponseReturns(&client.RetrieveKeyValueResponse{
+				JSON200: &client.KeyValueDetail{
+					Id:    "red-123",
+					Owner: client.Owner{Id: tt.ownerId},
+				},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.RetrieveKeyValueConnectionInfoWithResponseReturns(&client.RetrieveKeyValueConnectionInfoResponse{
+				JSON200: &client.KeyValueConnectionInfo{
+					ExternalConnectionString: "redis://" + mr.Addr(),
+				},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"keyValueId": "red-123",
+				"command":    tt.command,
+				"args":       tt.args,
+			}
+
+			_, handler := queryKeyValue(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.JSONEq(t, tt.expected, result.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestUpdateKeyValueTool(t *testing.T) {
+	tests := []struct {
+		name            string
+		arguments       map[string]interface{}
+		expectedChanges []diff.FieldChange
+		expectUpdate    bool
+		expectedText    string
+		expectedError   string
+	}{
+		{
+			name: "Applies changed fields",
+			arguments: map[string]interface{}{
+				"plan":            "pro",
+				"maxmemoryPolicy": "noeviction",
+				"ipAllowList": []interface{}{
+					map[string]interface{}{"cidrBlock": "203.0.113.0/24", "description": "office"},
+				},
+			},
+			expectedChanges: []diff.FieldChange{
+				{Field: "plan", Before: "starter", After: "pro"},
+				{Field: "maxmemoryPolicy", Before: "allkeys_lru", After: "noeviction"},
+				{Field: "ipAllowList", Before: "0.0.0.0/0 (everywhere)", After: "203.0.113.0/24 (office)"},
+			},
+			expectUpdate: true,
+		},
+		{
+			name: "Previews changes on a dry run",
+			arguments: map[string]interface{}{
+				"plan":   "pro",
+				"dryRun": true,
+			},
+			expectedChanges: []diff.FieldChange{
+				{Field: "plan", Before: "starter", After: "pro"},
+			},
+		},
+		{
+			name: "Skips the update when nothing changes",
+			arguments: map[string]interface{}{
+				"plan": "starter",
+			},
+			expectedText: "No changes to apply",
+		},
+		{
+			name: "Rejects invalid CIDR blocks",
+			arguments: map[string]interface{}{
+				"ipAllowList": []interface{}{
+					map[string]interface{}{"cidrBlock": "203.0.113.0"},
+				},
+			},
+			expectedError: "invalid CIDR block in ipAllowList: 203.0.113.0",
+		},
+		{
+			name: "Rejects custom plans",
+			arguments: map[string]interface{}{
+				"plan": "custom",
+			},
+			expectedError: "doesn't support custom Key Value plans",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeKeyValueRepoClient{}
+			fakeClient.RetrieveKeyValueWithResponseReturns(&client.RetrieveKeyValueResponse{
+				JSON200: &client.KeyValueDetail{
+					Id:      "red-123",
+					Name:    "cache",
+					Owner:   client.Owner{Id: "own-123"},
+					Plan:    client.KeyValuePlanStarter,
+					Options: client.KeyValueOptions{MaxmemoryPolicy: pointers.From("allkeys_lru")},
+					IpAllowList: []client.CidrBlockAndDescription{
+						{CidrBlock: "0.0.0.0/0", Description: "everywhere"},
+					},
+				},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.UpdateKeyValueWithResponseReturns(&client.UpdateKeyValueResponse{
+				JSON200:      &client.KeyValueDetail{Id: "red-123"},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"keyValueId": "red-123"}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			_, handler := updateKeyValue(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.UpdateKeyValueWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			if tt.expectedText != "" {
+				assert.Contains(t, text, tt.expectedText)
+				assert.Equal(t, 0, fakeClient.UpdateKeyValueWithResponseCallCount())
+				return
+			}
+
+			var updateResult UpdateResult
+			require.NoError(t, json.Unmarshal([]byte(text), &updateResult))
+			assert.Equal(t, tt.expectedChanges, updateResult.Changes)
+
+			if !tt.expectUpdate {
+				assert.True(t, updateResult.DryRun)
+				assert.Equal(t, 0, fakeClient.UpdateKeyValueWithResponseCallCount())
+				return
+			}
+
+			require.Equal(t, 1, fakeClient.UpdateKeyValueWithResponseCallCount())
+			_, keyValueId, body, _ := fakeClient.UpdateKeyValueWithResponseArgsForCall(0)
+			assert.Equal(t, "red-123", keyValueId)
+			assert.Equal(t, client.KeyValuePATCHInput{
+				Plan:            pointers.From(client.KeyValuePlanPro),
+				MaxmemoryPolicy: pointers.From(client.Noeviction),
+				IpAllowList: &[]client.CidrBlockAndDescription{
+					{CidrBlock: "203.0.113.0/24", Description: "office"},
+				},
+			}, body)
+		})
+	}
+}
+
+func TestDeleteKeyValueTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirmName   string
+		ownerId       string
+		expectedError string
+	}{
+		{
+			name:        "Deletes the instance when the name is confirmed",
+			confirmName: "cache",
+			ownerId:     "own-123",
+		},
+		{
+			name:          "Rejects a confirmation name that doesn't match",
+			confirmName:   "other-cache",
+			ownerId:       "own-123",
+			expectedError: `confirmation name "other-cache" does not match`,
+		},
+		{
+			name:          "Rejects instances outside the current workspace",
+			confirmName:   "cache",
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeKeyValueRepoClient{}
+			fakeClient.RetrieveKeyValueWithResponseReturns(&client.RetrieveKeyValueResponse{
+				JSON200: &client.KeyValueDetail{
+					Id:    "red-123",
+					Name:  "cache",
+					Owner: client.Owner{Id: tt.ownerId},
+				},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.DeleteKeyValueWithResponseReturns(&client.DeleteKeyValueResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"keyValueId":  "red-123",
+				"confirmName": tt.confirmName,
+			}
+
+			_, handler := deleteKeyValue(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.DeleteKeyValueWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			require.Equal(t, 1, fakeClient.DeleteKeyValueWithResponseCallCount())
+			_, keyValueId, _ := fakeClient.DeleteKeyValueWithResponseArgsForCall(0)
+			assert.Equal(t, "red-123", keyValueId)
+		})
+	}
+}
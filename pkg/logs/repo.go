@@ -2,8 +2,12 @@ package logs
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
+	"github.com/gorilla/websocket"
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	logsclient "github.com/render-oss/render-mcp-server/pkg/client/logs"
 )
 
 func NewLogRepo(c *client.ClientWithResponses) *LogRepo {
@@ -39,3 +43,44 @@ func (l *LogRepo) ListLogLabelValues(ctx context.Context, params *client.ListLog
 
 	return *resp.JSON200, nil
 }
+
+// TailLogs subscribes to logs matching params and calls onLog with each log as it arrives. It returns
+// once onLog returns false, the subscription is closed by the server, or the context is done. Messages
+// that can't be parsed as logs are skipped, and the number skipped is returned.
+func (l *LogRepo) TailLogs(ctx context.Context, params *client.SubscribeLogsParams, onLog func(*logsclient.Log) bool) (int, error) {
+	conn, err := l.c.SubscribeLogsStream(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	// ReadMessage doesn't observe the context, so close the connection to unblock it once the
+	// context is done.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	skipped := 0
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return skipped, ctx.Err()
+			}
+			var closeErr *websocket.CloseError
+			if errors.As(err, &closeErr) && closeErr.Code == websocket.CloseNormalClosure {
+				return skipped, nil
+			}
+			return skipped, err
+		}
+
+		var log logsclient.Log
+		if err := json.Unmarshal(message, &log); err != nil {
+			skipped++
+			continue
+		}
+
+		if !onLog(&log) {
+			return skipped, nil
+		}
+	}
+}
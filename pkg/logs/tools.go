@@ -3,6 +3,9 @@ package logs
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -22,6 +25,8 @@ func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
 	s.AddTool(*tool, handler)
 	tool, handler = listLogLabelValues(logRepo)
 	s.AddTool(*tool, handler)
+	tool, handler = tailLogs(logRepo)
+	s.AddTool(*tool, handler)
 }
 
 func listLogs(logRepo *LogRepo) (*mcp.Tool, server.ToolHandlerFunc) {
@@ -129,54 +134,18 @@ func listLogs(logRepo *LogRepo) (*mcp.Tool, server.ToolHandlerFunc) {
 				Resource: resource,
 			}
 
-			// Optional filters
-			if levelFilters, ok, err := validate.OptionalToolArrayParam[string](request, "level"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Level = &levelFilters
-			}
-
-			if typeFilters, ok, err := validate.OptionalToolArrayParam[string](request, "type"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Type = &typeFilters
-			}
-
-			if instanceFilters, ok, err := validate.OptionalToolArrayParam[string](request, "instance"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Instance = &instanceFilters
-			}
-
-			if hostFilters, ok, err := validate.OptionalToolArrayParam[string](request, "host"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Host = &hostFilters
-			}
-
-			if statusCodeFilters, ok, err := validate.OptionalToolArrayParam[string](request, "statusCode"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.StatusCode = &statusCodeFilters
-			}
-
-			if methodFilters, ok, err := validate.OptionalToolArrayParam[string](request, "method"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Method = &methodFilters
-			}
-
-			if pathFilters, ok, err := validate.OptionalToolArrayParam[string](request, "path"); err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Path = &pathFilters
-			}
-
-			if textFilters, ok, err := validate.OptionalToolArrayParam[string](request, "text"); err != nil {
+			filters, err := logFiltersFromRequest(request)
+			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
-				llParams.Text = &textFilters
 			}
+			llParams.Level = filters.Level
+			llParams.Type = filters.Type
+			llParams.Instance = filters.Instance
+			llParams.Host = filters.Host
+			llParams.StatusCode = filters.StatusCode
+			llParams.Method = filters.Method
+			llParams.Path = filters.Path
+			llParams.Text = filters.Text
 
 			if startTimeStr, ok, err := validate.OptionalToolParam[string](request, "startTime"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -429,4 +398,269 @@ func listLogLabelValues(logRepo *LogRepo) (*mcp.Tool, server.ToolHandlerFunc) {
 
 			return mcp.NewToolResultText(string(respJSON)), nil
 		}
-}
\ No newline at end of file
+}
+
+// TailLogsResult summarizes a tail_logs call. Logs holds every log that was streamed, in the order
+// it was received. When the stream fails after logs were received, StopReason is "error" and Error
+// holds the reason.
+type TailLogsResult struct {
+	Logs           []logsclient.Log `json:"logs"`
+	LineCount      int              `json:"lineCount"`
+	SkippedCount   int              `json:"skippedCount,omitempty"`
+	StopReason     string           `json:"stopReason"`
+	Error          string           `json:"error,omitempty"`
+	ElapsedSeconds float64          `json:"elapsedSeconds"`
+}
+
+const (
+	tailStopReasonMaxLines = "maxLines"
+	tailStopReasonDuration = "duration"
+	tailStopReasonClosed   = "closed"
+	tailStopReasonError    = "error"
+)
+
+const (
+	maxTailDurationSeconds = 300
+	maxTailLines           = 1000
+)
+
+// tailLogsDurationUnit is the length of one unit of durationSeconds. It's a variable so tests can
+// stop streaming without waiting a whole second.
+var tailLogsDurationUnit = time.Second
+
+func tailLogs(logRepo *LogRepo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("tail_logs",
+		mcp.WithDescription("Stream new logs matching the provided filters as they are emitted. "+
+			"Each log is sent to the client as a logging notification, and as a progress notification if the request includes a progress token. "+
+			"Streaming stops once durationSeconds have passed or maxLines logs have been received, whichever comes first, "+
+			"and the tool then returns the logs it received along with the reason it stopped. "+
+			"If the stream fails after logs were received, those logs are still returned with the stop reason 'error'. "+
+			"Use list_logs instead to look up logs that were emitted in the past."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Tail logs",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(false),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithArray("resource",
+			mcp.Required(),
+			mcp.Description("Filter logs by their resource. A resource is the id of a server, cronjob, job, postgres, or redis."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("level",
+			mcp.Description("Filter logs by their severity level. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("type",
+			mcp.Description("Filter logs by their type. Types include app for application logs, request for request logs, and build for build logs. You can find the full set of types available for a query by using the list_log_label_values tool."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("instance",
+			mcp.Description("Filter logs by the instance they were emitted from. An instance is the id of a specific running server."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("host",
+			mcp.Description("Filter request logs by their host. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("statusCode",
+			mcp.Description("Filter request logs by their status code. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("method",
+			mcp.Description("Filter request logs by their requests method. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("path",
+			mcp.Description("Filter request logs by their path. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithArray("text",
+			mcp.Description("Filter by the text of the logs. Wildcards and regex are supported."),
+			mcp.Items(map[string]interface{}{
+				"type": "string",
+			}),
+		),
+		mcp.WithNumber("durationSeconds",
+			mcp.Description("Maximum number of seconds to stream logs for."),
+			mcp.DefaultNumber(30),
+			mcp.Min(1),
+			mcp.Max(maxTailDurationSeconds),
+		),
+		mcp.WithNumber("maxLines",
+			mcp.Description("Maximum number of logs to stream before returning."),
+			mcp.DefaultNumber(100),
+			mcp.Min(1),
+			mcp.Max(maxTailLines),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerId, err := session.FromContext(ctx).GetWorkspace(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			resource, err := validate.RequiredToolArrayParam[string](request, "resource")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			filters, err := logFiltersFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			params := &client.SubscribeLogsParams{
+				OwnerId:    ownerId,
+				Resource:   resource,
+				Level:      filters.Level,
+				Type:       filters.Type,
+				Instance:   filters.Instance,
+				Host:       filters.Host,
+				StatusCode: filters.StatusCode,
+				Method:     filters.Method,
+				Path:       filters.Path,
+				Text:       filters.Text,
+			}
+
+			duration := 30 * tailLogsDurationUnit
+			if durationSeconds, ok, err := validate.OptionalToolParam[float64](request, "durationSeconds"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if durationSeconds < 1 || durationSeconds > maxTailDurationSeconds || durationSeconds != math.Trunc(durationSeconds) {
+					return mcp.NewToolResultError(fmt.Sprintf("durationSeconds must be a whole number between 1 and %d", maxTailDurationSeconds)), nil
+				}
+				duration = time.Duration(durationSeconds) * tailLogsDurationUnit
+			}
+
+			maxLines := 100
+			if maxLinesParam, ok, err := validate.OptionalToolParam[float64](request, "maxLines"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if maxLinesParam < 1 || maxLinesParam > maxTailLines || maxLinesParam != math.Trunc(maxLinesParam) {
+					return mcp.NewToolResultError(fmt.Sprintf("maxLines must be a whole number between 1 and %d", maxTailLines)), nil
+				}
+				maxLines = int(maxLinesParam)
+			}
+
+			tailCtx, cancel := context.WithTimeout(ctx, duration)
+			defer cancel()
+
+			start := time.Now()
+			total := float64(maxLines)
+			result := TailLogsResult{Logs: []logsclient.Log{}, StopReason: tailStopReasonClosed}
+			result.SkippedCount, err = logRepo.TailLogs(tailCtx, params, func(log *logsclient.Log) bool {
+				result.Logs = append(result.Logs, *log)
+				result.LineCount++
+
+				mcpserver.SendLogMessage(ctx, loggingLevel(log), "render", log)
+				mcpserver.SendProgress(ctx, request, float64(result.LineCount), &total, log.Message)
+
+				if result.LineCount >= maxLines {
+					result.StopReason = tailStopReasonMaxLines
+					return false
+				}
+				return true
+			})
+			if err != nil {
+				// The caller cancelling the request is an error, but running out of time is how
+				// tailing normally ends. Other errors still return the logs received before the
+				// stream failed.
+				switch {
+				case ctx.Err() != nil:
+					return mcp.NewToolResultError(err.Error()), nil
+				case tailCtx.Err() != nil:
+					result.StopReason = tailStopReasonDuration
+				case result.LineCount == 0:
+					return mcp.NewToolResultError(err.Error()), nil
+				default:
+					result.StopReason = tailStopReasonError
+					result.Error = err.Error()
+				}
+			}
+			result.ElapsedSeconds = time.Since(start).Seconds()
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+// logFilters holds the optional label filters shared by the tools that query logs.
+type logFilters struct {
+	Level      *[]string
+	Type       *[]string
+	Instance   *[]string
+	Host       *[]string
+	StatusCode *[]string
+	Method     *[]string
+	Path       *[]string
+	Text       *[]string
+}
+
+func logFiltersFromRequest(request mcp.CallToolRequest) (*logFilters, error) {
+	filters := &logFilters{}
+	for name, filter := range map[string]**[]string{
+		"level":      &filters.Level,
+		"type":       &filters.Type,
+		"instance":   &filters.Instance,
+		"host":       &filters.Host,
+		"statusCode": &filters.StatusCode,
+		"method":     &filters.Method,
+		"path":       &filters.Path,
+		"text":       &filters.Text,
+	} {
+		values, ok, err := validate.OptionalToolArrayParam[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*filter = &values
+		}
+	}
+
+	return filters, nil
+}
+
+// loggingLevel maps the level label of a log to the closest MCP logging level, so clients can filter
+// streamed logs by severity. Logs without a recognized level are sent at the info level.
+func loggingLevel(log *logsclient.Log) mcp.LoggingLevel {
+	for _, label := range log.Labels {
+		if label.Name != logsclient.LogLabelNameLevel {
+			continue
+		}
+
+		switch strings.ToLower(label.Value) {
+		case "debug", "trace":
+			return mcp.LoggingLevelDebug
+		case "warn", "warning":
+			return mcp.LoggingLevelWarning
+		case "error":
+			return mcp.LoggingLevelError
+		case "fatal", "critical":
+			return mcp.LoggingLevelCritical
+		}
+	}
+
+	return mcp.LoggingLevelInfo
+}
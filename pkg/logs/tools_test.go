@@ -0,0 +1,241 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	logsclient "github.com/render-oss/render-mcp-server/pkg/client/logs"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailLogsTool(t *testing.T) {
+	tailLogsDurationUnit = 100 * time.Millisecond
+	t.Cleanup(func() { tailLogsDurationUnit = time.Second })
+
+	tests := []struct {
+		name               string
+		arguments          map[string]interface{}
+		sentLogs           int
+		sendInvalidMessage bool
+		closeAfterSending  bool
+		dropAfterSending   bool
+		expectedLineCount  int
+		expectedSkipped    int
+		expectedStopReason string
+	}{
+		{
+			name:               "Stops after maxLines logs",
+			arguments:          map[string]interface{}{"maxLines": float64(3)},
+			sentLogs:           5,
+			expectedLineCount:  3,
+			expectedStopReason: tailStopReasonMaxLines,
+		},
+		{
+			name:               "Stops after durationSeconds",
+			arguments:          map[string]interface{}{"durationSeconds": float64(1)},
+			sentLogs:           2,
+			expectedLineCount:  2,
+			expectedStopReason: tailStopReasonDuration,
+		},
+		{
+			name:               "Stops when the server closes the subscription",
+			arguments:          map[string]interface{}{},
+			sentLogs:           2,
+			closeAfterSending:  true,
+			expectedLineCount:  2,
+			expectedStopReason: tailStopReasonClosed,
+		},
+		{
+			name:               "Skips messages that aren't logs",
+			arguments:          map[string]interface{}{},
+			sentLogs:           2,
+			sendInvalidMessage: true,
+			closeAfterSending:  true,
+			expectedLineCount:  2,
+			expectedSkipped:    1,
+			expectedStopReason: tailStopReasonClosed,
+		},
+		{
+			name:               "Returns the logs received before the connection dropped",
+			arguments:          map[string]interface{}{},
+			sentLogs:           2,
+			dropAfterSending:   true,
+			expectedLineCount:  2,
+			expectedStopReason: tailStopReasonError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query map[string][]string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+
+				conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+
+				if tt.sendInvalidMessage {
+					assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("not a log")))
+				}
+
+				for i := 0; i < tt.sentLogs; i++ {
+					assert.NoError(t, conn.WriteJSON(logsclient.Log{
+						Id:        fmt.Sprintf("log-%d", i),
+						Message:   "hello",
+						Timestamp: time.Now(),
+						Labels: []logsclient.LogLabel{
+							{Name: logsclient.LogLabelNameLevel, Value: "info"},
+						},
+					}))
+				}
+
+				if tt.closeAfterSending {
+					_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				}
+				if tt.dropAfterSending {
+					// Closing without a close message looks like a dropped connection to the client.
+					return
+				}
+
+				// Block until the client hangs up.
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}))
+			defer srv.Close()
+
+			c, err := client.NewClientWithResponses(srv.URL)
+			require.NoError(t, err)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"resource": []interface{}{"srv-123"},
+				"level":    []interface{}{"info"},
+			}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			_, handler := tailLogs(NewLogRepo(c))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+			require.False(t, result.IsError, result.Content)
+
+			var tailResult TailLogsResult
+			require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &tailResult))
+			assert.Equal(t, tt.expectedLineCount, tailResult.LineCount)
+			assert.Len(t, tailResult.Logs, tt.expectedLineCount)
+			assert.Equal(t, tt.expectedSkipped, tailResult.SkippedCount)
+			assert.Equal(t, tt.expectedStopReason, tailResult.StopReason)
+			if tt.expectedStopReason == tailStopReasonError {
+				assert.NotEmpty(t, tailResult.Error)
+			}
+
+			assert.Equal(t, []string{"own-123"}, query["ownerId"])
+			assert.Equal(t, []string{"srv-123"}, query["resource"])
+			assert.Equal(t, []string{"info"}, query["level"])
+		})
+	}
+}
+
+func TestTailLogsToolUnauthorized(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClientWithResponses(srv.URL)
+	require.NoError(t, err)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"resource": []interface{}{"srv-123"},
+	}
+
+	_, handler := tailLogs(NewLogRepo(c))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, client.ErrUnauthorized.Error())
+}
+
+func TestTailLogsToolValidatesLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "Rejects a duration that is too long",
+			arguments:     map[string]interface{}{"durationSeconds": float64(301)},
+			expectedError: "durationSeconds must be a whole number between 1 and 300",
+		},
+		{
+			name:          "Rejects a fractional duration",
+			arguments:     map[string]interface{}{"durationSeconds": 0.5},
+			expectedError: "durationSeconds must be a whole number between 1 and 300",
+		},
+		{
+			name:          "Rejects too many lines",
+			arguments:     map[string]interface{}{"maxLines": float64(1001)},
+			expectedError: "maxLines must be a whole number between 1 and 1000",
+		},
+		{
+			name:          "Rejects a fractional number of lines",
+			arguments:     map[string]interface{}{"maxLines": 2.5},
+			expectedError: "maxLines must be a whole number between 1 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("unexpected request to the logs API")
+			}))
+			defer srv.Close()
+
+			c, err := client.NewClientWithResponses(srv.URL)
+			require.NoError(t, err)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"resource": []interface{}{"srv-123"},
+			}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			_, handler := tailLogs(NewLogRepo(c))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Equal(t, tt.expectedError, result.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestLoggingLevel(t *testing.T) {
+	withLevel := func(level string) *logsclient.Log {
+		return &logsclient.Log{Labels: []logsclient.LogLabel{{Name: logsclient.LogLabelNameLevel, Value: level}}}
+	}
+
+	assert.Equal(t, mcp.LoggingLevelInfo, loggingLevel(&logsclient.Log{}))
+	assert.Equal(t, mcp.LoggingLevelInfo, loggingLevel(withLevel("info")))
+	assert.Equal(t, mcp.LoggingLevelWarning, loggingLevel(withLevel("WARN")))
+	assert.Equal(t, mcp.LoggingLevelError, loggingLevel(withLevel("error")))
+	assert.Equal(t, mcp.LoggingLevelDebug, loggingLevel(withLevel("debug")))
+}
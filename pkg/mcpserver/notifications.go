@@ -0,0 +1,46 @@
+package mcpserver
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// SendProgress sends a progress notification for a long-running tool call. It is a no-op if the
+// client didn't ask for progress by setting a progress token on the request. Notifications are
+// best effort, so failures to send them are ignored.
+func SendProgress(ctx context.Context, request mcp.CallToolRequest, progress float64, total *float64, message string) {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return
+	}
+
+	s := server.ServerFromContext(ctx)
+	if s == nil {
+		return
+	}
+
+	notification := mcp.NewProgressNotification(request.Params.Meta.ProgressToken, progress, total, &message)
+	params := map[string]any{
+		"progressToken": notification.Params.ProgressToken,
+		"progress":      notification.Params.Progress,
+		"message":       notification.Params.Message,
+	}
+	if total != nil {
+		params["total"] = notification.Params.Total
+	}
+
+	_ = s.SendNotificationToClient(ctx, notification.Method, params)
+}
+
+// SendLogMessage sends a logging notification to the client that made the current request. The
+// client decides which levels it receives by setting its logging level. Notifications are best
+// effort, so failures to send them are ignored.
+func SendLogMessage(ctx context.Context, level mcp.LoggingLevel, logger string, data any) {
+	s := server.ServerFromContext(ctx)
+	if s == nil {
+		return
+	}
+
+	_ = s.SendLogMessageToClient(ctx, mcp.NewLoggingMessageNotification(level, logger, data))
+}
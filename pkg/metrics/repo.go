@@ -22,6 +22,8 @@ type MetricsClient interface {
 	GetMemoryLimitWithResponse(ctx context.Context, params *client.GetMemoryLimitParams, reqEditors ...client.RequestEditorFn) (*client.GetMemoryLimitResponse, error)
 	GetMemoryTargetWithResponse(ctx context.Context, params *client.GetMemoryTargetParams, reqEditors ...client.RequestEditorFn) (*client.GetMemoryTargetResponse, error)
 	GetBandwidthWithResponse(ctx context.Context, params *client.GetBandwidthParams, reqEditors ...client.RequestEditorFn) (*client.GetBandwidthResponse, error)
+	GetDiskUsageWithResponse(ctx context.Context, params *client.GetDiskUsageParams, reqEditors ...client.RequestEditorFn) (*client.GetDiskUsageResponse, error)
+	GetDiskCapacityWithResponse(ctx context.Context, params *client.GetDiskCapacityParams, reqEditors ...client.RequestEditorFn) (*client.GetDiskCapacityResponse, error)
 }
 
 type Repo struct {
@@ -46,6 +48,8 @@ const (
 	MetricTypeMemoryLimit       MetricType = "memory_limit"
 	MetricTypeMemoryTarget      MetricType = "memory_target"
 	MetricTypeBandwidthUsage    MetricType = "bandwidth_usage"
+	MetricTypeDiskUsage         MetricType = "disk_usage"
+	MetricTypeDiskCapacity      MetricType = "disk_capacity"
 )
 
 type MetricsRequest struct {
@@ -128,6 +132,10 @@ func (r *Repo) fetchMetric(ctx context.Context, resourceId string, metricType Me
 		data, err = r.getMemoryTarget(ctx, resourceId, req)
 	case MetricTypeBandwidthUsage:
 		data, err = r.getBandwidthUsage(ctx, resourceId, req)
+	case MetricTypeDiskUsage:
+		data, err = r.getDiskUsage(ctx, resourceId, req)
+	case MetricTypeDiskCapacity:
+		data, err = r.getDiskCapacity(ctx, resourceId, req)
 	default:
 		return MetricData{}, fmt.Errorf("unsupported metric type: %s", metricType)
 	}
@@ -513,3 +521,63 @@ func (r *Repo) getBandwidthUsage(ctx context.Context, resourceId string, req Met
 
 	return *resp.JSON200, nil
 }
+
+func (r *Repo) getDiskUsage(ctx context.Context, resourceId string, req MetricsRequest) (metricstypes.TimeSeriesCollection, error) {
+	params := &client.GetDiskUsageParams{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+
+	if req.Resolution != nil {
+		resolutionParam := metricstypes.ResolutionParam(*req.Resolution)
+		params.ResolutionSeconds = &resolutionParam
+	}
+
+	resource := metricstypes.ResourceQueryParam(resourceId)
+	params.Resource = &resource
+
+	resp, err := r.client.GetDiskUsageWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage metrics: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("disk usage metrics API returned status %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil {
+		return metricstypes.TimeSeriesCollection{}, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) getDiskCapacity(ctx context.Context, resourceId string, req MetricsRequest) (metricstypes.TimeSeriesCollection, error) {
+	params := &client.GetDiskCapacityParams{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+
+	if req.Resolution != nil {
+		resolutionParam := metricstypes.ResolutionParam(*req.Resolution)
+		params.ResolutionSeconds = &resolutionParam
+	}
+
+	resource := metricstypes.ResourceQueryParam(resourceId)
+	params.Resource = &resource
+
+	resp, err := r.client.GetDiskCapacityWithResponse(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk capacity metrics: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("disk capacity metrics API returned status %d", resp.StatusCode())
+	}
+
+	if resp.JSON200 == nil {
+		return metricstypes.TimeSeriesCollection{}, nil
+	}
+
+	return *resp.JSON200, nil
+}
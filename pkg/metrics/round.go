@@ -0,0 +1,11 @@
+package metrics
+
+import "math"
+
+// RoundTo rounds a metric value to the given number of decimal places, so summaries don't carry
+// floating point noise.
+func RoundTo(value float64, places int) *float64 {
+	scale := math.Pow(10, float64(places))
+	rounded := math.Round(value*scale) / scale
+	return &rounded
+}
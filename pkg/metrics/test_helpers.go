@@ -77,6 +77,16 @@ func (m *MockClientWithResponses) GetBandwidthWithResponse(ctx context.Context,
 	return args.Get(0).(*client.GetBandwidthResponse), args.Error(1)
 }
 
+func (m *MockClientWithResponses) GetDiskUsageWithResponse(ctx context.Context, params *client.GetDiskUsageParams, reqEditors ...client.RequestEditorFn) (*client.GetDiskUsageResponse, error) {
+	args := m.Called(ctx, params, reqEditors)
+	return args.Get(0).(*client.GetDiskUsageResponse), args.Error(1)
+}
+
+func (m *MockClientWithResponses) GetDiskCapacityWithResponse(ctx context.Context, params *client.GetDiskCapacityParams, reqEditors ...client.RequestEditorFn) (*client.GetDiskCapacityResponse, error) {
+	args := m.Called(ctx, params, reqEditors)
+	return args.Get(0).(*client.GetDiskCapacityResponse), args.Error(1)
+}
+
 // MetricsTestSuite provides shared setup and utilities for metrics tests
 type MetricsTestSuite struct {
 	suite.Suite
@@ -248,6 +258,28 @@ func NewMockBandwidthResponse(value float32) *client.GetBandwidthResponse {
 	}
 }
 
+func NewMockDiskUsageResponse(value float32) *client.GetDiskUsageResponse {
+	return &client.GetDiskUsageResponse{
+		HTTPResponse: &http.Response{StatusCode: 200},
+		JSON200: &metricstypes.TimeSeriesCollection{{
+			Unit:   "bytes",
+			Labels: []metricstypes.Label{{Field: "instance", Value: "srv-123-abc"}},
+			Values: []metricstypes.TimeSeriesValue{{Timestamp: testTimestamp, Value: value}},
+		}},
+	}
+}
+
+func NewMockDiskCapacityResponse(value float32) *client.GetDiskCapacityResponse {
+	return &client.GetDiskCapacityResponse{
+		HTTPResponse: &http.Response{StatusCode: 200},
+		JSON200: &metricstypes.TimeSeriesCollection{{
+			Unit:   "bytes",
+			Labels: []metricstypes.Label{{Field: "instance", Value: "srv-123-abc"}},
+			Values: []metricstypes.TimeSeriesValue{{Timestamp: testTimestamp, Value: value}},
+		}},
+	}
+}
+
 func NewMockErrorResponse(statusCode int) *http.Response {
 	return &http.Response{StatusCode: statusCode}
 }
@@ -130,6 +130,24 @@ func (s *MetricsIntegrationSuite) TestSuccessfulMetricsFetching() {
 			},
 			value: 1048576,
 		},
+		{
+			name:       "Disk usage success",
+			metricType: MetricTypeDiskUsage,
+			setupMock: func() {
+				s.mockClient.On("GetDiskUsageWithResponse", mock.Anything, mock.Anything, mock.Anything).
+					Return(NewMockDiskUsageResponse(5368709120), nil)
+			},
+			value: 5368709120,
+		},
+		{
+			name:       "Disk capacity success",
+			metricType: MetricTypeDiskCapacity,
+			setupMock: func() {
+				s.mockClient.On("GetDiskCapacityWithResponse", mock.Anything, mock.Anything, mock.Anything).
+					Return(NewMockDiskCapacityResponse(10737418240), nil)
+			},
+			value: 10737418240,
+		},
 	}
 
 	for _, tt := range tests {
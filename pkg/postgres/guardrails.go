@@ -0,0 +1,454 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	statementTimeoutEnvKey         = "RENDER_POSTGRES_STATEMENT_TIMEOUT"
+	idleInTransactionTimeoutEnvKey = "RENDER_POSTGRES_IDLE_IN_TRANSACTION_TIMEOUT"
+	maxQueryCostEnvKey             = "RENDER_POSTGRES_MAX_QUERY_COST"
+
+	defaultStatementTimeout         = 30 * time.Second
+	defaultIdleInTransactionTimeout = 10 * time.Second
+)
+
+// queryGuardrails limits the queries query_render_postgres runs. A MaxCost of zero disables the
+// cost check.
+type queryGuardrails struct {
+	StatementTimeout         time.Duration
+	IdleInTransactionTimeout time.Duration
+	MaxCost                  float64
+}
+
+// queryGuardrailsFromEnv reads the guardrails operators have configured, falling back to the defaults
+// for any that aren't set or can't be parsed.
+func queryGuardrailsFromEnv() queryGuardrails {
+	guardrails := queryGuardrails{
+		StatementTimeout:         defaultStatementTimeout,
+		IdleInTransactionTimeout: defaultIdleInTransactionTimeout,
+	}
+
+	if value := os.Getenv(statementTimeoutEnvKey); value != "" {
+		if timeout, err := time.ParseDuration(value); err != nil || timeout <= 0 {
+			log.Printf("ignoring invalid %s %q, using %s\n", statementTimeoutEnvKey, value, defaultStatementTimeout)
+		} else {
+			guardrails.StatementTimeout = timeout
+		}
+	}
+
+	if value := os.Getenv(idleInTransactionTimeoutEnvKey); value != "" {
+		if timeout, err := time.ParseDuration(value); err != nil || timeout <= 0 {
+			log.Printf("ignoring invalid %s %q, using %s\n", idleInTransactionTimeoutEnvKey, value, defaultIdleInTransactionTimeout)
+		} else {
+			guardrails.IdleInTransactionTimeout = timeout
+		}
+	}
+
+	if value := os.Getenv(maxQueryCostEnvKey); value != "" {
+		if maxCost, err := strconv.ParseFloat(value, 64); err != nil || maxCost < 0 {
+			log.Printf("ignoring invalid %s %q, the query cost check is disabled\n", maxQueryCostEnvKey, value)
+		} else {
+			guardrails.MaxCost = maxCost
+		}
+	}
+
+	return guardrails
+}
+
+// setTimeouts sets the timeouts for the rest of tx.
+func (g queryGuardrails) setTimeouts(ctx context.Context, tx pgx.Tx) error {
+	settings := map[string]time.Duration{
+		"statement_timeout":                   g.StatementTimeout,
+		"idle_in_transaction_session_timeout": g.IdleInTransactionTimeout,
+	}
+	for name, timeout := range settings {
+		if _, err := tx.Exec(ctx, "SELECT set_config($1, $2, true)", name, strconv.FormatInt(timeout.Milliseconds(), 10)); err != nil {
+			return fmt.Errorf("error setting %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// checkCost refuses query if a cost ceiling is configured and the planner estimates the query costs
+// more than the ceiling.
+func (g queryGuardrails) checkCost(ctx context.Context, tx pgx.Tx, query string) error {
+	if g.MaxCost == 0 {
+		return nil
+	}
+	statement := query
+	switch firstKeyword(query) {
+	case "show":
+		// SHOW can't be explained.
+		return nil
+	case "explain":
+		// EXPLAIN can't be explained either, but EXPLAIN ANALYZE runs the statement it explains, so
+		// that statement is checked instead.
+		statement = explainedStatement(query)
+		if statement == "" {
+			return errors.New("query refused: the statement to explain couldn't be found")
+		}
+	}
+
+	var plan []byte
+	if err := tx.QueryRow(ctx, "EXPLAIN (FORMAT JSON) "+statement).Scan(&plan); err != nil {
+		return fmt.Errorf("error estimating query cost: %w", err)
+	}
+
+	cost, err := totalCost(plan)
+	if err != nil {
+		return err
+	}
+	if cost > g.MaxCost {
+		return fmt.Errorf("query refused: the planner estimates a cost of %.0f, which is above the limit of %.0f. "+
+			"Add filters or a LIMIT to the query, or query a smaller table", cost, g.MaxCost)
+	}
+
+	return nil
+}
+
+// queryError explains errors caused by the guardrails, which Postgres reports as a generic
+// cancellation.
+func (g queryGuardrails) queryError(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "57014" {
+		return fmt.Sprintf("query refused: it ran longer than the statement timeout of %s", g.StatementTimeout)
+	}
+	return err.Error()
+}
+
+// totalCost returns the estimated total cost of the plan returned by EXPLAIN (FORMAT JSON).
+func totalCost(plan []byte) (float64, error) {
+	var explain []struct {
+		Plan struct {
+			TotalCost float64 `json:"Total Cost"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil {
+		return 0, fmt.Errorf("error reading query plan: %w", err)
+	}
+	if len(explain) == 0 {
+		return 0, errors.New("error reading query plan: the plan is empty")
+	}
+
+	return explain[0].Plan.TotalCost, nil
+}
+
+// allowedStatements are the statements query_render_postgres runs. Everything else, including
+// statements that change session settings or end the read-only transaction, is refused.
+var allowedStatements = map[string]bool{
+	"select":  true,
+	"with":    true,
+	"values":  true,
+	"table":   true,
+	"explain": true,
+	"show":    true,
+}
+
+// deniedFunctions are functions that reach outside the database, read server files, change session
+// settings, or interfere with other sessions.
+//
+// The deny list only catches mistakes in queries written in good faith. It can't recognize every way
+// of calling a function, so the read-only transaction and the statement timeout are what actually
+// limit what a query can do.
+var deniedFunctions = map[string]bool{
+	"pg_read_file":         true,
+	"pg_read_binary_file":  true,
+	"pg_ls_dir":            true,
+	"pg_stat_file":         true,
+	"lo_import":            true,
+	"lo_export":            true,
+	"set_config":           true,
+	"pg_sleep":             true,
+	"pg_sleep_for":         true,
+	"pg_sleep_until":       true,
+	"pg_terminate_backend": true,
+	"pg_cancel_backend":    true,
+	"pg_reload_conf":       true,
+	"pg_rotate_logfile":    true,
+}
+
+// deniedFunctionPrefixes are families of denied functions. dblink reaches outside the database, and
+// the *_to_xml functions run queries passed to them as strings, which would get around the deny list.
+var deniedFunctionPrefixes = []string{
+	"dblink",
+	"query_to_xml",
+	"cursor_to_xml",
+	"table_to_xml",
+	"schema_to_xml",
+	"database_to_xml",
+}
+
+// validateQuery refuses queries that have more than one statement, aren't one of the allowed
+// statements, reference a denied function, or use Unicode escapes in identifiers or strings, which
+// could spell the name of a denied function.
+func validateQuery(query string) error {
+	tokens, err := tokenizeSQL(query)
+	if err != nil {
+		return fmt.Errorf("query refused: %w", err)
+	}
+	if len(tokens) == 0 {
+		return errors.New("query refused: the query is empty")
+	}
+
+	for i, token := range tokens {
+		if token.kind == sqlTokenSemicolon && i != len(tokens)-1 {
+			return errors.New("query refused: only a single statement can be run at a time")
+		}
+	}
+
+	if tokens[0].kind != sqlTokenWord || !allowedStatements[tokens[0].text] {
+		return errors.New("query refused: only SELECT, WITH, VALUES, TABLE, EXPLAIN and SHOW statements can be run")
+	}
+
+	for i, token := range tokens {
+		if isUnicodeEscapePrefix(tokens, i) {
+			return errors.New("query refused: Unicode escapes (U&) can't be used in queries")
+		}
+		if token.kind != sqlTokenWord && token.kind != sqlTokenQuotedIdentifier {
+			continue
+		}
+		if isDeniedFunction(token.text) {
+			return fmt.Errorf("query refused: %s can't be used in queries", token.text)
+		}
+	}
+
+	return nil
+}
+
+func isDeniedFunction(name string) bool {
+	if deniedFunctions[name] {
+		return true
+	}
+	for _, prefix := range deniedFunctionPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnicodeEscapePrefix reports whether tokens[i] starts a U&"..." identifier or U&'...' string.
+// Unlike the "u & ..." operator expression, the U, the & and the quote are written without spaces.
+func isUnicodeEscapePrefix(tokens []sqlToken, i int) bool {
+	if i+2 >= len(tokens) || tokens[i].kind != sqlTokenWord || tokens[i].text != "u" {
+		return false
+	}
+	amp, quoted := tokens[i+1], tokens[i+2]
+	return amp.kind == sqlTokenOther && amp.text == "&" && amp.pos == tokens[i].pos+1 &&
+		(quoted.kind == sqlTokenQuotedIdentifier || quoted.kind == sqlTokenString) && quoted.pos == amp.pos+1
+}
+
+func firstKeyword(query string) string {
+	tokens, err := tokenizeSQL(query)
+	if err != nil || len(tokens) == 0 || tokens[0].kind != sqlTokenWord {
+		return ""
+	}
+	return tokens[0].text
+}
+
+// explainedStatement returns the statement an EXPLAIN query explains, without EXPLAIN and its
+// options, or "" if query isn't an EXPLAIN of a statement.
+func explainedStatement(query string) string {
+	tokens, err := tokenizeSQL(query)
+	if err != nil || len(tokens) == 0 || tokens[0].kind != sqlTokenWord || tokens[0].text != "explain" {
+		return ""
+	}
+
+	i := 1
+	if i < len(tokens) && tokens[i].kind == sqlTokenOther && tokens[i].text == "(" {
+		// EXPLAIN (option [, ...]) statement
+		depth := 0
+		for ; i < len(tokens); i++ {
+			if tokens[i].kind != sqlTokenOther {
+				continue
+			}
+			if tokens[i].text == "(" {
+				depth++
+			} else if tokens[i].text == ")" {
+				depth--
+				if depth == 0 {
+					i++
+					break
+				}
+			}
+		}
+	} else {
+		// EXPLAIN [ANALYZE] [VERBOSE] statement
+		for i < len(tokens) && tokens[i].kind == sqlTokenWord &&
+			(tokens[i].text == "analyze" || tokens[i].text == "analyse" || tokens[i].text == "verbose") {
+			i++
+		}
+	}
+	if i >= len(tokens) || tokens[i].kind == sqlTokenSemicolon {
+		return ""
+	}
+
+	return query[tokens[i].pos:]
+}
+
+type sqlTokenKind int
+
+const (
+	sqlTokenWord sqlTokenKind = iota
+	sqlTokenQuotedIdentifier
+	sqlTokenString
+	sqlTokenSemicolon
+	sqlTokenOther
+)
+
+// sqlToken is a token of a query. pos is the offset of the start of the token in the query.
+type sqlToken struct {
+	kind sqlTokenKind
+	text string
+	pos  int
+}
+
+// tokenizeSQL splits a query into tokens, skipping comments. It understands enough of Postgres'
+// lexical structure to tell keywords and identifiers apart from the contents of strings, quoted
+// identifiers and comments. Unquoted words are lowercased, as Postgres does.
+func tokenizeSQL(query string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case isSQLSpace(c):
+			i++
+		case strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end == -1 {
+				return tokens, nil
+			}
+			i += end + 1
+		case strings.HasPrefix(query[i:], "/*"):
+			// Block comments nest in Postgres.
+			depth := 0
+			for {
+				if i >= len(query) {
+					return nil, errors.New("unterminated comment")
+				}
+				if strings.HasPrefix(query[i:], "/*") {
+					depth++
+					i += 2
+				} else if strings.HasPrefix(query[i:], "*/") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+		case c == '\'':
+			end, err := quotedEnd(query, i, '\'', false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, text: query[i:end], pos: i})
+			i = end
+		case (c == 'e' || c == 'E') && i+1 < len(query) && query[i+1] == '\'':
+			end, err := quotedEnd(query, i+1, '\'', true)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, text: query[i:end], pos: i})
+			i = end
+		case c == '"':
+			end, err := quotedEnd(query, i, '"', false)
+			if err != nil {
+				return nil, err
+			}
+			text := strings.ReplaceAll(query[i+1:end-1], `""`, `"`)
+			tokens = append(tokens, sqlToken{kind: sqlTokenQuotedIdentifier, text: text, pos: i})
+			i = end
+		case c == '$' && dollarQuoteTag(query[i:]) != "":
+			tag := dollarQuoteTag(query[i:])
+			end := strings.Index(query[i+len(tag):], tag)
+			if end == -1 {
+				return nil, errors.New("unterminated dollar-quoted string")
+			}
+			end = i + len(tag) + end + len(tag)
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, text: query[i:end], pos: i})
+			i = end
+		case c == ';':
+			tokens = append(tokens, sqlToken{kind: sqlTokenSemicolon, text: ";", pos: i})
+			i++
+		case isSQLIdentStart(c):
+			start := i
+			for i < len(query) && isSQLIdentPart(query[i]) {
+				i++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenWord, text: strings.ToLower(query[start:i]), pos: start})
+		default:
+			tokens = append(tokens, sqlToken{kind: sqlTokenOther, text: string(c), pos: i})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// quotedEnd returns the index just past the closing quote of the quoted string starting at start.
+// A doubled quote is an escaped quote, as is a backslash-escaped quote in escape strings.
+func quotedEnd(query string, start int, quote byte, backslashEscapes bool) (int, error) {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1, nil
+		}
+	}
+
+	if quote == '"' {
+		return 0, errors.New("unterminated quoted identifier")
+	}
+	return 0, errors.New("unterminated string")
+}
+
+// dollarQuoteTag returns the opening tag of a dollar-quoted string, like $$ or $body$, at the start
+// of s, or "" if s doesn't start with one.
+func dollarQuoteTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '$':
+			return s[:i+1]
+		case i == 1 && !isSQLIdentStart(s[i]):
+			return ""
+		case !isSQLIdentPart(s[i]):
+			return ""
+		}
+	}
+	return ""
+}
+
+func isSQLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isSQLIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isSQLIdentPart(c byte) bool {
+	return isSQLIdentStart(c) || (c >= '0' && c <= '9') || c == '$'
+}
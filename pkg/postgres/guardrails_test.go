@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedError string
+	}{
+		{name: "Allows a select", query: "SELECT * FROM users WHERE id = 1"},
+		{name: "Allows a trailing semicolon", query: "select 1;"},
+		{name: "Allows a CTE", query: "WITH recent AS (SELECT * FROM orders) SELECT count(*) FROM recent"},
+		{name: "Allows explain", query: "EXPLAIN ANALYZE SELECT * FROM users"},
+		{name: "Allows leading comments", query: "-- count users\n/* outer /* nested */ */ SELECT count(*) FROM users"},
+		{name: "Allows semicolons in strings", query: "SELECT 'a;b', E'it\\'s;', $body$x;y$body$, \"odd;name\" FROM t"},
+		{name: "Allows denied names in strings", query: "SELECT 'pg_sleep(10)' AS note"},
+		{name: "Allows denied names in comments", query: "SELECT 1 -- not dblink"},
+		{
+			name:          "Rejects multiple statements",
+			query:         "SELECT 1; SELECT 2",
+			expectedError: "only a single statement",
+		},
+		{
+			name:          "Rejects statements hidden after a comment",
+			query:         "SELECT 1; -- harmless\nCOMMIT",
+			expectedError: "only a single statement",
+		},
+		{
+			name:          "Rejects transaction control",
+			query:         "COMMIT",
+			expectedError: "only SELECT, WITH, VALUES, TABLE, EXPLAIN and SHOW statements",
+		},
+		{
+			name:          "Rejects changing settings",
+			query:         "SET statement_timeout = 0",
+			expectedError: "only SELECT, WITH, VALUES, TABLE, EXPLAIN and SHOW statements",
+		},
+		{
+			name:          "Rejects dblink",
+			query:         "SELECT * FROM dblink('host=evil', 'select 1') AS t(a int)",
+			expectedError: "dblink can't be used",
+		},
+		{
+			name:          "Rejects dblink variants",
+			query:         "SELECT DBLINK_CONNECT('host=evil')",
+			expectedError: "dblink_connect can't be used",
+		},
+		{
+			name:          "Rejects reading server files",
+			query:         "SELECT pg_catalog.pg_read_file('/etc/passwd')",
+			expectedError: "pg_read_file can't be used",
+		},
+		{
+			name:          "Rejects quoted function names",
+			query:         `SELECT "pg_read_file"('/etc/passwd')`,
+			expectedError: "pg_read_file can't be used",
+		},
+		{
+			name:          "Rejects changing settings with set_config",
+			query:         "SELECT set_config('statement_timeout', '0', true)",
+			expectedError: "set_config can't be used",
+		},
+		{
+			name:          "Rejects functions that run queries passed as strings",
+			query:         "SELECT query_to_xml('select pg_sleep(100)', true, false, '')",
+			expectedError: "query_to_xml can't be used",
+		},
+		{
+			name:          "Rejects the rest of the xml query family",
+			query:         "SELECT table_to_xml_and_xmlschema('users', true, false, '')",
+			expectedError: "table_to_xml_and_xmlschema can't be used",
+		},
+		{
+			name:          "Rejects Unicode escaped identifiers",
+			query:         `SELECT U&"pg_sleep"(100)`,
+			expectedError: "Unicode escapes (U&) can't be used",
+		},
+		{
+			name:          "Rejects Unicode escaped strings",
+			query:         `SELECT u&'\0070g_sleep'`,
+			expectedError: "Unicode escapes (U&) can't be used",
+		},
+		{name: "Allows a column named u in a bitwise and", query: `SELECT u & "mask" FROM flags`},
+		{
+			name:          "Rejects unterminated strings",
+			query:         "SELECT 'oops",
+			expectedError: "unterminated string",
+		},
+		{
+			name:          "Rejects empty queries",
+			query:         "  -- nothing\n",
+			expectedError: "the query is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateQuery(tt.query)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, "query refused")
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestExplainedStatement(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{query: "EXPLAIN SELECT 1", expected: "SELECT 1"},
+		{query: "explain analyze verbose SELECT * FROM users", expected: "SELECT * FROM users"},
+		{query: "EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON) SELECT (1)", expected: "SELECT (1)"},
+		{query: "EXPLAIN /* why */ ANALYSE\nWITH t AS (SELECT 1) TABLE t", expected: "WITH t AS (SELECT 1) TABLE t"},
+		{query: "EXPLAIN (ANALYZE", expected: ""},
+		{query: "EXPLAIN ANALYZE;", expected: ""},
+		{query: "SELECT 1", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, explainedStatement(tt.query))
+		})
+	}
+}
+
+func TestCheckCost(t *testing.T) {
+	guardrails := queryGuardrails{MaxCost: 1000}
+
+	tests := []struct {
+		name            string
+		query           string
+		cost            float64
+		expectedExplain string
+		expectedError   string
+	}{
+		{
+			name:            "Allows cheap queries",
+			query:           "SELECT * FROM users WHERE id = 1",
+			cost:            8.27,
+			expectedExplain: "EXPLAIN (FORMAT JSON) SELECT * FROM users WHERE id = 1",
+		},
+		{
+			name:            "Refuses expensive queries",
+			query:           "SELECT * FROM events",
+			cost:            18334,
+			expectedExplain: "EXPLAIN (FORMAT JSON) SELECT * FROM events",
+			expectedError:   "the planner estimates a cost of 18334, which is above the limit of 1000",
+		},
+		{
+			name:            "Checks the statement that EXPLAIN ANALYZE runs",
+			query:           "EXPLAIN (ANALYZE, BUFFERS) SELECT * FROM events",
+			cost:            18334,
+			expectedExplain: "EXPLAIN (FORMAT JSON) SELECT * FROM events",
+			expectedError:   "the planner estimates a cost of 18334, which is above the limit of 1000",
+		},
+		{
+			name:  "Skips SHOW",
+			query: "SHOW server_version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakePlanTx{plan: fmt.Sprintf(`[{"Plan": {"Total Cost": %f}}]`, tt.cost)}
+
+			err := guardrails.checkCost(context.Background(), tx, tt.query)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+			if tt.expectedExplain == "" {
+				assert.Empty(t, tx.queries)
+			} else {
+				assert.Equal(t, []string{tt.expectedExplain}, tx.queries)
+			}
+		})
+	}
+}
+
+// fakePlanTx is a transaction that answers every query with plan.
+type fakePlanTx struct {
+	pgx.Tx
+	plan    string
+	queries []string
+}
+
+func (tx *fakePlanTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	tx.queries = append(tx.queries, sql)
+	return fakePlanRow(tx.plan)
+}
+
+type fakePlanRow string
+
+func (r fakePlanRow) Scan(dest ...any) error {
+	*dest[0].(*[]byte) = []byte(r)
+	return nil
+}
+
+func TestTotalCost(t *testing.T) {
+	cost, err := totalCost([]byte(`[{"Plan": {"Node Type": "Seq Scan", "Startup Cost": 0.00, "Total Cost": 18334.00, "Plan Rows": 1000000}}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, 18334.0, cost)
+
+	_, err = totalCost([]byte(`[]`))
+	assert.ErrorContains(t, err, "the plan is empty")
+}
+
+func TestQueryGuardrailsFromEnv(t *testing.T) {
+	t.Setenv(statementTimeoutEnvKey, "5s")
+	t.Setenv(idleInTransactionTimeoutEnvKey, "not a duration")
+	t.Setenv(maxQueryCostEnvKey, "10000")
+
+	assert.Equal(t, queryGuardrails{
+		StatementTimeout:         5 * time.Second,
+		IdleInTransactionTimeout: defaultIdleInTransactionTimeout,
+		MaxCost:                  10000,
+	}, queryGuardrailsFromEnv())
+}
+
+func TestQueryError(t *testing.T) {
+	guardrails := queryGuardrails{StatementTimeout: 5 * time.Second}
+
+	timeoutErr := fmt.Errorf("error iterating rows: %w", &pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"})
+	assert.Equal(t, "query refused: it ran longer than the statement timeout of 5s", guardrails.queryError(timeoutErr))
+
+	otherErr := &pgconn.PgError{Code: "42P01", Message: `relation "missing" does not exist`}
+	assert.Equal(t, otherErr.Error(), guardrails.queryError(otherErr))
+}
@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/render-oss/render-mcp-server/pkg/authn"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+const (
+	defaultPoolMaxConns      = 4
+	defaultPoolIdleTimeout   = 5 * time.Minute
+	defaultConnectionInfoTTL = 5 * time.Minute
+)
+
+// PoolCache keeps a connection pool per database and API token, so that follow-up queries reuse
+// connections instead of looking up connection info and connecting again. Pools that haven't been
+// used for the idle timeout are closed. Callers must call Close when they are done with the cache.
+type PoolCache struct {
+	mu    sync.Mutex
+	pools map[poolKey]*cachedPool
+
+	maxConns          int32
+	idleTimeout       time.Duration
+	connectionInfoTTL time.Duration
+	now               func() time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// poolKey includes a hash of the API token, so that a pool opened for one caller is never used by a
+// caller that may not have access to the database.
+type poolKey struct {
+	postgresId string
+	tokenHash  string
+}
+
+// connectionInfo is what the cache needs to know about a database. The owner ID is kept with the
+// connection string, so that callers can check the database is in their workspace without making an
+// API request on every query.
+type connectionInfo struct {
+	connString string
+	ownerId    string
+}
+
+type cachedPool struct {
+	pool              *pgxpool.Pool
+	connString        string
+	ownerId           string
+	connInfoFetchedAt time.Time
+	lastUsed          time.Time
+	inUse             int
+}
+
+func NewPoolCache() *PoolCache {
+	c := newPoolCache(defaultPoolMaxConns, defaultPoolIdleTimeout, defaultConnectionInfoTTL, time.Now)
+	go c.evictIdlePools()
+	return c
+}
+
+func newPoolCache(maxConns int32, idleTimeout time.Duration, connectionInfoTTL time.Duration, now func() time.Time) *PoolCache {
+	return &PoolCache{
+		pools:             map[poolKey]*cachedPool{},
+		maxConns:          maxConns,
+		idleTimeout:       idleTimeout,
+		connectionInfoTTL: connectionInfoTTL,
+		now:               now,
+		stop:              make(chan struct{}),
+	}
+}
+
+// Acquire returns the pool for the database and the ID of the database's owner, creating the pool if
+// needed. getConnectionInfo is called to look up the connection info when there is no pool yet or the
+// cached connection info is older than the TTL. If the connection string has changed, for example
+// because credentials were rotated, the old pool is replaced. The returned release func must be called
+// once the caller is done with the pool.
+func (c *PoolCache) Acquire(ctx context.Context, postgresId string, getConnectionInfo func(ctx context.Context) (connectionInfo, error)) (*pgxpool.Pool, string, func(), error) {
+	key := poolKey{postgresId: postgresId, tokenHash: hashToken(authn.APITokenFromContext(ctx))}
+
+	c.mu.Lock()
+	cached, ok := c.pools[key]
+	if ok && c.now().Sub(cached.connInfoFetchedAt) < c.connectionInfoTTL {
+		c.markUsed(cached)
+		c.mu.Unlock()
+		return cached.pool, cached.ownerId, c.releaseFunc(cached), nil
+	}
+	c.mu.Unlock()
+
+	// Look up the connection info without holding the lock, since it makes API requests.
+	latest, err := getConnectionInfo(ctx)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Another caller may have refreshed the pool while the lock wasn't held.
+	cached, ok = c.pools[key]
+	if ok && cached.connString == latest.connString {
+		cached.ownerId = latest.ownerId
+		cached.connInfoFetchedAt = c.now()
+		c.markUsed(cached)
+		return cached.pool, cached.ownerId, c.releaseFunc(cached), nil
+	}
+
+	config, err := pgxpool.ParseConfig(latest.connString)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	config.MaxConns = c.maxConns
+	config.MinConns = 0
+	config.MaxConnIdleTime = c.idleTimeout
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	if ok {
+		c.closeWhenUnused(key, cached)
+	}
+
+	cached = &cachedPool{
+		pool:              pool,
+		connString:        latest.connString,
+		ownerId:           latest.ownerId,
+		connInfoFetchedAt: c.now(),
+	}
+	c.pools[key] = cached
+	c.markUsed(cached)
+
+	return cached.pool, cached.ownerId, c.releaseFunc(cached), nil
+}
+
+// Close closes every pool and stops evicting idle pools.
+func (c *PoolCache) Close() {
+	c.stopOnce.Do(func() { close(c.stop) })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, cached := range c.pools {
+		cached.pool.Close()
+		delete(c.pools, key)
+	}
+}
+
+func (c *PoolCache) evictIdlePools() {
+	ticker := time.NewTicker(c.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.evictIdle()
+		}
+	}
+}
+
+// evictIdle closes pools that aren't in use and haven't been used for the idle timeout.
+func (c *PoolCache) evictIdle() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, cached := range c.pools {
+		if cached.inUse == 0 && c.now().Sub(cached.lastUsed) >= c.idleTimeout {
+			cached.pool.Close()
+			delete(c.pools, key)
+		}
+	}
+}
+
+// closeWhenUnused removes a replaced pool from the cache. It is closed right away if nobody is using
+// it, or otherwise when the last caller releases it. Must be called with the lock held.
+func (c *PoolCache) closeWhenUnused(key poolKey, cached *cachedPool) {
+	delete(c.pools, key)
+	if cached.inUse == 0 {
+		cached.pool.Close()
+	}
+}
+
+// markUsed must be called with the lock held.
+func (c *PoolCache) markUsed(cached *cachedPool) {
+	cached.inUse++
+	cached.lastUsed = c.now()
+}
+
+func (c *PoolCache) releaseFunc(cached *cachedPool) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			cached.inUse--
+			cached.lastUsed = c.now()
+
+			// Pools that were replaced while in use are closed once the last caller is done.
+			if cached.inUse == 0 && !c.isCached(cached) {
+				cached.pool.Close()
+			}
+		})
+	}
+}
+
+// isCached must be called with the lock held.
+func (c *PoolCache) isCached(cached *cachedPool) bool {
+	for _, p := range c.pools {
+		if p == cached {
+			return true
+		}
+	}
+	return false
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// acquirePool returns the pool for the database, looking up its owner and external connection string
+// through the Render API when needed. The database must be in the current workspace.
+func acquirePool(ctx context.Context, pools *PoolCache, postgresRepo *Repo, postgresId string) (*pgxpool.Pool, func(), error) {
+	pool, ownerId, release, err := pools.Acquire(ctx, postgresId, func(ctx context.Context) (connectionInfo, error) {
+		// Check the workspace before looking up the connection string, so that credentials for a
+		// database in another workspace are never fetched.
+		pg, err := postgresRepo.getPostgresInWorkspace(ctx, postgresId)
+		if err != nil {
+			return connectionInfo{}, err
+		}
+
+		info, err := postgresRepo.GetPostgresConnectionInfo(ctx, postgresId)
+		if err != nil {
+			return connectionInfo{}, err
+		}
+		return connectionInfo{connString: info.ExternalConnectionString, ownerId: pg.Owner.Id}, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Pools are shared by every session using the same API token, so the workspace is checked against
+	// the cached owner on every call rather than only when the connection info is looked up.
+	if err := validate.WorkspaceMatches(ctx, ownerId); err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	return pool, release, nil
+}
+
+// beginTx begins a transaction on a pooled connection. It's a variable so tests can stand in a fake
+// transaction.
+var beginTx = func(ctx context.Context, pool *pgxpool.Pool, options pgx.TxOptions) (pgx.Tx, error) {
+	return pool.BeginTx(ctx, options)
+}
+
+// beginReadOnlyTx begins a read-only transaction on a pooled connection to the database, with the
+// guardrail timeouts applied. The database must be in the current workspace. The returned func rolls
+// back the transaction and releases the pool, and must be called once the caller is done with the
+// transaction.
+func beginReadOnlyTx(ctx context.Context, pools *PoolCache, postgresRepo *Repo, postgresId string, guardrails queryGuardrails) (pgx.Tx, func(), error) {
+	pool, release, err := acquirePool(ctx, pools, postgresRepo, postgresId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to database: %w", err)
+	}
+
+	tx, err := beginTx(ctx, pool, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		release()
+		return nil, nil, fmt.Errorf("error beginning transaction: %w", err)
+	}
+
+	done := func() {
+		// Ignore the error from rolling back, since nothing in a read-only transaction needs to be kept.
+		_ = tx.Rollback(ctx)
+		release()
+	}
+
+	if err := guardrails.setTimeouts(ctx, tx); err != nil {
+		done()
+		return nil, nil, err
+	}
+
+	return tx, done, nil
+}
@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	defaultQueryMaxRows = 100
+	maxQueryMaxRows     = 1000
+)
+
+const (
+	queryFormatJSON     = "json"
+	queryFormatCSV      = "csv"
+	queryFormatMarkdown = "markdown"
+)
+
+// QueryResult is the result of a query. Rows hold the values of each row in the same order as
+// Columns.
+type QueryResult struct {
+	Columns   []QueryColumn `json:"columns"`
+	Rows      [][]any       `json:"rows"`
+	RowCount  int           `json:"rowCount"`
+	Truncated bool          `json:"truncated"`
+}
+
+type QueryColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// readQueryResult reads at most maxRows rows and closes rows. Truncated is set if there were more
+// rows to read.
+func readQueryResult(rows pgx.Rows, typeMap *pgtype.Map, maxRows int) (*QueryResult, error) {
+	defer rows.Close()
+
+	fieldDescriptions := rows.FieldDescriptions()
+	result := &QueryResult{
+		Columns: make([]QueryColumn, len(fieldDescriptions)),
+		Rows:    [][]any{},
+	}
+	for i, fd := range fieldDescriptions {
+		result.Columns[i] = QueryColumn{
+			Name: fd.Name,
+			Type: typeName(typeMap, fd.DataTypeOID),
+		}
+	}
+
+	for rows.Next() {
+		if len(result.Rows) == maxRows {
+			result.Truncated = true
+			break
+		}
+
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("error reading row values: %w", err)
+		}
+
+		row := make([]any, len(values))
+		for i, value := range values {
+			row[i] = encodeValue(value, fieldDescriptions[i].DataTypeOID)
+		}
+		result.Rows = append(result.Rows, row)
+	}
+
+	// Closing before checking the error makes sure an error that stopped the query early is reported.
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	result.RowCount = len(result.Rows)
+	return result, nil
+}
+
+func typeName(typeMap *pgtype.Map, oid uint32) string {
+	if dt, ok := typeMap.TypeForOID(oid); ok {
+		return dt.Name
+	}
+	return fmt.Sprintf("oid:%d", oid)
+}
+
+// encodeValue converts a value decoded by pgx into one that encodes to JSON without losing
+// information. Numerics are encoded as strings so they keep their precision.
+func encodeValue(value any, oid uint32) any {
+	switch v := value.(type) {
+	case pgtype.Numeric:
+		s, err := v.Value()
+		if err != nil {
+			return nil
+		}
+		return s
+	case pgtype.Interval:
+		s, err := v.Value()
+		if err != nil {
+			return nil
+		}
+		return s
+	case [16]byte:
+		return fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16])
+	case []byte:
+		if oid == pgtype.ByteaOID {
+			return `\x` + hex.EncodeToString(v)
+		}
+		return string(v)
+	case time.Time:
+		if oid == pgtype.DateOID {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format(time.RFC3339Nano)
+	case float32:
+		return encodeFloat(float64(v))
+	case float64:
+		return encodeFloat(v)
+	case []any:
+		// Array elements don't carry their own OID, so only types that can be recognized from their
+		// Go type are encoded.
+		elements := make([]any, len(v))
+		for i, element := range v {
+			elements[i] = encodeValue(element, 0)
+		}
+		return elements
+	default:
+		return v
+	}
+}
+
+// encodeFloat encodes the special float values Postgres supports as strings, because JSON has no
+// representation for them.
+func encodeFloat(f float64) any {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	default:
+		return f
+	}
+}
+
+func formatQueryResult(result *QueryResult, format string) (string, error) {
+	switch format {
+	case queryFormatJSON:
+		respJSON, err := json.Marshal(result)
+		if err != nil {
+			return "", err
+		}
+		return string(respJSON), nil
+	case queryFormatCSV:
+		return formatCSV(result)
+	case queryFormatMarkdown:
+		return formatMarkdown(result), nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func formatCSV(result *QueryResult) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := make([]string, len(result.Columns))
+	for i, col := range result.Columns {
+		header[i] = col.Name
+	}
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+
+	for _, row := range result.Rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			if value != nil {
+				record[i] = stringValue(value)
+			}
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+func formatMarkdown(result *QueryResult) string {
+	var sb strings.Builder
+
+	sb.WriteString("|")
+	for _, col := range result.Columns {
+		sb.WriteString(" " + markdownCell(col.Name) + " |")
+	}
+	sb.WriteString("\n|")
+	for range result.Columns {
+		sb.WriteString(" --- |")
+	}
+	sb.WriteString("\n")
+
+	for _, row := range result.Rows {
+		sb.WriteString("|")
+		for _, value := range row {
+			cell := "NULL"
+			if value != nil {
+				cell = markdownCell(stringValue(value))
+			}
+			sb.WriteString(" " + cell + " |")
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// stringValue formats an encoded value for text formats. Values that don't have a natural string
+// form, like JSON columns and arrays, are formatted as JSON.
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool, int16, int32, int64, int, float64:
+		return fmt.Sprint(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
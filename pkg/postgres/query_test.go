@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRows stands in for the rows pgx returns from a query, with values already decoded into the
+// Go types pgx uses for each column type.
+type fakeRows struct {
+	pgx.Rows
+	fields []pgconn.FieldDescription
+	values [][]any
+	err    error
+	next   int
+	closed bool
+}
+
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return r.fields }
+func (r *fakeRows) Err() error                                   { return r.err }
+func (r *fakeRows) Close()                                       { r.closed = true }
+
+func (r *fakeRows) Next() bool {
+	if r.closed || r.next >= len(r.values) {
+		return false
+	}
+	r.next++
+	return true
+}
+
+func (r *fakeRows) Values() ([]any, error) {
+	return r.values[r.next-1], nil
+}
+
+func TestReadQueryResult(t *testing.T) {
+	var numeric pgtype.Numeric
+	require.NoError(t, numeric.Scan("12345678901234567890.0123456789"))
+
+	createdAt := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	rows := &fakeRows{
+		fields: []pgconn.FieldDescription{
+			{Name: "id", DataTypeOID: pgtype.UUIDOID},
+			{Name: "balance", DataTypeOID: pgtype.NumericOID},
+			{Name: "retention", DataTypeOID: pgtype.IntervalOID},
+			{Name: "payload", DataTypeOID: pgtype.ByteaOID},
+			{Name: "birthday", DataTypeOID: pgtype.DateOID},
+			{Name: "created_at", DataTypeOID: pgtype.TimestamptzOID},
+			{Name: "ratio", DataTypeOID: pgtype.Float8OID},
+			{Name: "note", DataTypeOID: pgtype.TextOID},
+		},
+		values: [][]any{
+			{
+				[16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0},
+				numeric,
+				pgtype.Interval{Days: 3, Microseconds: int64(2 * time.Hour / time.Microsecond), Valid: true},
+				[]byte{0xde, 0xad, 0xbe, 0xef},
+				time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC),
+				createdAt,
+				math.NaN(),
+				nil,
+			},
+		},
+	}
+
+	result, err := readQueryResult(rows, pgtype.NewMap(), 10)
+	require.NoError(t, err)
+	assert.True(t, rows.closed)
+
+	assert.Equal(t, []QueryColumn{
+		{Name: "id", Type: "uuid"},
+		{Name: "balance", Type: "numeric"},
+		{Name: "retention", Type: "interval"},
+		{Name: "payload", Type: "bytea"},
+		{Name: "birthday", Type: "date"},
+		{Name: "created_at", Type: "timestamptz"},
+		{Name: "ratio", Type: "float8"},
+		{Name: "note", Type: "text"},
+	}, result.Columns)
+	assert.Equal(t, [][]any{{
+		"12345678-9abc-def0-1234-56789abcdef0",
+		"12345678901234567890.0123456789",
+		"3 day 02:00:00",
+		`\xdeadbeef`,
+		"1990-01-02",
+		"2024-05-01T12:30:00Z",
+		"NaN",
+		nil,
+	}}, result.Rows)
+	assert.Equal(t, 1, result.RowCount)
+	assert.False(t, result.Truncated)
+
+	_, err = json.Marshal(result)
+	assert.NoError(t, err)
+}
+
+func TestReadQueryResultTruncates(t *testing.T) {
+	rows := &fakeRows{
+		fields: []pgconn.FieldDescription{{Name: "n", DataTypeOID: pgtype.Int4OID}},
+		values: [][]any{{int32(1)}, {int32(2)}, {int32(3)}},
+	}
+
+	result, err := readQueryResult(rows, pgtype.NewMap(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{int32(1)}, {int32(2)}}, result.Rows)
+	assert.Equal(t, 2, result.RowCount)
+	assert.True(t, result.Truncated)
+}
+
+func TestReadQueryResultReturnsRowErrors(t *testing.T) {
+	rows := &fakeRows{
+		fields: []pgconn.FieldDescription{{Name: "n", DataTypeOID: pgtype.Int4OID}},
+		err:    errors.New("canceling statement due to statement timeout"),
+	}
+
+	_, err := readQueryResult(rows, pgtype.NewMap(), 10)
+	assert.ErrorContains(t, err, "statement timeout")
+}
+
+func TestFormatQueryResult(t *testing.T) {
+	result := &QueryResult{
+		Columns: []QueryColumn{{Name: "id", Type: "int4"}, {Name: "name", Type: "text"}, {Name: "tags", Type: "jsonb"}},
+		Rows: [][]any{
+			{int32(1), "a|b", map[string]any{"k": "v"}},
+			{int32(2), "line1\nline2", nil},
+		},
+		RowCount: 2,
+	}
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   queryFormatJSON,
+			expected: `{"columns":[{"name":"id","type":"int4"},{"name":"name","type":"text"},{"name":"tags","type":"jsonb"}],"rows":[[1,"a|b",{"k":"v"}],[2,"line1\nline2",null]],"rowCount":2,"truncated":false}`,
+		},
+		{
+			format:   queryFormatCSV,
+			expected: "id,name,tags\n1,a|b,\"{\"\"k\"\":\"\"v\"\"}\"\n2,\"line1\nline2\",\n",
+		},
+		{
+			format: queryFormatMarkdown,
+			expected: "| id | name | tags |\n" +
+				"| --- | --- | --- |\n" +
+				"| 1 | a\\|b | {\"k\":\"v\"} |\n" +
+				"| 2 | line1<br>line2 | NULL |\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			formatted, err := formatQueryResult(result, tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, formatted)
+		})
+	}
+}
@@ -2,9 +2,12 @@ package postgres
 
 import (
 	"context"
-	"net/http"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	pgclient "github.com/render-oss/render-mcp-server/pkg/client/postgres"
 	"github.com/render-oss/render-mcp-server/pkg/session"
 	"github.com/render-oss/render-mcp-server/pkg/validate"
 )
@@ -15,7 +18,15 @@ type postgresRepoClient interface {
 	RetrievePostgresWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrievePostgresResponse, error)
 	RetrievePostgresConnectionInfoWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrievePostgresConnectionInfoResponse, error)
 	CreatePostgresWithResponse(ctx context.Context, body client.PostgresPOSTInput, reqEditors ...client.RequestEditorFn) (*client.CreatePostgresResponse, error)
-	RestartPostgres(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*http.Response, error)
+	RestartPostgresWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.RestartPostgresResponse, error)
+	SuspendPostgresWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.SuspendPostgresResponse, error)
+	ResumePostgresWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.ResumePostgresResponse, error)
+	FailoverPostgresWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.FailoverPostgresResponse, error)
+	DeletePostgresWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.DeletePostgresResponse, error)
+	RetrievePostgresRecoveryInfoWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.RetrievePostgresRecoveryInfoResponse, error)
+	RecoverPostgresWithResponse(ctx context.Context, postgresId string, body client.RecoverPostgresJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.RecoverPostgresResponse, error)
+	ListPostgresExportWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.ListPostgresExportResponse, error)
+	CreatePostgresExportWithResponse(ctx context.Context, postgresId string, reqEditors ...client.RequestEditorFn) (*client.CreatePostgresExportResponse, error)
 }
 
 type Repo struct {
@@ -105,10 +116,191 @@ func (r *Repo) CreatePostgres(ctx context.Context, input client.PostgresPOSTInpu
 }
 
 func (r *Repo) RestartPostgresDatabase(ctx context.Context, id string) error {
-	resp, err := r.client.RestartPostgres(ctx, id)
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return err
+	}
+
+	resp, err := r.client.RestartPostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) SuspendPostgres(ctx context.Context, id string) error {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return err
+	}
+
+	resp, err := r.client.SuspendPostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) ResumePostgres(ctx context.Context, id string) error {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return err
+	}
+
+	resp, err := r.client.ResumePostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) FailoverPostgres(ctx context.Context, id string) error {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return err
+	}
+
+	resp, err := r.client.FailoverPostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// DeletePostgres deletes the database only if confirmationName matches its name, so that a caller
+// can't delete a database it hasn't looked up first.
+func (r *Repo) DeletePostgres(ctx context.Context, id string, confirmationName string) error {
+	pg, err := r.getPostgresInWorkspace(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if confirmationName != pg.Name {
+		return fmt.Errorf("confirmation name %q does not match the name of Postgres instance %s", confirmationName, id)
+	}
+
+	resp, err := r.client.DeletePostgresWithResponse(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	return client.ErrorFromResponse(resp)
 }
+
+func (r *Repo) GetPostgresRecoveryInfo(ctx context.Context, id string) (*pgclient.RecoveryInfo, error) {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return r.getRecoveryInfo(ctx, id)
+}
+
+func (r *Repo) getRecoveryInfo(ctx context.Context, id string) (*pgclient.RecoveryInfo, error) {
+	resp, err := r.client.RetrievePostgresRecoveryInfoWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// RecoverPostgres creates a new database from the state of an existing one at input.RestoreTime.
+// The new database is created in the same workspace as the existing one, which must be the current
+// workspace.
+func (r *Repo) RecoverPostgres(ctx context.Context, id string, input client.RecoverPostgresJSONRequestBody) (*client.PostgresDetail, error) {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return nil, err
+	}
+
+	info, err := r.getRecoveryInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRestoreTime(info, input.RestoreTime, time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.RecoverPostgresWithResponse(ctx, id, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) ListPostgresExports(ctx context.Context, id string) ([]pgclient.PostgresExport, error) {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.ListPostgresExportWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) CreatePostgresExport(ctx context.Context, id string) error {
+	if _, err := r.getPostgresInWorkspace(ctx, id); err != nil {
+		return err
+	}
+
+	resp, err := r.client.CreatePostgresExportWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// validateRestoreTime checks that restoreTime falls within the recovery window reported by the API,
+// which runs from info.StartsAt until now.
+func validateRestoreTime(info *pgclient.RecoveryInfo, restoreTime time.Time, now time.Time) error {
+	switch info.RecoveryStatus {
+	case pgclient.AVAILABLE:
+	case pgclient.BACKUPNOTREADY:
+		return errors.New("point-in-time recovery is not available yet because the first backup is not ready")
+	default:
+		return errors.New("point-in-time recovery is not available for this database")
+	}
+
+	if info.StartsAt != nil && restoreTime.Before(*info.StartsAt) {
+		return fmt.Errorf("restore time %s is before the earliest available restore time %s",
+			restoreTime.Format(time.RFC3339), info.StartsAt.Format(time.RFC3339))
+	}
+	if restoreTime.After(now) {
+		return fmt.Errorf("restore time %s is in the future", restoreTime.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (r *Repo) getPostgresInWorkspace(ctx context.Context, id string) (*client.PostgresDetail, error) {
+	pg, err := r.GetPostgres(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, pg.Owner.Id); err != nil {
+		return nil, err
+	}
+
+	return pg, nil
+}
@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SchemaSummary lists the tables, views and materialized views in a schema. Partitions are left out,
+// since they are included in the sizes and row estimates of their parent table.
+type SchemaSummary struct {
+	Name   string         `json:"name"`
+	Tables []TableSummary `json:"tables"`
+}
+
+type TableSummary struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	EstimatedRows *int64 `json:"estimatedRows,omitempty"`
+	TotalBytes    int64  `json:"totalBytes"`
+}
+
+type TableDescription struct {
+	Schema            string              `json:"schema"`
+	Name              string              `json:"name"`
+	Type              string              `json:"type"`
+	Comment           *string             `json:"comment,omitempty"`
+	EstimatedRows     *int64              `json:"estimatedRows,omitempty"`
+	TotalBytes        int64               `json:"totalBytes"`
+	TableBytes        int64               `json:"tableBytes"`
+	IndexesBytes      int64               `json:"indexesBytes"`
+	Columns           []ColumnDescription `json:"columns"`
+	PrimaryKey        []string            `json:"primaryKey,omitempty"`
+	ForeignKeys       []ForeignKey        `json:"foreignKeys,omitempty"`
+	UniqueConstraints []UniqueConstraint  `json:"uniqueConstraints,omitempty"`
+	Indexes           []IndexDescription  `json:"indexes,omitempty"`
+}
+
+type ColumnDescription struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Nullable bool    `json:"nullable"`
+	Default  *string `json:"default,omitempty"`
+	Comment  *string `json:"comment,omitempty"`
+}
+
+type ForeignKey struct {
+	Name              string   `json:"name"`
+	Columns           []string `json:"columns"`
+	ReferencedTable   string   `json:"referencedTable"`
+	ReferencedColumns []string `json:"referencedColumns"`
+	Definition        string   `json:"definition"`
+}
+
+type UniqueConstraint struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+}
+
+type IndexDescription struct {
+	Schema     string `json:"schema"`
+	Table      string `json:"table"`
+	Name       string `json:"name"`
+	Definition string `json:"definition"`
+	Unique     bool   `json:"unique"`
+	Primary    bool   `json:"primary"`
+	SizeBytes  int64  `json:"sizeBytes"`
+	Scans      *int64 `json:"scans,omitempty"`
+}
+
+// systemSchemaFilter leaves out the schemas Postgres uses internally. It expects the pg_namespace
+// table to be aliased as n.
+const systemSchemaFilter = `n.nspname NOT IN ('pg_catalog', 'information_schema')
+	AND n.nspname NOT LIKE 'pg_toast%'
+	AND n.nspname NOT LIKE 'pg_temp_%'`
+
+// estimatedRows converts the planner's row estimate, which is -1 for tables that have never been
+// vacuumed or analyzed, to a nullable row count.
+const estimatedRows = `CASE WHEN c.reltuples < 0 THEN NULL ELSE c.reltuples::bigint END`
+
+func listSchemas(ctx context.Context, tx pgx.Tx, schema *string) ([]SchemaSummary, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT n.nspname, c.relname, c.relkind::text, `+estimatedRows+`,
+			COALESCE(pg_total_relation_size(c.oid), 0)
+		FROM pg_namespace n
+		LEFT JOIN pg_class c ON c.relnamespace = n.oid
+			AND c.relkind IN ('r', 'p', 'v', 'm', 'f')
+			AND NOT c.relispartition
+		WHERE `+systemSchemaFilter+`
+			AND ($1::text IS NULL OR n.nspname = $1)
+		ORDER BY n.nspname, c.relname`, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schemas := []SchemaSummary{}
+	for rows.Next() {
+		var schemaName string
+		var tableName, relkind *string
+		var table TableSummary
+		if err := rows.Scan(&schemaName, &tableName, &relkind, &table.EstimatedRows, &table.TotalBytes); err != nil {
+			return nil, err
+		}
+
+		if len(schemas) == 0 || schemas[len(schemas)-1].Name != schemaName {
+			schemas = append(schemas, SchemaSummary{Name: schemaName, Tables: []TableSummary{}})
+		}
+		// Schemas without any tables have a single row with no table.
+		if tableName == nil {
+			continue
+		}
+
+		table.Name = *tableName
+		table.Type = relationType(*relkind)
+		current := &schemas[len(schemas)-1]
+		current.Tables = append(current.Tables, table)
+	}
+
+	return schemas, rows.Err()
+}
+
+func describeTable(ctx context.Context, tx pgx.Tx, schema string, table string) (*TableDescription, error) {
+	description := &TableDescription{Schema: schema, Name: table}
+
+	var oid uint32
+	var relkind string
+	err := tx.QueryRow(ctx, `
+		SELECT c.oid, c.relkind::text, obj_description(c.oid, 'pg_class'), `+estimatedRows+`,
+			pg_total_relation_size(c.oid), pg_relation_size(c.oid), pg_indexes_size(c.oid)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2
+			AND c.relkind IN ('r', 'p', 'v', 'm', 'f')`, schema, table,
+	).Scan(&oid, &relkind, &description.Comment, &description.EstimatedRows,
+		&description.TotalBytes, &description.TableBytes, &description.IndexesBytes)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("table %s.%s not found", schema, table)
+	}
+	if err != nil {
+		return nil, err
+	}
+	description.Type = relationType(relkind)
+
+	if description.Columns, err = listColumns(ctx, tx, oid); err != nil {
+		return nil, err
+	}
+	if err := addConstraints(ctx, tx, oid, description); err != nil {
+		return nil, err
+	}
+	if description.Indexes, err = listIndexes(ctx, tx, &schema, &table); err != nil {
+		return nil, err
+	}
+
+	return description, nil
+}
+
+func listColumns(ctx context.Context, tx pgx.Tx, tableOid uint32) ([]ColumnDescription, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod), NOT a.attnotnull,
+			pg_get_expr(d.adbin, d.adrelid), col_description(a.attrelid, a.attnum)
+		FROM pg_attribute a
+		LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		WHERE a.attrelid = $1 AND a.attnum > 0 AND NOT a.attisdropped
+		ORDER BY a.attnum`, tableOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := []ColumnDescription{}
+	for rows.Next() {
+		var column ColumnDescription
+		if err := rows.Scan(&column.Name, &column.Type, &column.Nullable, &column.Default, &column.Comment); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, rows.Err()
+}
+
+func addConstraints(ctx context.Context, tx pgx.Tx, tableOid uint32, description *TableDescription) error {
+	rows, err := tx.Query(ctx, `
+		SELECT con.conname, con.contype::text,
+			ARRAY(
+				SELECT a.attname FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord)
+				JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+				ORDER BY k.ord
+			),
+			CASE WHEN con.contype = 'f' THEN con.confrelid::regclass::text END,
+			ARRAY(
+				SELECT a.attname FROM unnest(con.confkey) WITH ORDINALITY AS k(attnum, ord)
+				JOIN pg_attribute a ON a.attrelid = con.confrelid AND a.attnum = k.attnum
+				ORDER BY k.ord
+			),
+			pg_get_constraintdef(con.oid)
+		FROM pg_constraint con
+		WHERE con.conrelid = $1 AND con.contype IN ('p', 'f', 'u')
+		ORDER BY con.conname`, tableOid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name, contype, definition string
+		var columns, referencedColumns []string
+		var referencedTable *string
+		if err := rows.Scan(&name, &contype, &columns, &referencedTable, &referencedColumns, &definition); err != nil {
+			return err
+		}
+
+		switch contype {
+		case "p":
+			description.PrimaryKey = columns
+		case "u":
+			description.UniqueConstraints = append(description.UniqueConstraints, UniqueConstraint{
+				Name:    name,
+				Columns: columns,
+			})
+		case "f":
+			fk := ForeignKey{
+				Name:              name,
+				Columns:           columns,
+				ReferencedColumns: referencedColumns,
+				Definition:        definition,
+			}
+			if referencedTable != nil {
+				fk.ReferencedTable = *referencedTable
+			}
+			description.ForeignKeys = append(description.ForeignKeys, fk)
+		}
+	}
+
+	return rows.Err()
+}
+
+func listIndexes(ctx context.Context, tx pgx.Tx, schema *string, table *string) ([]IndexDescription, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT n.nspname, t.relname, i.relname, pg_get_indexdef(i.oid), ix.indisunique, ix.indisprimary,
+			pg_relation_size(i.oid), s.idx_scan
+		FROM pg_index ix
+		JOIN pg_class i ON i.oid = ix.indexrelid
+		JOIN pg_class t ON t.oid = ix.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		LEFT JOIN pg_stat_all_indexes s ON s.indexrelid = ix.indexrelid
+		WHERE `+systemSchemaFilter+`
+			AND ($1::text IS NULL OR n.nspname = $1)
+			AND ($2::text IS NULL OR t.relname = $2)
+		ORDER BY n.nspname, t.relname, i.relname`, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indexes := []IndexDescription{}
+	for rows.Next() {
+		var index IndexDescription
+		if err := rows.Scan(&index.Schema, &index.Table, &index.Name, &index.Definition, &index.Unique,
+			&index.Primary, &index.SizeBytes, &index.Scans); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes, rows.Err()
+}
+
+// splitTableName returns the schema and name of a table. When no schema is given, the table name may
+// be qualified with its schema, and otherwise the table is assumed to be in the public schema.
+func splitTableName(schema string, table string) (string, string) {
+	if schema != "" {
+		return schema, table
+	}
+	if qualifiedSchema, name, ok := strings.Cut(table, "."); ok {
+		return qualifiedSchema, name
+	}
+	return "public", table
+}
+
+func relationType(relkind string) string {
+	switch relkind {
+	case "r":
+		return "table"
+	case "p":
+		return "partitioned table"
+	case "v":
+		return "view"
+	case "m":
+		return "materialized view"
+	case "f":
+		return "foreign table"
+	default:
+		return relkind
+	}
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTableName(t *testing.T) {
+	tests := []struct {
+		name       string
+		schema     string
+		table      string
+		wantSchema string
+		wantTable  string
+	}{
+		{name: "defaults to public", table: "users", wantSchema: "public", wantTable: "users"},
+		{name: "qualified table name", table: "analytics.events", wantSchema: "analytics", wantTable: "events"},
+		{name: "explicit schema", schema: "analytics", table: "events", wantSchema: "analytics", wantTable: "events"},
+		{name: "explicit schema wins over qualified name", schema: "analytics", table: "a.b", wantSchema: "analytics", wantTable: "a.b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, table := splitTableName(tt.schema, tt.table)
+			assert.Equal(t, tt.wantSchema, schema)
+			assert.Equal(t, tt.wantTable, table)
+		})
+	}
+}
+
+func TestRelationType(t *testing.T) {
+	assert.Equal(t, "table", relationType("r"))
+	assert.Equal(t, "partitioned table", relationType("p"))
+	assert.Equal(t, "materialized view", relationType("m"))
+}
+
+func TestListPostgresSchemasTool(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		rows         [][]any
+		expectedArgs []any
+		expectedText string
+		expectError  bool
+	}{
+		{
+			name: "Groups tables by schema",
+			rows: [][]any{
+				{"analytics", (*string)(nil), (*string)(nil), (*int64)(nil), int64(0)},
+				{"public", pointers.From("orders"), pointers.From("v"), (*int64)(nil), int64(0)},
+				{"public", pointers.From("users"), pointers.From("r"), pointers.From(int64(42)), int64(16384)},
+			},
+			expectedArgs: []any{(*string)(nil)},
+			expectedText: `[{"name":"analytics","tables":[]},{"name":"public","tables":[` +
+				`{"name":"orders","type":"view","totalBytes":0},` +
+				`{"name":"users","type":"table","estimatedRows":42,"totalBytes":16384}]}]`,
+		},
+		{
+			name:         "Returns an empty list when there are no schemas",
+			expectedArgs: []any{(*string)(nil)},
+			expectedText: `[]`,
+		},
+		{
+			name:         "Reports a missing schema",
+			arguments:    map[string]interface{}{"schema": "missing"},
+			expectedArgs: []any{pointers.From("missing")},
+			expectedText: "schema missing not found",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeCatalogTx{rows: tt.rows}
+			stubBeginTx(t, tx)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123"}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			pools := newPoolCache(1, time.Minute, time.Minute, time.Now)
+			defer pools.Close()
+
+			_, handler := listPostgresSchemas(NewRepo(fakeCatalogClient("own-123")), pools, queryGuardrails{})
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectError, result.IsError)
+			assert.Equal(t, tt.expectedText, result.Content[0].(mcp.TextContent).Text)
+
+			assert.Equal(t, pgx.ReadOnly, tx.options.AccessMode)
+			require.Len(t, tx.queries, 1)
+			assert.Contains(t, tx.queries[0], "FROM pg_namespace n")
+			assert.Contains(t, tx.queries[0], "n.nspname NOT IN ('pg_catalog', 'information_schema')")
+			assert.Equal(t, tt.expectedArgs, tx.args[0])
+			assert.True(t, tx.rolledBack)
+		})
+	}
+}
+
+func TestListPostgresIndexesToolReturnsEmptyList(t *testing.T) {
+	tx := &fakeCatalogTx{}
+	stubBeginTx(t, tx)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123", "table": "analytics.events"}
+
+	pools := newPoolCache(1, time.Minute, time.Minute, time.Now)
+	defer pools.Close()
+
+	_, handler := listPostgresIndexes(NewRepo(fakeCatalogClient("own-123")), pools, queryGuardrails{})
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, `[]`, result.Content[0].(mcp.TextContent).Text)
+
+	assert.Equal(t, pgx.ReadOnly, tx.options.AccessMode)
+	require.Len(t, tx.queries, 1)
+	assert.Contains(t, tx.queries[0], "FROM pg_index ix")
+	assert.Equal(t, []any{pointers.From("analytics"), pointers.From("events")}, tx.args[0])
+}
+
+func TestSchemaToolsCheckWorkspace(t *testing.T) {
+	tx := &fakeCatalogTx{}
+	stubBeginTx(t, tx)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123", "table": "users"}
+
+	pools := newPoolCache(1, time.Minute, time.Minute, time.Now)
+	defer pools.Close()
+
+	fakeClient := fakeCatalogClient("own-other")
+	repo := NewRepo(fakeClient)
+	for _, tool := range []func(*Repo, *PoolCache, queryGuardrails) (*mcp.Tool, server.ToolHandlerFunc){
+		listPostgresSchemas,
+		describePostgresTable,
+		listPostgresIndexes,
+	} {
+		_, handler := tool(repo, pools, queryGuardrails{})
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	}
+
+	assert.Equal(t, 0, fakeClient.RetrievePostgresConnectionInfoWithResponseCallCount())
+	assert.Empty(t, tx.queries)
+}
+
+func TestSchemaToolsReuseConnectionInfo(t *testing.T) {
+	tx := &fakeCatalogTx{}
+	stubBeginTx(t, tx)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123"}
+
+	pools := newPoolCache(1, time.Minute, time.Minute, time.Now)
+	defer pools.Close()
+
+	fakeClient := fakeCatalogClient("own-123")
+	_, handler := listPostgresSchemas(NewRepo(fakeClient), pools, queryGuardrails{})
+
+	for i := 0; i < 2; i++ {
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		require.NoError(t, err)
+		require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	}
+
+	// The second query within the TTL reuses the cached owner and connection string.
+	assert.Equal(t, 1, fakeClient.RetrievePostgresWithResponseCallCount())
+	assert.Equal(t, 1, fakeClient.RetrievePostgresConnectionInfoWithResponseCallCount())
+	assert.Len(t, tx.queries, 2)
+
+	// A session in another workspace is refused using the cached owner.
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-other"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, 1, fakeClient.RetrievePostgresWithResponseCallCount())
+	assert.Len(t, tx.queries, 2)
+}
+
+// stubBeginTx makes beginReadOnlyTx use tx for the rest of the test.
+func stubBeginTx(t *testing.T, tx *fakeCatalogTx) {
+	original := beginTx
+	beginTx = func(ctx context.Context, pool *pgxpool.Pool, options pgx.TxOptions) (pgx.Tx, error) {
+		tx.options = options
+		return tx, nil
+	}
+	t.Cleanup(func() { beginTx = original })
+}
+
+func fakeCatalogClient(ownerId string) *fakes.FakePostgresRepoClient {
+	fakeClient := &fakes.FakePostgresRepoClient{}
+	fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", ownerId), nil)
+	fakeClient.RetrievePostgresConnectionInfoWithResponseReturns(&client.RetrievePostgresConnectionInfoResponse{
+		JSON200:      &client.PostgresConnectionInfo{ExternalConnectionString: testConnString},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
+
+// fakeCatalogTx is a transaction that answers every query with rows. Statements run with Exec, which
+// only set the guardrail timeouts, aren't recorded.
+type fakeCatalogTx struct {
+	pgx.Tx
+	rows       [][]any
+	options    pgx.TxOptions
+	queries    []string
+	args       [][]any
+	rolledBack bool
+}
+
+func (tx *fakeCatalogTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *fakeCatalogTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	tx.queries = append(tx.queries, sql)
+	tx.args = append(tx.args, args)
+	return &fakeScanRows{fakeRows: fakeRows{values: tx.rows}}, nil
+}
+
+func (tx *fakeCatalogTx) Rollback(ctx context.Context) error {
+	tx.rolledBack = true
+	return nil
+}
+
+// fakeScanRows scans each value into the destination of the same position, which must point to the
+// value's type.
+type fakeScanRows struct {
+	fakeRows
+}
+
+func (r *fakeScanRows) Scan(dest ...any) error {
+	for i, value := range r.values[r.next-1] {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
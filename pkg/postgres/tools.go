@@ -4,32 +4,60 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/render-oss/render-mcp-server/pkg/client"
 	pgclient "github.com/render-oss/render-mcp-server/pkg/client/postgres"
+	"github.com/render-oss/render-mcp-server/pkg/environment"
 	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
 	"github.com/render-oss/render-mcp-server/pkg/session"
 	"github.com/render-oss/render-mcp-server/pkg/validate"
 )
 
-func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses, pools *PoolCache) {
 	postgresRepo := NewRepo(c)
+	environmentRepo := environment.NewRepo(c)
+	guardrails := queryGuardrailsFromEnv()
 
-	tool, handler := listPostgresInstances(postgresRepo)
+	tool, handler := listPostgresInstances(postgresRepo, environmentRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = getPostgres(postgresRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = createPostgres(postgresRepo)
 	s.AddTool(*tool, handler)
-	tool, handler = queryPostgres(postgresRepo)
+	tool, handler = queryPostgres(postgresRepo, pools, guardrails)
+	s.AddTool(*tool, handler)
+	tool, handler = listPostgresSchemas(postgresRepo, pools, guardrails)
+	s.AddTool(*tool, handler)
+	tool, handler = describePostgresTable(postgresRepo, pools, guardrails)
+	s.AddTool(*tool, handler)
+	tool, handler = listPostgresIndexes(postgresRepo, pools, guardrails)
+	s.AddTool(*tool, handler)
+	tool, handler = restartPostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = suspendPostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = resumePostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = failoverPostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deletePostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getPostgresRecoveryInfo(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = recoverPostgres(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listPostgresExports(postgresRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createPostgresExport(postgresRepo)
 	s.AddTool(*tool, handler)
 }
 
-func listPostgresInstances(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+func listPostgresInstances(postgresRepo *Repo, environmentRepo *environment.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("list_postgres_instances",
 		mcp.WithDescription("List all Postgres databases in your Render account"),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -38,10 +66,22 @@ func listPostgresInstances(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFun
 			IdempotentHint: pointers.From(true),
 			OpenWorldHint:  pointers.From(true),
 		}),
+		environment.WithProjectFilter(),
+		environment.WithEnvironmentFilter(),
 	)
 	return &tool,
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			postgres, err := postgresRepo.ListPostgres(ctx, &client.ListPostgresParams{})
+			params := &client.ListPostgresParams{}
+			if environmentIds, ok, err := environmentRepo.EnvironmentFilter(ctx, request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if len(environmentIds) == 0 {
+					return mcp.NewToolResultText("No Postgres instances found"), nil
+				}
+				params.EnvironmentId = &environmentIds
+			}
+
+			postgres, err := postgresRepo.ListPostgres(ctx, params)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -193,10 +233,363 @@ func createPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 		}
 }
 
-func queryPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+func restartPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("restart_postgres",
+		mcp.WithDescription("Restart a Postgres instance. "+
+			"Open connections are dropped while the database restarts."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Restart Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to restart"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.RestartPostgresDatabase(ctx, postgresId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Restart of Postgres instance %s has been requested", postgresId)), nil
+		}
+}
+
+func suspendPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("suspend_postgres",
+		mcp.WithDescription("Suspend a Postgres instance. "+
+			"A suspended database doesn't accept connections until it is resumed with the resume_postgres tool. "+
+			"Its data is kept while it is suspended."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Suspend Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to suspend"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.SuspendPostgres(ctx, postgresId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Postgres instance %s is being suspended", postgresId)), nil
+		}
+}
+
+func resumePostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("resume_postgres",
+		mcp.WithDescription("Resume a suspended Postgres instance so that it accepts connections again."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Resume Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to resume"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.ResumePostgres(ctx, postgresId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Postgres instance %s is being resumed", postgresId)), nil
+		}
+}
+
+func failoverPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("failover_postgres",
+		mcp.WithDescription("Trigger a failover of a high availability Postgres instance, promoting its standby to primary. "+
+			"Open connections are dropped during the failover. "+
+			"This only works for databases with high availability enabled."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Fail over Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to fail over"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.FailoverPostgres(ctx, postgresId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Failover of Postgres instance %s has been triggered", postgresId)), nil
+		}
+}
+
+func deletePostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_postgres",
+		mcp.WithDescription("Permanently delete a Postgres instance and all of its data. This cannot be undone. "+
+			"To confirm the deletion, confirmName must exactly match the name of the database, which you can look up with the get_postgres tool. "+
+			"Always ask the user to confirm before deleting a database."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to delete"),
+		),
+		mcp.WithString("confirmName",
+			mcp.Required(),
+			mcp.Description("The name of the Postgres instance, to confirm that it is the one to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			confirmName, err := validate.RequiredToolParam[string](request, "confirmName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.DeletePostgres(ctx, postgresId, confirmName); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Postgres instance %s has been deleted", postgresId)), nil
+		}
+}
+
+func getPostgresRecoveryInfo(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_postgres_recovery_info",
+		mcp.WithDescription("Retrieve point-in-time recovery information for a Postgres instance. "+
+			"The response includes whether recovery is available and startsAt, the earliest time the database can be restored to. "+
+			"Any time between startsAt and now can be passed to the recover_postgres tool."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get Postgres recovery info",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			info, err := postgresRepo.GetPostgresRecoveryInfo(ctx, postgresId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(info)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func recoverPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("recover_postgres",
+		mcp.WithDescription("Create a new Postgres instance from the state of an existing one at a point in time. "+
+			"The existing database is left unchanged. "+
+			"Use the get_postgres_recovery_info tool to find the earliest time the database can be restored to."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Recover Postgres instance",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to recover"),
+		),
+		mcp.WithString("restoreTime",
+			mcp.Required(),
+			mcp.Description("The point in time to restore the database to (RFC3339 format). "+
+				"Must be within the recovery window reported by the get_postgres_recovery_info tool."),
+		),
+		mcp.WithString("restoreName",
+			mcp.Description("The name of the new database. Defaults to a name based on the existing database."),
+		),
+		mcp.WithString("plan",
+			mcp.Description("Pricing plan for the new database. Defaults to the plan of the existing database, and can't be a lower tier."),
+			mcp.Enum(mcpserver.PostgresPlanEnumValues()...),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			restoreTimeStr, err := validate.RequiredToolParam[string](request, "restoreTime")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			restoreTime, err := time.Parse(time.RFC3339, restoreTimeStr)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := client.RecoverPostgresJSONRequestBody{
+				RestoreTime: restoreTime,
+			}
+
+			if restoreName, ok, err := validate.OptionalToolParam[string](request, "restoreName"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				input.RestoreName = &restoreName
+			}
+
+			if plan, ok, err := validate.OptionalToolParam[string](request, "plan"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				postgresPlan, err := validate.PostgresPlan(plan)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.Plan = pointers.From(string(postgresPlan))
+			}
+
+			postgres, err := postgresRepo.RecoverPostgres(ctx, postgresId, input)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(postgres)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func listPostgresExports(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_postgres_exports",
+		mcp.WithDescription("List the exports of a Postgres instance. "+
+			"Each export includes a URL the export can be downloaded from once it is complete."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List Postgres exports",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			exports, err := postgresRepo.ListPostgresExports(ctx, postgresId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(exports) == 0 {
+				return mcp.NewToolResultText("No Postgres exports found"), nil
+			}
+
+			respJSON, err := json.Marshal(exports)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createPostgresExport(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_postgres_export",
+		mcp.WithDescription("Start an export of a Postgres instance. "+
+			"Exports run in the background. Use the list_postgres_exports tool to find the export and its download URL."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Create Postgres export",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance to export"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := postgresRepo.CreatePostgresExport(ctx, postgresId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Export of Postgres instance %s has been started", postgresId)), nil
+		}
+}
+
+func queryPostgres(postgresRepo *Repo, pools *PoolCache, guardrails queryGuardrails) (*mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("query_render_postgres",
 		mcp.WithDescription("Run a read-only SQL query against a Render-hosted Postgres database. "+
-			"This tool creates a new connection for each query and closes it after the query completes."),
+			"Connections are pooled and reused by later queries to the same database."),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
 			Title:          "Query Postgres",
 			ReadOnlyHint:   pointers.From(true),
@@ -209,7 +602,22 @@ func queryPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 		),
 		mcp.WithString("sql",
 			mcp.Required(),
-			mcp.Description("The SQL query to run. Note that the query will be wrapped in a read-only transaction."),
+			mcp.Description("The SQL query to run. Note that the query will be wrapped in a read-only transaction. "+
+				"Only a single SELECT, WITH, VALUES, TABLE, EXPLAIN or SHOW statement can be run, and it is canceled if it runs longer than the statement timeout."),
+		),
+		mcp.WithNumber("maxRows",
+			mcp.Description("Maximum number of rows to return. If the query returns more rows, the result is marked as truncated."),
+			mcp.DefaultNumber(defaultQueryMaxRows),
+			mcp.Min(1),
+			mcp.Max(maxQueryMaxRows),
+		),
+		mcp.WithString("format",
+			mcp.Description("The format of the results. "+
+				"json returns the columns with their Postgres types and the rows as arrays of values in column order. "+
+				"Numerics are returned as strings to keep their precision. "+
+				"csv and markdown return the rows as a CSV document or a markdown table."),
+			mcp.Enum(queryFormatJSON, queryFormatCSV, queryFormatMarkdown),
+			mcp.DefaultString(queryFormatJSON),
 		),
 	)
 	return &tool,
@@ -224,79 +632,248 @@ func queryPostgres(postgresRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			connectionInfo, err := postgresRepo.GetPostgresConnectionInfo(ctx, postgresId)
+			maxRows := defaultQueryMaxRows
+			if maxRowsParam, ok, err := validate.OptionalToolParam[float64](request, "maxRows"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if maxRowsParam < 1 || maxRowsParam > maxQueryMaxRows || maxRowsParam != math.Trunc(maxRowsParam) {
+					return mcp.NewToolResultError(fmt.Sprintf("maxRows must be a whole number between 1 and %d", maxQueryMaxRows)), nil
+				}
+				maxRows = int(maxRowsParam)
+			}
+
+			format := queryFormatJSON
+			if formatParam, ok, err := validate.OptionalToolParam[string](request, "format"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				switch formatParam {
+				case queryFormatJSON, queryFormatCSV, queryFormatMarkdown:
+					format = formatParam
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("unsupported format %q", formatParam)), nil
+				}
+			}
+
+			if err := validateQuery(sqlQuery); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			tx, done, err := beginReadOnlyTx(ctx, pools, postgresRepo, postgresId, guardrails)
+			if err != nil {
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
+			}
+			defer done()
+
+			if err := guardrails.checkCost(ctx, tx, sqlQuery); err != nil {
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
+			}
+
+			rows, err := tx.Query(ctx, sqlQuery)
+			if err != nil {
+				return mcp.NewToolResultError("Error executing query: " + guardrails.queryError(err)), nil
+			}
+
+			result, err := readQueryResult(rows, tx.Conn().TypeMap(), maxRows)
+			if err != nil {
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
+			}
+
+			formatted, err := formatQueryResult(result, format)
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("Error formatting results", err), nil
+			}
+
+			toolResult := mcp.NewToolResultText(formatted)
+			if result.Truncated && format != queryFormatJSON {
+				toolResult.Content = append(toolResult.Content, mcp.NewTextContent(
+					fmt.Sprintf("Results were truncated to the first %d rows. Increase maxRows or refine the query to see more.", maxRows)))
+			}
+
+			return toolResult, nil
+		}
+}
+
+func listPostgresSchemas(postgresRepo *Repo, pools *PoolCache, guardrails queryGuardrails) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_postgres_schemas",
+		mcp.WithDescription("List the schemas in a Render-hosted Postgres database, with their tables, views and materialized views. "+
+			"Each table includes the planner's row estimate and its total size in bytes, including indexes and TOAST data. "+
+			"System schemas are left out."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List Postgres schemas",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance"),
+		),
+		mcp.WithString("schema",
+			mcp.Description("Only list this schema"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var schema *string
+			if schemaParam, ok, err := validate.OptionalToolParam[string](request, "schema"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				schema = &schemaParam
+			}
+
+			tx, done, err := beginReadOnlyTx(ctx, pools, postgresRepo, postgresId, guardrails)
+			if err != nil {
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
+			}
+			defer done()
+
+			schemas, err := listSchemas(ctx, tx, schema)
+			if err != nil {
+				return mcp.NewToolResultError("Error listing schemas: " + guardrails.queryError(err)), nil
+			}
+			if schema != nil && len(schemas) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("schema %s not found", *schema)), nil
+			}
+
+			respJSON, err := json.Marshal(schemas)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func describePostgresTable(postgresRepo *Repo, pools *PoolCache, guardrails queryGuardrails) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("describe_postgres_table",
+		mcp.WithDescription("Describe a table or view in a Render-hosted Postgres database. "+
+			"Returns its columns with their types, nullability and defaults, the primary key, foreign keys, unique constraints and indexes, "+
+			"the planner's row estimate, and the size of the table and its indexes in bytes."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Describe Postgres table",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance"),
+		),
+		mcp.WithString("table",
+			mcp.Required(),
+			mcp.Description("The name of the table. It can be qualified with its schema, like analytics.events."),
+		),
+		mcp.WithString("schema",
+			mcp.Description("The schema of the table. Defaults to public unless the table name is qualified with its schema."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			tableParam, err := validate.RequiredToolParam[string](request, "table")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			schemaParam, _, err := validate.OptionalToolParam[string](request, "schema")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			config, err := pgx.ParseConfig(connectionInfo.ExternalConnectionString)
+			schema, table := splitTableName(schemaParam, tableParam)
+
+			tx, done, err := beginReadOnlyTx(ctx, pools, postgresRepo, postgresId, guardrails)
 			if err != nil {
-				return mcp.NewToolResultErrorFromErr("Error parsing connection string", err), nil
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
 			}
-			conn, err := pgx.ConnectConfig(ctx, config)
+			defer done()
+
+			description, err := describeTable(ctx, tx, schema, table)
 			if err != nil {
-				return mcp.NewToolResultErrorFromErr("Error connecting to database", err), nil
+				return mcp.NewToolResultError("Error describing table: " + guardrails.queryError(err)), nil
 			}
-			defer conn.Close(ctx)
 
-			// Wrap all queries in a READ ONLY transaction
-			tx, err := conn.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+			respJSON, err := json.Marshal(description)
 			if err != nil {
-				return mcp.NewToolResultErrorFromErr("Error beginning transaction", err), nil
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Make sure we roll back the transaction if it's not committed successfully
-			defer func() {
-				_ = tx.Rollback(ctx) // Ignore error from rollback, as it might already be committed/rolled back
-			}()
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
 
-			rows, err := tx.Query(ctx, sqlQuery)
+func listPostgresIndexes(postgresRepo *Repo, pools *PoolCache, guardrails queryGuardrails) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_postgres_indexes",
+		mcp.WithDescription("List the indexes in a Render-hosted Postgres database, optionally only those of one schema or table. "+
+			"Each index includes its definition, whether it is unique or the primary key, its size in bytes, "+
+			"and how many times it has been scanned since statistics were last reset."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List Postgres indexes",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("postgresId",
+			mcp.Required(),
+			mcp.Description("The ID of the Postgres instance"),
+		),
+		mcp.WithString("schema",
+			mcp.Description("Only list indexes in this schema"),
+		),
+		mcp.WithString("table",
+			mcp.Description("Only list indexes on this table. It can be qualified with its schema, like analytics.events."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			postgresId, err := validate.RequiredToolParam[string](request, "postgresId")
 			if err != nil {
-				return mcp.NewToolResultErrorFromErr("Error executing query", err), nil
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-			defer rows.Close()
 
-			// Get field descriptions
-			fieldDescriptions := rows.FieldDescriptions()
-			columnNames := make([]string, len(fieldDescriptions))
-			for i, fd := range fieldDescriptions {
-				columnNames[i] = string(fd.Name)
+			schemaParam, hasSchema, err := validate.OptionalToolParam[string](request, "schema")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			results := []map[string]interface{}{}
+			tableParam, hasTable, err := validate.OptionalToolParam[string](request, "table")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-			for rows.Next() {
-				values, err := rows.Values()
-				if err != nil {
-					return mcp.NewToolResultErrorFromErr("Error reading row values", err), nil
-				}
+			var schema, table *string
+			if hasTable {
+				schemaName, tableName := splitTableName(schemaParam, tableParam)
+				schema, table = &schemaName, &tableName
+			} else if hasSchema {
+				schema = &schemaParam
+			}
 
-				rowMap := make(map[string]interface{})
-				for i, col := range columnNames {
-					// Handle pgx types appropriately
-					val := values[i]
-					switch v := val.(type) {
-					case []byte:
-						// Convert byte arrays to string
-						rowMap[col] = string(v)
-					default:
-						// For other types, use as-is
-						rowMap[col] = v
-					}
-				}
-				results = append(results, rowMap)
+			tx, done, err := beginReadOnlyTx(ctx, pools, postgresRepo, postgresId, guardrails)
+			if err != nil {
+				return mcp.NewToolResultError(guardrails.queryError(err)), nil
 			}
+			defer done()
 
-			// Check for any errors encountered during iteration
-			if err := rows.Err(); err != nil {
-				return mcp.NewToolResultErrorFromErr("Error iterating rows", err), nil
+			indexes, err := listIndexes(ctx, tx, schema, table)
+			if err != nil {
+				return mcp.NewToolResultError("Error listing indexes: " + guardrails.queryError(err)), nil
 			}
 
-			respJSON, err := json.Marshal(results)
+			respJSON, err := json.Marshal(indexes)
 			if err != nil {
-				return mcp.NewToolResultErrorFromErr("Error marshaling results", err), nil
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			return mcp.NewToolResultText(string(respJSON)), nil
 		}
-}
\ No newline at end of file
+}
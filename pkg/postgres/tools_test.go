@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	pgclient "github.com/render-oss/render-mcp-server/pkg/client/postgres"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletePostgresTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		confirmName   string
+		ownerId       string
+		expectedError string
+	}{
+		{
+			name:        "Deletes the database when the name is confirmed",
+			confirmName: "my-db",
+			ownerId:     "own-123",
+		},
+		{
+			name:          "Rejects a confirmation name that doesn't match",
+			confirmName:   "other-db",
+			ownerId:       "own-123",
+			expectedError: `confirmation name "other-db" does not match`,
+		},
+		{
+			name:          "Rejects databases outside the current workspace",
+			confirmName:   "my-db",
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", tt.ownerId), nil)
+			fakeClient.DeletePostgresWithResponseReturns(&client.DeletePostgresResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"postgresId":  "dpg-123",
+				"confirmName": tt.confirmName,
+			}
+
+			_, handler := deletePostgres(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.DeletePostgresWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, 1, fakeClient.DeletePostgresWithResponseCallCount())
+			_, postgresId, _ := fakeClient.DeletePostgresWithResponseArgsForCall(0)
+			assert.Equal(t, "dpg-123", postgresId)
+		})
+	}
+}
+
+func TestPostgresLifecycleTools(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      func(*Repo) (*mcp.Tool, server.ToolHandlerFunc)
+		callCount func(*fakes.FakePostgresRepoClient) int
+	}{
+		{
+			name:      "restart_postgres",
+			tool:      restartPostgres,
+			callCount: (*fakes.FakePostgresRepoClient).RestartPostgresWithResponseCallCount,
+		},
+		{
+			name:      "suspend_postgres",
+			tool:      suspendPostgres,
+			callCount: (*fakes.FakePostgresRepoClient).SuspendPostgresWithResponseCallCount,
+		},
+		{
+			name:      "resume_postgres",
+			tool:      resumePostgres,
+			callCount: (*fakes.FakePostgresRepoClient).ResumePostgresWithResponseCallCount,
+		},
+		{
+			name:      "failover_postgres",
+			tool:      failoverPostgres,
+			callCount: (*fakes.FakePostgresRepoClient).FailoverPostgresWithResponseCallCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123"}
+
+			fakeClient := &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", "own-123"), nil)
+			fakeClient.RestartPostgresWithResponseReturns(&client.RestartPostgresResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+			fakeClient.SuspendPostgresWithResponseReturns(&client.SuspendPostgresResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+			fakeClient.ResumePostgresWithResponseReturns(&client.ResumePostgresResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+			fakeClient.FailoverPostgresWithResponseReturns(&client.FailoverPostgresResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+
+			_, handler := tt.tool(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+			assert.Equal(t, 1, tt.callCount(fakeClient))
+
+			// A database in another workspace is never acted on.
+			fakeClient = &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", "own-other"), nil)
+
+			_, handler = tt.tool(NewRepo(fakeClient))
+			result, err = handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Equal(t, 0, tt.callCount(fakeClient))
+		})
+	}
+}
+
+func TestPostgresReadToolsCheckWorkspace(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      func(*Repo) (*mcp.Tool, server.ToolHandlerFunc)
+		callCount func(*fakes.FakePostgresRepoClient) int
+	}{
+		{
+			name:      "get_postgres_recovery_info",
+			tool:      getPostgresRecoveryInfo,
+			callCount: (*fakes.FakePostgresRepoClient).RetrievePostgresRecoveryInfoWithResponseCallCount,
+		},
+		{
+			name:      "list_postgres_exports",
+			tool:      listPostgresExports,
+			callCount: (*fakes.FakePostgresRepoClient).ListPostgresExportWithResponseCallCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"postgresId": "dpg-123"}
+
+			fakeClient := &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", "own-123"), nil)
+			fakeClient.RetrievePostgresRecoveryInfoWithResponseReturns(&client.RetrievePostgresRecoveryInfoResponse{
+				JSON200:      &pgclient.RecoveryInfo{RecoveryStatus: pgclient.AVAILABLE},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.ListPostgresExportWithResponseReturns(&client.ListPostgresExportResponse{
+				JSON200:      &[]pgclient.PostgresExport{},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			_, handler := tt.tool(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+			assert.Equal(t, 1, tt.callCount(fakeClient))
+
+			// A database in another workspace is never read.
+			fakeClient = &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", "own-other"), nil)
+
+			_, handler = tt.tool(NewRepo(fakeClient))
+			result, err = handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Equal(t, 0, tt.callCount(fakeClient))
+		})
+	}
+}
+
+func TestQueryPostgresToolValidatesMaxRows(t *testing.T) {
+	for _, maxRows := range []float64{0, 2.7, maxQueryMaxRows + 1} {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{
+			"postgresId": "dpg-123",
+			"sql":        "SELECT 1",
+			"maxRows":    maxRows,
+		}
+
+		pools := newPoolCache(1, time.Minute, time.Minute, time.Now)
+		defer pools.Close()
+
+		fakeClient := &fakes.FakePostgresRepoClient{}
+		_, handler := queryPostgres(NewRepo(fakeClient), pools, queryGuardrails{})
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		assert.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, fmt.Sprintf("maxRows must be a whole number between 1 and %d", maxQueryMaxRows), result.Content[0].(mcp.TextContent).Text)
+		assert.Equal(t, 0, fakeClient.RetrievePostgresWithResponseCallCount())
+	}
+}
+
+func postgresResponse(id, name, ownerId string) *client.RetrievePostgresResponse {
+	return &client.RetrievePostgresResponse{
+		JSON200: &client.PostgresDetail{
+			Id:    id,
+			Name:  name,
+			Owner: client.Owner{Id: ownerId},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}
+}
+
+func TestRecoverPostgresTool(t *testing.T) {
+	windowStart := time.Now().Add(-72 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		restoreTime    time.Time
+		recoveryStatus pgclient.RecoveryInfoRecoveryStatus
+		expectedError  string
+	}{
+		{
+			name:           "Recovers to a time inside the recovery window",
+			restoreTime:    windowStart.Add(time.Hour),
+			recoveryStatus: pgclient.AVAILABLE,
+		},
+		{
+			name:           "Rejects a time before the recovery window",
+			restoreTime:    windowStart.Add(-time.Hour),
+			recoveryStatus: pgclient.AVAILABLE,
+			expectedError:  "is before the earliest available restore time",
+		},
+		{
+			name:           "Rejects a time in the future",
+			restoreTime:    time.Now().Add(time.Hour),
+			recoveryStatus: pgclient.AVAILABLE,
+			expectedError:  "is in the future",
+		},
+		{
+			name:           "Rejects recovery before the first backup is ready",
+			restoreTime:    windowStart.Add(time.Hour),
+			recoveryStatus: pgclient.BACKUPNOTREADY,
+			expectedError:  "first backup is not ready",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakePostgresRepoClient{}
+			fakeClient.RetrievePostgresWithResponseReturns(postgresResponse("dpg-123", "my-db", "own-123"), nil)
+			fakeClient.RetrievePostgresRecoveryInfoWithResponseReturns(&client.RetrievePostgresRecoveryInfoResponse{
+				JSON200: &pgclient.RecoveryInfo{
+					RecoveryStatus: tt.recoveryStatus,
+					StartsAt:       &windowStart,
+				},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.RecoverPostgresWithResponseReturns(&client.RecoverPostgresResponse{
+				JSON200:      &client.PostgresDetail{Id: "dpg-456", Name: "my-db-restored"},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"postgresId":  "dpg-123",
+				"restoreTime": tt.restoreTime.Format(time.RFC3339),
+				"restoreName": "my-db-restored",
+				"plan":        "pro_4gb",
+			}
+
+			_, handler := recoverPostgres(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.RecoverPostgresWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "dpg-456")
+			_, postgresId, body, _ := fakeClient.RecoverPostgresWithResponseArgsForCall(0)
+			assert.Equal(t, "dpg-123", postgresId)
+			assert.Equal(t, client.RecoverPostgresJSONRequestBody{
+				RestoreTime: tt.restoreTime,
+				RestoreName: pointers.From("my-db-restored"),
+				Plan:        pointers.From("pro_4gb"),
+			}, body)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package registrycredential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+)
+
+//go:generate go tool counterfeiter -o ../fakes/fakeregistrycredentialrepoclient_gen.go . registryCredentialRepoClient
+type registryCredentialRepoClient interface {
+	ListRegistryCredentialsWithResponse(ctx context.Context, params *client.ListRegistryCredentialsParams, reqEditors ...client.RequestEditorFn) (*client.ListRegistryCredentialsResponse, error)
+	CreateRegistryCredentialWithResponse(ctx context.Context, body client.CreateRegistryCredentialJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateRegistryCredentialResponse, error)
+	DeleteRegistryCredentialWithResponse(ctx context.Context, registryCredentialId string, reqEditors ...client.RequestEditorFn) (*client.DeleteRegistryCredentialResponse, error)
+}
+
+type Repo struct {
+	client registryCredentialRepoClient
+}
+
+func NewRepo(c registryCredentialRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+// ListRegistryCredentials returns the registry credentials of the current workspace. Credentials
+// never include their auth token.
+func (r *Repo) ListRegistryCredentials(ctx context.Context, params *client.ListRegistryCredentialsParams) ([]client.RegistryCredential, error) {
+	workspace, err := session.FromContext(ctx).GetWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params.OwnerId = &client.OwnerIdParam{workspace}
+
+	credentials, err := client.ListAll(ctx, params, r.listPage)
+	if err != nil {
+		return nil, err
+	}
+	if credentials == nil {
+		return []client.RegistryCredential{}, nil
+	}
+
+	return credentials, nil
+}
+
+// listPage lists a page of registry credentials. Credentials aren't wrapped with a cursor like the
+// results of other list endpoints, so the ID of the last credential on a page is the cursor for the
+// next page.
+func (r *Repo) listPage(ctx context.Context, params *client.ListRegistryCredentialsParams) ([]client.RegistryCredential, *client.Cursor, error) {
+	resp, err := r.client.ListRegistryCredentialsWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	credentials := *resp.JSON200
+	cursor := credentials[len(credentials)-1].Id
+	// Stop rather than list the same page forever if the API doesn't move past the cursor.
+	if params.Cursor != nil && *params.Cursor == cursor {
+		return nil, nil, nil
+	}
+
+	return credentials, &cursor, nil
+}
+
+// GetRegistryCredential returns a registry credential of the current workspace. Credentials don't
+// include their owner, so it's looked up among the workspace's credentials instead of retrieved by ID.
+func (r *Repo) GetRegistryCredential(ctx context.Context, registryCredentialId string) (*client.RegistryCredential, error) {
+	credentials, err := r.ListRegistryCredentials(ctx, &client.ListRegistryCredentialsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credential := range credentials {
+		if credential.Id == registryCredentialId {
+			return &credential, nil
+		}
+	}
+
+	return nil, fmt.Errorf("registry credential %s not found in the current workspace", registryCredentialId)
+}
+
+// CreateRegistryCredential stores a credential for the current workspace. The auth token is sent to
+// the API and never returned, even in an error.
+func (r *Repo) CreateRegistryCredential(ctx context.Context, body client.CreateRegistryCredentialJSONRequestBody) (*client.RegistryCredential, error) {
+	resp, err := r.client.CreateRegistryCredentialWithResponse(ctx, body)
+	if err != nil {
+		return nil, redactToken(err, body.AuthToken)
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, redactToken(err, body.AuthToken)
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteRegistryCredential(ctx context.Context, registryCredentialId string) error {
+	// Skip validation of the credential belonging to the workspace because it should be done before the
+	// call to DeleteRegistryCredential.
+	resp, err := r.client.DeleteRegistryCredentialWithResponse(ctx, registryCredentialId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func redactToken(err error, token string) error {
+	if token == "" || !strings.Contains(err.Error(), token) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), token, "[REDACTED]"))
+}
@@ -0,0 +1,197 @@
+package registrycredential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	registryCredentialRepo := NewRepo(c)
+
+	tool, handler := listRegistryCredentials(registryCredentialRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createRegistryCredential(registryCredentialRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteRegistryCredential(registryCredentialRepo)
+	s.AddTool(*tool, handler)
+}
+
+var registryEnumValues = mcpserver.EnumValuesFromClientType(client.DOCKER, client.GITHUB, client.GITLAB, client.AWSECR, client.GOOGLEARTIFACT)
+
+func listRegistryCredentials(registryCredentialRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_registry_credentials",
+		mcp.WithDescription("List the container registry credentials in your Render workspace. "+
+			"Services use a credential to pull private images, by passing its ID as 'registryCredentialId' when they are created. "+
+			"Auth tokens are never included in the results."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List registry credentials",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("registry",
+			mcp.Description("Only list credentials for this registry"),
+			mcp.Enum(registryEnumValues...),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			params := &client.ListRegistryCredentialsParams{}
+			if registry, ok, err := validate.OptionalToolParam[string](request, "registry"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				params.Type = &[]client.RegistryCredentialRegistry{client.RegistryCredentialRegistry(registry)}
+			}
+
+			credentials, err := registryCredentialRepo.ListRegistryCredentials(ctx, params)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(credentials)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createRegistryCredential(registryCredentialRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_registry_credential",
+		mcp.WithDescription("Store a credential that Render uses to pull private images from a container registry. "+
+			"The auth token is write-only: it is sent to Render but never returned by this or any other tool, so it can't be read back later. "+
+			"The result includes the credential's ID, to pass as 'registryCredentialId' when creating a service."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Create registry credential",
+			ReadOnlyHint:   pointers.From(false),
+			IdempotentHint: pointers.From(false),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("A descriptive name for the credential"),
+		),
+		mcp.WithString("registry",
+			mcp.Required(),
+			mcp.Description("The registry the credential is for"),
+			mcp.Enum(registryEnumValues...),
+		),
+		mcp.WithString("username",
+			mcp.Required(),
+			mcp.Description("The username to sign in to the registry with"),
+		),
+		mcp.WithString("authToken",
+			mcp.Required(),
+			mcp.Description("A personal access token or password for the registry. It only needs permission to read images."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			registry, err := validate.RequiredToolParam[string](request, "registry")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			username, err := validate.RequiredToolParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			authToken, err := validate.RequiredToolParam[string](request, "authToken")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if authToken == "" {
+				return mcp.NewToolResultError("authToken must not be empty"), nil
+			}
+
+			ownerId, err := session.FromContext(ctx).GetWorkspace(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			credential, err := registryCredentialRepo.CreateRegistryCredential(ctx, client.CreateRegistryCredentialJSONRequestBody{
+				Name:      name,
+				OwnerId:   ownerId,
+				Registry:  client.RegistryCredentialRegistry(registry),
+				Username:  username,
+				AuthToken: authToken,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(credential)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteRegistryCredential(registryCredentialRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_registry_credential",
+		mcp.WithDescription("Permanently delete a container registry credential. "+
+			"Services that use it can no longer pull private images with it. "+
+			"To confirm the deletion, confirmName must exactly match the name of the credential, which you can look up with the list_registry_credentials tool. "+
+			"Always ask the user to confirm before deleting a credential."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete registry credential",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("registryCredentialId",
+			mcp.Required(),
+			mcp.Description("The ID of the registry credential to delete"),
+		),
+		mcp.WithString("confirmName",
+			mcp.Required(),
+			mcp.Description("The name of the credential, to confirm that it is the one to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			registryCredentialId, err := validate.RequiredToolParam[string](request, "registryCredentialId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			confirmName, err := validate.RequiredToolParam[string](request, "confirmName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			credential, err := registryCredentialRepo.GetRegistryCredential(ctx, registryCredentialId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if confirmName != credential.Name {
+				return mcp.NewToolResultError(fmt.Sprintf("confirmation name %q does not match the name of registry credential %s", confirmName, registryCredentialId)), nil
+			}
+
+			if err := registryCredentialRepo.DeleteRegistryCredential(ctx, registryCredentialId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Registry credential %s has been deleted", registryCredentialId)), nil
+		}
+}
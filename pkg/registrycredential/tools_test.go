@@ -0,0 +1,181 @@
+package registrycredential
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const authToken = "ghp_supersecrettoken"
+
+func TestListRegistryCredentialsTool(t *testing.T) {
+	fakeClient := &fakes.FakeRegistryCredentialRepoClient{}
+	fakeClient.ListRegistryCredentialsWithResponseReturns(&client.ListRegistryCredentialsResponse{
+		JSON200: &[]client.RegistryCredential{
+			{Id: "rgc-123", Name: "ghcr", Registry: client.GITHUB, Username: "acme-bot"},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := listRegistryCredentials(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"registry": "GITHUB",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"id":"rgc-123"`)
+
+	_, params, _ := fakeClient.ListRegistryCredentialsWithResponseArgsForCall(0)
+	assert.Equal(t, &client.OwnerIdParam{"own-123"}, params.OwnerId)
+	assert.Equal(t, &[]client.RegistryCredentialRegistry{client.GITHUB}, params.Type)
+}
+
+func TestCreateRegistryCredentialTool(t *testing.T) {
+	arguments := map[string]interface{}{
+		"name":      "ghcr",
+		"registry":  "GITHUB",
+		"username":  "acme-bot",
+		"authToken": authToken,
+	}
+
+	t.Run("Creates a credential without echoing the token", func(t *testing.T) {
+		fakeClient := &fakes.FakeRegistryCredentialRepoClient{}
+		fakeClient.CreateRegistryCredentialWithResponseReturns(&client.CreateRegistryCredentialResponse{
+			JSON200:      &client.RegistryCredential{Id: "rgc-123", Name: "ghcr", Registry: client.GITHUB, Username: "acme-bot"},
+			HTTPResponse: &http.Response{StatusCode: 200},
+		}, nil)
+
+		_, handler := createRegistryCredential(NewRepo(fakeClient))
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"id":"rgc-123"`)
+		assert.NotContains(t, result.Content[0].(mcp.TextContent).Text, authToken)
+
+		_, body, _ := fakeClient.CreateRegistryCredentialWithResponseArgsForCall(0)
+		assert.Equal(t, client.CreateRegistryCredentialJSONRequestBody{
+			Name:      "ghcr",
+			OwnerId:   "own-123",
+			Registry:  client.GITHUB,
+			Username:  "acme-bot",
+			AuthToken: authToken,
+		}, body)
+	})
+
+	t.Run("Redacts the token from errors", func(t *testing.T) {
+		fakeClient := &fakes.FakeRegistryCredentialRepoClient{}
+		fakeClient.CreateRegistryCredentialWithResponseReturns(nil, errors.New("invalid credentials for acme-bot:"+authToken))
+
+		_, handler := createRegistryCredential(NewRepo(fakeClient))
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, "invalid credentials for acme-bot:[REDACTED]", result.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func TestDeleteRegistryCredentialTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:      "Deletes a credential when the name is confirmed",
+			arguments: map[string]interface{}{"registryCredentialId": "rgc-123", "confirmName": "ghcr"},
+		},
+		{
+			name:          "Does not delete a credential when the name doesn't match",
+			arguments:     map[string]interface{}{"registryCredentialId": "rgc-123", "confirmName": "dockerhub"},
+			expectedError: `confirmation name "dockerhub" does not match the name of registry credential rgc-123`,
+		},
+		{
+			name:          "Does not delete a credential outside the workspace",
+			arguments:     map[string]interface{}{"registryCredentialId": "rgc-other", "confirmName": "ghcr"},
+			expectedError: "registry credential rgc-other not found in the current workspace",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeRegistryCredentialRepoClient{}
+			fakeClient.ListRegistryCredentialsWithResponseReturns(&client.ListRegistryCredentialsResponse{
+				JSON200:      &[]client.RegistryCredential{{Id: "rgc-123", Name: "ghcr"}},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.DeleteRegistryCredentialWithResponseReturns(&client.DeleteRegistryCredentialResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			_, handler := deleteRegistryCredential(NewRepo(fakeClient))
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tt.expectedError, result.Content[0].(mcp.TextContent).Text)
+				assert.Equal(t, 0, fakeClient.DeleteRegistryCredentialWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			require.Equal(t, 1, fakeClient.DeleteRegistryCredentialWithResponseCallCount())
+			_, registryCredentialId, _ := fakeClient.DeleteRegistryCredentialWithResponseArgsForCall(0)
+			assert.Equal(t, "rgc-123", registryCredentialId)
+		})
+	}
+}
+
+func TestDeleteRegistryCredentialToolFindsCredentialsOnLaterPages(t *testing.T) {
+	firstPage := make([]client.RegistryCredential, 100)
+	for i := range firstPage {
+		firstPage[i] = client.RegistryCredential{Id: fmt.Sprintf("rgc-%03d", i), Name: fmt.Sprintf("credential-%d", i)}
+	}
+	secondPage := []client.RegistryCredential{{Id: "rgc-123", Name: "ghcr"}}
+
+	fakeClient := &fakes.FakeRegistryCredentialRepoClient{}
+	fakeClient.ListRegistryCredentialsWithResponseReturnsOnCall(0, &client.ListRegistryCredentialsResponse{
+		JSON200:      &firstPage,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListRegistryCredentialsWithResponseReturnsOnCall(1, &client.ListRegistryCredentialsResponse{
+		JSON200:      &secondPage,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.DeleteRegistryCredentialWithResponseReturns(&client.DeleteRegistryCredentialResponse{
+		HTTPResponse: &http.Response{StatusCode: 204},
+	}, nil)
+
+	_, handler := deleteRegistryCredential(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"registryCredentialId": "rgc-123", "confirmName": "ghcr"}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	require.Equal(t, 2, fakeClient.ListRegistryCredentialsWithResponseCallCount())
+	_, params, _ := fakeClient.ListRegistryCredentialsWithResponseArgsForCall(1)
+	assert.Equal(t, "rgc-099", *params.Cursor)
+	assert.Equal(t, 1, fakeClient.DeleteRegistryCredentialWithResponseCallCount())
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	autoscalingtypes "github.com/render-oss/render-mcp-server/pkg/client/autoscaling"
+	metricstypes "github.com/render-oss/render-mcp-server/pkg/client/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
+)
+
+const (
+	minAutoscalingInstances        = 1
+	maxAutoscalingInstances        = 100
+	minAutoscalingTargetPercentage = 1
+	maxAutoscalingTargetPercentage = 90
+
+	// utilizationWindow is how far back autoscaling tools look when comparing a service's
+	// utilization with its targets.
+	utilizationWindow = time.Hour
+)
+
+// AutoscalingStatus is the autoscaling configuration of a service, along with how its recent CPU
+// and memory utilization compare with the targets. Autoscaling is nil for services that don't
+// autoscale. Metrics are best effort, so UtilizationError is set instead of failing the tool when
+// they can't be fetched.
+type AutoscalingStatus struct {
+	ServiceId        string                              `json:"serviceId"`
+	Autoscaling      *autoscalingtypes.AutoscalingConfig `json:"autoscaling"`
+	NumInstances     *int                                `json:"numInstances,omitempty"`
+	Utilization      []ResourceUtilization               `json:"utilization,omitempty"`
+	UtilizationError string                              `json:"utilizationError,omitempty"`
+}
+
+// ResourceUtilization compares the usage of a resource over the utilization window with its limit
+// and autoscaling target. Usage, limit and target are in Unit, and percentages are of the limit.
+type ResourceUtilization struct {
+	Resource                     string   `json:"resource"`
+	Unit                         string   `json:"unit,omitempty"`
+	TargetPercentage             *int     `json:"targetPercentage,omitempty"`
+	Target                       *float64 `json:"target,omitempty"`
+	Limit                        *float64 `json:"limit,omitempty"`
+	AverageUsage                 *float64 `json:"averageUsage,omitempty"`
+	PeakUsage                    *float64 `json:"peakUsage,omitempty"`
+	AverageUtilizationPercentage *float64 `json:"averageUtilizationPercentage,omitempty"`
+	PeakUtilizationPercentage    *float64 `json:"peakUtilizationPercentage,omitempty"`
+}
+
+// serviceScaling returns the autoscaling configuration and instance count of a service. Only web
+// services, private services and background workers can scale.
+func serviceScaling(service *client.Service) (*autoscalingtypes.AutoscalingConfig, int, error) {
+	switch service.Type {
+	case client.WebService:
+		details, err := service.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return nil, 0, err
+		}
+		return details.Autoscaling, details.NumInstances, nil
+	case client.PrivateService:
+		details, err := service.ServiceDetails.AsPrivateServiceDetails()
+		if err != nil {
+			return nil, 0, err
+		}
+		return details.Autoscaling, details.NumInstances, nil
+	case client.BackgroundWorker:
+		details, err := service.ServiceDetails.AsBackgroundWorkerDetails()
+		if err != nil {
+			return nil, 0, err
+		}
+		return details.Autoscaling, details.NumInstances, nil
+	default:
+		return nil, 0, fmt.Errorf("autoscaling is only available for web services, private services and background workers, not %s", service.Type)
+	}
+}
+
+func validateAutoscalingConfig(config autoscalingtypes.AutoscalingConfig) error {
+	if config.Min < minAutoscalingInstances {
+		return fmt.Errorf("minInstances must be at least %d", minAutoscalingInstances)
+	}
+	if config.Max > maxAutoscalingInstances {
+		return fmt.Errorf("maxInstances can't be more than %d", maxAutoscalingInstances)
+	}
+	if config.Min > config.Max {
+		return fmt.Errorf("minInstances (%d) can't be more than maxInstances (%d)", config.Min, config.Max)
+	}
+
+	if !config.Criteria.Cpu.Enabled && !config.Criteria.Memory.Enabled {
+		return fmt.Errorf("at least one of cpuTargetPercentage or memoryTargetPercentage is required")
+	}
+	targets := []struct {
+		name     string
+		criteria autoscalingtypes.AutoscalingCriteriaPercentage
+	}{
+		{"cpuTargetPercentage", config.Criteria.Cpu},
+		{"memoryTargetPercentage", config.Criteria.Memory},
+	}
+	for _, target := range targets {
+		name, criteria := target.name, target.criteria
+		if criteria.Enabled && (criteria.Percentage < minAutoscalingTargetPercentage || criteria.Percentage > maxAutoscalingTargetPercentage) {
+			return fmt.Errorf("%s must be between %d and %d", name, minAutoscalingTargetPercentage, maxAutoscalingTargetPercentage)
+		}
+	}
+
+	return nil
+}
+
+// autoscalingStatus builds the status of a service from its autoscaling configuration and its CPU
+// and memory metrics over the utilization window.
+func autoscalingStatus(ctx context.Context, metricsRepo *metrics.Repo, serviceId string, config *autoscalingtypes.AutoscalingConfig, numInstances int) *AutoscalingStatus {
+	status := &AutoscalingStatus{
+		ServiceId:   serviceId,
+		Autoscaling: config,
+	}
+	if config == nil || !config.Enabled {
+		status.NumInstances = &numInstances
+	}
+
+	end := time.Now()
+	start := end.Add(-utilizationWindow)
+	resp, err := metricsRepo.GetMetrics(ctx, metrics.MetricsRequest{
+		ResourceID: serviceId,
+		MetricTypes: []metrics.MetricType{
+			metrics.MetricTypeCPUUsage,
+			metrics.MetricTypeCPULimit,
+			metrics.MetricTypeCPUTarget,
+			metrics.MetricTypeMemoryUsage,
+			metrics.MetricTypeMemoryLimit,
+			metrics.MetricTypeMemoryTarget,
+		},
+		StartTime: &start,
+		EndTime:   &end,
+	})
+	if err != nil {
+		status.UtilizationError = err.Error()
+		return status
+	}
+
+	series := make(map[metrics.MetricType]metricstypes.TimeSeriesCollection, len(resp.Metrics))
+	for _, metric := range resp.Metrics {
+		series[metric.Type] = metric.Data
+	}
+
+	var cpuTarget, memoryTarget *int
+	if config != nil && config.Enabled {
+		if config.Criteria.Cpu.Enabled {
+			cpuTarget = &config.Criteria.Cpu.Percentage
+		}
+		if config.Criteria.Memory.Enabled {
+			memoryTarget = &config.Criteria.Memory.Percentage
+		}
+	}
+
+	status.Utilization = []ResourceUtilization{
+		resourceUtilization("cpu", series[metrics.MetricTypeCPUUsage], series[metrics.MetricTypeCPULimit], series[metrics.MetricTypeCPUTarget], cpuTarget),
+		resourceUtilization("memory", series[metrics.MetricTypeMemoryUsage], series[metrics.MetricTypeMemoryLimit], series[metrics.MetricTypeMemoryTarget], memoryTarget),
+	}
+	return status
+}
+
+func resourceUtilization(resource string, usage, limit, target metricstypes.TimeSeriesCollection, targetPercentage *int) ResourceUtilization {
+	utilization := ResourceUtilization{
+		Resource:         resource,
+		TargetPercentage: targetPercentage,
+		Limit:            latestValue(limit),
+		Target:           latestValue(target),
+	}
+
+	var sum, peak float64
+	var count int
+	for _, s := range usage {
+		if utilization.Unit == "" {
+			utilization.Unit = s.Unit
+		}
+		for _, v := range s.Values {
+			value := float64(v.Value)
+			sum += value
+			peak = max(peak, value)
+			count++
+		}
+	}
+	if count == 0 {
+		return utilization
+	}
+
+	average := sum / float64(count)
+	utilization.AverageUsage = metrics.RoundTo(average, 3)
+	utilization.PeakUsage = metrics.RoundTo(peak, 3)
+	if utilization.Limit != nil && *utilization.Limit > 0 {
+		utilization.AverageUtilizationPercentage = metrics.RoundTo(average / *utilization.Limit * 100, 1)
+		utilization.PeakUtilizationPercentage = metrics.RoundTo(peak / *utilization.Limit * 100, 1)
+	}
+	return utilization
+}
+
+// latestValue returns the most recent value across a collection of time series, or nil if there
+// are no values.
+func latestValue(collection metricstypes.TimeSeriesCollection) *float64 {
+	var latest *metricstypes.TimeSeriesValue
+	for _, s := range collection {
+		for i := range s.Values {
+			if latest == nil || s.Values[i].Timestamp.After(latest.Timestamp) {
+				latest = &s.Values[i]
+			}
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	return metrics.RoundTo(float64(latest.Value), 3)
+}
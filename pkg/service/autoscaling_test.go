@@ -0,0 +1,275 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	autoscalingtypes "github.com/render-oss/render-mcp-server/pkg/client/autoscaling"
+	metricstypes "github.com/render-oss/render-mcp-server/pkg/client/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAutoscalingConfig(t *testing.T) {
+	cpuTarget := autoscalingtypes.AutoscalingCriteria{
+		Cpu: autoscalingtypes.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 60},
+	}
+
+	tests := []struct {
+		name          string
+		config        autoscalingtypes.AutoscalingConfig
+		expectedError string
+	}{
+		{
+			name:   "Accepts a valid configuration",
+			config: autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 1, Max: 3, Criteria: cpuTarget},
+		},
+		{
+			name:          "Requires at least one instance",
+			config:        autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 0, Max: 3, Criteria: cpuTarget},
+			expectedError: "minInstances must be at least 1",
+		},
+		{
+			name:          "Requires min to be at most max",
+			config:        autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 4, Max: 3, Criteria: cpuTarget},
+			expectedError: "minInstances (4) can't be more than maxInstances (3)",
+		},
+		{
+			name:          "Limits the number of instances",
+			config:        autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 1, Max: 101, Criteria: cpuTarget},
+			expectedError: "maxInstances can't be more than 100",
+		},
+		{
+			name:          "Requires a target",
+			config:        autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 1, Max: 3},
+			expectedError: "at least one of cpuTargetPercentage or memoryTargetPercentage is required",
+		},
+		{
+			name: "Limits target percentages",
+			config: autoscalingtypes.AutoscalingConfig{Enabled: true, Min: 1, Max: 3, Criteria: autoscalingtypes.AutoscalingCriteria{
+				Memory: autoscalingtypes.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 95},
+			}},
+			expectedError: "memoryTargetPercentage must be between 1 and 90",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAutoscalingConfig(tt.config)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestResourceUtilization(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	collection := func(unit string, values ...float32) metricstypes.TimeSeriesCollection {
+		series := metricstypes.TimeSeries{Unit: unit}
+		for i, value := range values {
+			series.Values = append(series.Values, metricstypes.TimeSeriesValue{
+				Timestamp: now.Add(time.Duration(i) * time.Minute),
+				Value:     value,
+			})
+		}
+		return metricstypes.TimeSeriesCollection{series}
+	}
+
+	utilization := resourceUtilization("cpu",
+		collection("cpu", 0.2, 0.4),
+		collection("cpu", 0.5, 1),
+		collection("cpu", 0.6),
+		pointers.From(60),
+	)
+
+	assert.Equal(t, ResourceUtilization{
+		Resource:                     "cpu",
+		Unit:                         "cpu",
+		TargetPercentage:             pointers.From(60),
+		Target:                       pointers.From(0.6),
+		Limit:                        pointers.From(1.0),
+		AverageUsage:                 pointers.From(0.3),
+		PeakUsage:                    pointers.From(0.4),
+		AverageUtilizationPercentage: pointers.From(30.0),
+		PeakUtilizationPercentage:    pointers.From(40.0),
+	}, utilization)
+
+	// Without usage data, only the limit and target are known.
+	empty := resourceUtilization("memory", nil, collection("bytes", 512), nil, nil)
+	assert.Nil(t, empty.AverageUsage)
+	assert.Nil(t, empty.AverageUtilizationPercentage)
+	assert.Equal(t, pointers.From(512.0), empty.Limit)
+}
+
+func TestGetAutoscalingTool(t *testing.T) {
+	config := &autoscalingtypes.AutoscalingConfig{
+		Enabled: true,
+		Min:     1,
+		Max:     4,
+		Criteria: autoscalingtypes.AutoscalingCriteria{
+			Cpu: autoscalingtypes.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 60},
+		},
+	}
+
+	fakeClient := &fakes.FakeServiceRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200:      autoscaledWebService(t, config),
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	mockMetrics := &metrics.MockClientWithResponses{}
+	mockMetrics.On("GetCpuWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockCPUResponse(0.45), nil)
+	mockMetrics.On("GetCpuLimitWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockCPULimitResponse(0.5), nil)
+	mockMetrics.On("GetCpuTargetWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockCPUTargetResponse(0.3), nil)
+	mockMetrics.On("GetMemoryWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockMemoryResponse(256), nil)
+	mockMetrics.On("GetMemoryLimitWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockMemoryLimitResponse(512), nil)
+	mockMetrics.On("GetMemoryTargetWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(metrics.NewMockMemoryTargetResponse(0), nil)
+
+	_, handler := getAutoscaling(NewRepo(fakeClient), metrics.NewRepo(mockMetrics))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"serviceId": "srv-123456"}
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var status AutoscalingStatus
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &status))
+	assert.Equal(t, config, status.Autoscaling)
+	assert.Nil(t, status.NumInstances)
+	assert.Empty(t, status.UtilizationError)
+	require.Len(t, status.Utilization, 2)
+
+	cpu := status.Utilization[0]
+	assert.Equal(t, "cpu", cpu.Resource)
+	assert.Equal(t, pointers.From(60), cpu.TargetPercentage)
+	assert.Equal(t, pointers.From(90.0), cpu.AverageUtilizationPercentage)
+
+	memory := status.Utilization[1]
+	assert.Equal(t, "memory", memory.Resource)
+	assert.Nil(t, memory.TargetPercentage)
+	assert.Equal(t, pointers.From(50.0), memory.AverageUtilizationPercentage)
+}
+
+func TestSetAutoscalingTool(t *testing.T) {
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		service        func(t *testing.T) *client.Service
+		expectedConfig *autoscalingtypes.AutoscalingConfig
+		expectedError  string
+	}{
+		{
+			name: "Sets autoscaling",
+			arguments: map[string]interface{}{
+				"minInstances":           float64(2),
+				"maxInstances":           float64(5),
+				"memoryTargetPercentage": float64(70),
+			},
+			expectedConfig: &autoscalingtypes.AutoscalingConfig{
+				Enabled: true,
+				Min:     2,
+				Max:     5,
+				Criteria: autoscalingtypes.AutoscalingCriteria{
+					Memory: autoscalingtypes.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 70},
+				},
+			},
+		},
+		{
+			name: "Validates the configuration before calling the API",
+			arguments: map[string]interface{}{
+				"minInstances":        float64(5),
+				"maxInstances":        float64(2),
+				"cpuTargetPercentage": float64(70),
+			},
+			expectedError: "minInstances (5) can't be more than maxInstances (2)",
+		},
+		{
+			name: "Refuses services that can't scale",
+			arguments: map[string]interface{}{
+				"minInstances":        float64(1),
+				"maxInstances":        float64(2),
+				"cpuTargetPercentage": float64(70),
+			},
+			service: func(t *testing.T) *client.Service {
+				return &client.Service{Id: "srv-123456", OwnerId: "own-123", Type: client.StaticSite}
+			},
+			expectedError: "autoscaling is only available for web services, private services and background workers, not static_site",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := autoscaledWebService(t, nil)
+			if tt.service != nil {
+				service = tt.service(t)
+			}
+
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      service,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.AutoscaleServiceWithResponseReturns(&client.AutoscaleServiceResponse{
+				JSON200:      tt.expectedConfig,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			// Utilization is best effort, so failing metrics calls still return the configuration.
+			mockMetrics := &metrics.MockClientWithResponses{}
+			mockMetrics.On("GetCpuWithResponse", mock.Anything, mock.Anything, mock.Anything).Return(&client.GetCpuResponse{
+				HTTPResponse: metrics.NewMockErrorResponse(500),
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			arguments := map[string]interface{}{"serviceId": "srv-123456"}
+			for k, v := range tt.arguments {
+				arguments[k] = v
+			}
+			request.Params.Arguments = arguments
+
+			_, handler := setAutoscaling(NewRepo(fakeClient), metrics.NewRepo(mockMetrics))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.AutoscaleServiceWithResponseCallCount())
+				return
+			}
+
+			require.False(t, result.IsError, text)
+			require.Equal(t, 1, fakeClient.AutoscaleServiceWithResponseCallCount())
+			_, _, body, _ := fakeClient.AutoscaleServiceWithResponseArgsForCall(0)
+			assert.Equal(t, *tt.expectedConfig, body)
+
+			var status AutoscalingStatus
+			require.NoError(t, json.Unmarshal([]byte(text), &status))
+			assert.Equal(t, tt.expectedConfig, status.Autoscaling)
+			assert.Contains(t, status.UtilizationError, "failed to fetch cpu_usage metrics")
+		})
+	}
+}
+
+func autoscaledWebService(t *testing.T, config *autoscalingtypes.AutoscalingConfig) *client.Service {
+	service := webService(t, "own-123", client.ServiceRuntimeNode)
+	details, err := service.ServiceDetails.AsWebServiceDetails()
+	require.NoError(t, err)
+	details.Autoscaling = config
+	details.NumInstances = 1
+	require.NoError(t, service.ServiceDetails.FromWebServiceDetails(details))
+	return service
+}
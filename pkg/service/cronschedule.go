@@ -0,0 +1,208 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultCronPreviewRuns = 5
+	maxCronPreviewRuns     = 20
+
+	// cronSearchLimit bounds the search for the next run, so schedules that can never run, like
+	// 0 0 30 2 *, don't loop forever. It's long enough to reach the next 29th of February.
+	cronSearchLimit = 5 * 365 * 24 * time.Hour
+
+	cronPreviewFormat = "Mon 2 Jan 2006 15:04 MST"
+)
+
+// cronSchedule is a parsed five field cron expression. Render runs cron jobs on UTC time.
+type cronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// When both day fields are restricted, a day matches if either of them does, like in standard cron.
+	dayOfMonthRestricted, dayOfWeekRestricted bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	// 7 is also Sunday.
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// CronSchedulePreview lists the next times a cron job would run, so a schedule can be checked
+// before the job is created.
+type CronSchedulePreview struct {
+	Schedule string   `json:"schedule"`
+	Timezone string   `json:"timezone"`
+	NextRuns []string `json:"nextRuns"`
+}
+
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+		bits[i] = b
+	}
+
+	// Fold 7 into 0 so Sunday only has to be checked once.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return &cronSchedule{
+		minute:               bits[0],
+		hour:                 bits[1],
+		dayOfMonth:           bits[2],
+		month:                bits[3],
+		dayOfWeek:            bits[4],
+		dayOfMonthRestricted: !strings.HasPrefix(fields[2], "*"),
+		dayOfWeekRestricted:  !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps, like 1,15 or 9-17 or */5,
+// into a bitset of the values it matches.
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			low, high, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = cronValue(low, f); err != nil {
+				return 0, err
+			}
+			if end, err = cronValue(high, f); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+			}
+		default:
+			var err error
+			if start, err = cronValue(rangePart, f); err != nil {
+				return 0, err
+			}
+			end = start
+			// A single value with a step, like 5/15, runs from the value to the end of the range.
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func cronValue(s string, f cronField) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
+
+// next returns the first time after t that the schedule runs, or the zero time if it never does.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<t.Day()) != 0
+	dayOfWeek := s.dayOfWeek&(1<<int(t.Weekday())) != 0
+	if s.dayOfMonthRestricted && s.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// previewCronSchedule validates a schedule and lists its next n run times after now.
+func previewCronSchedule(expr string, now time.Time, n int) (*CronSchedulePreview, error) {
+	schedule, err := parseCronSchedule(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &CronSchedulePreview{
+		Schedule: expr,
+		Timezone: "UTC",
+		NextRuns: make([]string, 0, n),
+	}
+
+	t := now
+	for len(preview.NextRuns) < n {
+		t = schedule.next(t)
+		if t.IsZero() {
+			break
+		}
+		preview.NextRuns = append(preview.NextRuns, t.Format(cronPreviewFormat))
+	}
+
+	if len(preview.NextRuns) == 0 {
+		return nil, fmt.Errorf("invalid schedule %q: it never runs", expr)
+	}
+	return preview, nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewCronSchedule(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		schedule      string
+		n             int
+		expectedRuns  []string
+		expectedError string
+	}{
+		{
+			name:     "Every 15 minutes",
+			schedule: "*/15 * * * *",
+			n:        3,
+			expectedRuns: []string{
+				"Wed 15 Jan 2025 10:45 UTC",
+				"Wed 15 Jan 2025 11:00 UTC",
+				"Wed 15 Jan 2025 11:15 UTC",
+			},
+		},
+		{
+			name:     "Does not include the current minute",
+			schedule: "30 10 * * *",
+			n:        1,
+			expectedRuns: []string{
+				"Thu 16 Jan 2025 10:30 UTC",
+			},
+		},
+		{
+			name:     "Weekdays by name",
+			schedule: "0 9 * * MON-FRI",
+			n:        3,
+			expectedRuns: []string{
+				"Thu 16 Jan 2025 09:00 UTC",
+				"Fri 17 Jan 2025 09:00 UTC",
+				"Mon 20 Jan 2025 09:00 UTC",
+			},
+		},
+		{
+			name:     "Seven is Sunday",
+			schedule: "0 0 * * 7",
+			n:        1,
+			expectedRuns: []string{
+				"Sun 19 Jan 2025 00:00 UTC",
+			},
+		},
+		{
+			name:     "Either restricted day field matches",
+			schedule: "0 0 1 * 5",
+			n:        3,
+			expectedRuns: []string{
+				"Fri 17 Jan 2025 00:00 UTC",
+				"Fri 24 Jan 2025 00:00 UTC",
+				"Fri 31 Jan 2025 00:00 UTC",
+			},
+		},
+		{
+			name:     "Lists, ranges and steps",
+			schedule: "0,30 8-12/2 * jan,jun *",
+			n:        3,
+			expectedRuns: []string{
+				"Wed 15 Jan 2025 12:00 UTC",
+				"Wed 15 Jan 2025 12:30 UTC",
+				"Thu 16 Jan 2025 08:00 UTC",
+			},
+		},
+		{
+			name:     "Leap days",
+			schedule: "0 0 29 2 *",
+			n:        1,
+			expectedRuns: []string{
+				"Tue 29 Feb 2028 00:00 UTC",
+			},
+		},
+		{
+			name:          "Wrong number of fields",
+			schedule:      "* * * *",
+			expectedError: "expected 5 fields",
+		},
+		{
+			name:          "Value out of range",
+			schedule:      "60 * * * *",
+			expectedError: "value 60 out of range 0-59 in minute field",
+		},
+		{
+			name:          "Unknown name",
+			schedule:      "0 0 * * funday",
+			expectedError: `invalid value "funday" in day of week field`,
+		},
+		{
+			name:          "Zero step",
+			schedule:      "*/0 * * * *",
+			expectedError: `invalid step "0" in minute field`,
+		},
+		{
+			name:          "Backwards range",
+			schedule:      "0 17-9 * * *",
+			expectedError: `invalid range "17-9" in hour field`,
+		},
+		{
+			name:          "Never runs",
+			schedule:      "0 0 30 2 *",
+			expectedError: "it never runs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview, err := previewCronSchedule(tt.schedule, now, tt.n)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.schedule, preview.Schedule)
+			assert.Equal(t, "UTC", preview.Timezone)
+			assert.Equal(t, tt.expectedRuns, preview.NextRuns)
+		})
+	}
+}
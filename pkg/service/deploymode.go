@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+const (
+	deployModeDeploy           = "deploy"
+	deployModeClearCacheDeploy = "clearCacheDeploy"
+	deployModeRestart          = "restart"
+	deployModeNone             = "none"
+)
+
+// deployModes are the ways a service can pick up changes to its environment.
+var deployModes = []string{deployModeDeploy, deployModeClearCacheDeploy, deployModeRestart, deployModeNone}
+
+// DeployModeResult describes what happened to a service after its environment was changed. Deploy
+// is only set when a deploy was triggered.
+type DeployModeResult struct {
+	Message    string         `json:"message"`
+	DeployMode string         `json:"deployMode"`
+	Deploy     *client.Deploy `json:"deploy,omitempty"`
+}
+
+func withDeployMode() mcp.ToolOption {
+	return mcp.WithString("deployMode",
+		mcp.Description("How to pick up the changes. 'deploy' triggers a new deploy, "+
+			"'clearCacheDeploy' triggers a new deploy after clearing the build cache, 'restart' restarts "+
+			"the service without rebuilding it, and 'none' leaves the service running with its current "+
+			"environment until the next deploy. Defaults to 'deploy'."),
+		mcp.Enum(deployModes...),
+		mcp.DefaultString(deployModeDeploy),
+	)
+}
+
+// deployModeParam returns the deployMode parameter of a request, defaulting to deployModeDeploy.
+func deployModeParam(request mcp.CallToolRequest) (string, error) {
+	deployMode, ok, err := validate.OptionalToolParam[string](request, "deployMode")
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return deployModeDeploy, nil
+	}
+	if !slices.Contains(deployModes, deployMode) {
+		return "", fmt.Errorf("deployMode must be one of %s", strings.Join(deployModes, ", "))
+	}
+	return deployMode, nil
+}
+
+// applyDeployMode makes a service pick up changes to its environment in the way deployMode asks
+// for. changed names what was changed, e.g. "Environment variables", and starts the message.
+func applyDeployMode(ctx context.Context, serviceRepo *Repo, serviceId string, deployMode string, changed string) (*DeployModeResult, error) {
+	result := &DeployModeResult{DeployMode: deployMode}
+
+	switch deployMode {
+	case deployModeDeploy, deployModeClearCacheDeploy:
+		body := client.CreateDeployJSONRequestBody{}
+		result.Message = changed + " updated. A new deploy has been triggered to pick up the changes."
+		if deployMode == deployModeClearCacheDeploy {
+			body.ClearCache = pointers.From(client.Clear)
+			result.Message = changed + " updated. A new deploy with a cleared build cache has been triggered to pick up the changes."
+		}
+
+		deploy, err := serviceRepo.DeployService(ctx, serviceId, body)
+		if err != nil {
+			return nil, err
+		}
+		result.Deploy = deploy
+	case deployModeRestart:
+		if err := serviceRepo.RestartService(ctx, serviceId); err != nil {
+			return nil, err
+		}
+		result.Message = changed + " updated. The service is restarting to pick up the changes."
+	case deployModeNone:
+		result.Message = changed + " updated. No deploy was triggered, so the changes take effect on the service's next deploy."
+	}
+
+	return result, nil
+}
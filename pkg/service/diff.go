@@ -0,0 +1,13 @@
+package service
+
+import (
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+)
+
+// UpdateResult is returned by the update tools. Service is nil when the update was a dry run.
+type UpdateResult struct {
+	DryRun  bool               `json:"dryRun"`
+	Changes []diff.FieldChange `json:"changes"`
+	Service *client.Service    `json:"service,omitempty"`
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+// revealEnvVarValuesEnvKey lets operators allow list_environment_variables to return plaintext
+// values. Values are only ever returned as fingerprints otherwise.
+const revealEnvVarValuesEnvKey = "RENDER_MCP_REVEAL_ENV_VAR_VALUES"
+
+// fingerprintLength is the number of hex characters of the HMAC kept in a fingerprint.
+const fingerprintLength = 12
+
+func revealEnvVarValuesFromEnv() bool {
+	value := os.Getenv(revealEnvVarValuesEnvKey)
+	if value == "" {
+		return false
+	}
+
+	reveal, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("ignoring invalid %s %q, environment variable values are not revealed\n", revealEnvVarValuesEnvKey, value)
+		return false
+	}
+	return reveal
+}
+
+// fingerprinter fingerprints environment variable values, so that the model can tell whether two
+// values are the same without seeing them. Fingerprints are keyed with a random key that is created
+// when the server starts, so they can't be reversed by hashing guesses of the value, and they can
+// only be compared within one run of the server.
+type fingerprinter struct {
+	key []byte
+}
+
+func newFingerprinter() *fingerprinter {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		// crypto/rand only fails if the operating system's random source is unavailable.
+		panic(err)
+	}
+	return &fingerprinter{key: key}
+}
+
+func (f *fingerprinter) fingerprint(value string) string {
+	mac := hmac.New(sha256.New, f.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))[:fingerprintLength]
+}
+
+// EnvVarSummary describes an environment variable. Value is only set when the operator has enabled
+// revealing values.
+type EnvVarSummary struct {
+	Key         string  `json:"key"`
+	Length      int     `json:"length"`
+	Fingerprint string  `json:"fingerprint"`
+	Value       *string `json:"value,omitempty"`
+}
+
+func summarizeEnvVars(envVars []*client.EnvVar, f *fingerprinter, reveal bool) []EnvVarSummary {
+	summaries := make([]EnvVarSummary, 0, len(envVars))
+	for _, envVar := range envVars {
+		summary := EnvVarSummary{
+			Key:         envVar.Key,
+			Length:      len(envVar.Value),
+			Fingerprint: f.fingerprint(envVar.Value),
+		}
+		if reveal {
+			value := envVar.Value
+			summary.Value = &value
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Key < summaries[j].Key
+	})
+	return summaries
+}
+
+// EnvVarDiff compares the environment variables of a source and a target by key. Only keys are
+// listed, so that no values are exposed.
+type EnvVarDiff struct {
+	Source       string   `json:"source"`
+	Target       string   `json:"target"`
+	OnlyInSource []string `json:"onlyInSource"`
+	OnlyInTarget []string `json:"onlyInTarget"`
+	Different    []string `json:"different"`
+	Same         []string `json:"same"`
+}
+
+func compareEnvVars(source []*client.EnvVar, target []*client.EnvVar) EnvVarDiff {
+	targetValues := make(map[string]string, len(target))
+	for _, envVar := range target {
+		targetValues[envVar.Key] = envVar.Value
+	}
+
+	diff := EnvVarDiff{
+		OnlyInSource: []string{},
+		OnlyInTarget: []string{},
+		Different:    []string{},
+		Same:         []string{},
+	}
+	inSource := make(map[string]bool, len(source))
+	for _, envVar := range source {
+		inSource[envVar.Key] = true
+
+		targetValue, ok := targetValues[envVar.Key]
+		switch {
+		case !ok:
+			diff.OnlyInSource = append(diff.OnlyInSource, envVar.Key)
+		case targetValue != envVar.Value:
+			diff.Different = append(diff.Different, envVar.Key)
+		default:
+			diff.Same = append(diff.Same, envVar.Key)
+		}
+	}
+	for _, envVar := range target {
+		if !inSource[envVar.Key] {
+			diff.OnlyInTarget = append(diff.OnlyInTarget, envVar.Key)
+		}
+	}
+
+	sort.Strings(diff.OnlyInSource)
+	sort.Strings(diff.OnlyInTarget)
+	sort.Strings(diff.Different)
+	sort.Strings(diff.Same)
+	return diff
+}
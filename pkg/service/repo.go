@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	autoscalingtypes "github.com/render-oss/render-mcp-server/pkg/client/autoscaling"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
 	"github.com/render-oss/render-mcp-server/pkg/session"
 	"github.com/render-oss/render-mcp-server/pkg/validate"
@@ -14,11 +15,30 @@ type serviceRepoClient interface {
 	ListServicesWithResponse(ctx context.Context, params *client.ListServicesParams, reqEditors ...client.RequestEditorFn) (*client.ListServicesResponse, error)
 	GetEnvVarsForServiceWithResponse(ctx context.Context, serviceId string, params *client.GetEnvVarsForServiceParams, reqEditors ...client.RequestEditorFn) (*client.GetEnvVarsForServiceResponse, error)
 	UpdateEnvVarsForServiceWithResponse(ctx context.Context, serviceId string, body []client.EnvVarInput, reqEditors ...client.RequestEditorFn) (*client.UpdateEnvVarsForServiceResponse, error)
+	DeleteEnvVarWithResponse(ctx context.Context, serviceId string, envVarKey client.EnvVarKeyParam, reqEditors ...client.RequestEditorFn) (*client.DeleteEnvVarResponse, error)
+	ListSecretFilesForServiceWithResponse(ctx context.Context, serviceId string, params *client.ListSecretFilesForServiceParams, reqEditors ...client.RequestEditorFn) (*client.ListSecretFilesForServiceResponse, error)
+	RetrieveSecretFileWithResponse(ctx context.Context, serviceId string, secretFileName string, reqEditors ...client.RequestEditorFn) (*client.RetrieveSecretFileResponse, error)
+	AddOrUpdateSecretFileWithResponse(ctx context.Context, serviceId string, secretFileName string, body client.AddOrUpdateSecretFileJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AddOrUpdateSecretFileResponse, error)
+	UpdateSecretFilesForServiceWithResponse(ctx context.Context, serviceId string, body client.UpdateSecretFilesForServiceJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateSecretFilesForServiceResponse, error)
+	DeleteSecretFileWithResponse(ctx context.Context, serviceId string, secretFileName string, reqEditors ...client.RequestEditorFn) (*client.DeleteSecretFileResponse, error)
 	CreateDeployWithResponse(ctx context.Context, serviceId string, body client.CreateDeployJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateDeployResponse, error)
+	RestartServiceWithResponse(ctx context.Context, serviceId string, reqEditors ...client.RequestEditorFn) (*client.RestartServiceResponse, error)
+	SuspendServiceWithResponse(ctx context.Context, serviceId string, reqEditors ...client.RequestEditorFn) (*client.SuspendServiceResponse, error)
+	ResumeServiceWithResponse(ctx context.Context, serviceId string, reqEditors ...client.RequestEditorFn) (*client.ResumeServiceResponse, error)
+	ScaleServiceWithResponse(ctx context.Context, serviceId string, body client.ScaleServiceJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.ScaleServiceResponse, error)
+	DeleteServiceWithResponse(ctx context.Context, serviceId string, reqEditors ...client.RequestEditorFn) (*client.DeleteServiceResponse, error)
+	AutoscaleServiceWithResponse(ctx context.Context, serviceId string, body client.AutoscaleServiceJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AutoscaleServiceResponse, error)
+	DeleteAutoscalingConfigWithResponse(ctx context.Context, serviceId string, reqEditors ...client.RequestEditorFn) (*client.DeleteAutoscalingConfigResponse, error)
+	RunCronJobWithResponse(ctx context.Context, cronJobId client.CronJobIdParam, reqEditors ...client.RequestEditorFn) (*client.RunCronJobResponse, error)
+	CancelCronJobRunWithResponse(ctx context.Context, cronJobId client.CronJobIdParam, reqEditors ...client.RequestEditorFn) (*client.CancelCronJobRunResponse, error)
 	CreateServiceWithResponse(ctx context.Context, data client.CreateServiceJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.CreateServiceResponse, error)
 	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+	UpdateServiceWithResponse(ctx context.Context, serviceId string, body client.UpdateServiceJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateServiceResponse, error)
 }
 
+// Repo calls the service API. Apart from UpdateEnvVars, methods that take a service ID don't check
+// that the service belongs to the current workspace, so callers must check it first, usually with
+// serviceInWorkspace.
 type Repo struct {
 	client serviceRepoClient
 }
@@ -117,14 +137,94 @@ func (s *Repo) UpdateEnvVars(ctx context.Context, serviceId string, envVars []cl
 	return resp, nil
 }
 
-func (s *Repo) DeployService(ctx context.Context, serviceId string) (*client.CreateDeployResponse, error) {
-	// Skip validation of the service belongs to the workspace because it should be done before the
-	// call to DeployService.
-	resp, err := s.client.CreateDeployWithResponse(ctx, serviceId, client.CreateDeployJSONRequestBody{
-		ClearCache: nil,
-		CommitId:   nil,
-		ImageUrl:   nil,
+func (s *Repo) DeleteEnvVar(ctx context.Context, serviceId string, key string) error {
+	resp, err := s.client.DeleteEnvVarWithResponse(ctx, serviceId, key)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+type ListSecretFilesParams struct {
+	*client.ListSecretFilesForServiceParams
+	serviceId string
+}
+
+func (s *Repo) ListSecretFiles(ctx context.Context, serviceId string) ([]*client.SecretFile, error) {
+	params := &ListSecretFilesParams{
+		ListSecretFilesForServiceParams: &client.ListSecretFilesForServiceParams{},
+		serviceId:                       serviceId,
+	}
+	return client.ListAll(ctx, params, s.listSecretFilesPage)
+}
+
+func (s *Repo) listSecretFilesPage(ctx context.Context, params *ListSecretFilesParams) ([]*client.SecretFile, *client.Cursor, error) {
+	resp, err := s.client.ListSecretFilesForServiceWithResponse(ctx, params.serviceId, params.ListSecretFilesForServiceParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	secretFiles := make([]*client.SecretFile, 0, len(res))
+	for _, secretFileWithCursor := range res {
+		secretFiles = append(secretFiles, &secretFileWithCursor.SecretFile)
+	}
+
+	return secretFiles, &res[len(res)-1].Cursor, nil
+}
+
+func (s *Repo) GetSecretFile(ctx context.Context, serviceId string, name string) (*client.SecretFile, error) {
+	resp, err := s.client.RetrieveSecretFileWithResponse(ctx, serviceId, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (s *Repo) SetSecretFile(ctx context.Context, serviceId string, secretFile client.SecretFileInput) error {
+	resp, err := s.client.AddOrUpdateSecretFileWithResponse(ctx, serviceId, secretFile.Name, client.AddOrUpdateSecretFileJSONRequestBody{
+		Content: pointers.From(secretFile.Content),
 	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) ReplaceSecretFiles(ctx context.Context, serviceId string, secretFiles []client.SecretFileInput) error {
+	resp, err := s.client.UpdateSecretFilesForServiceWithResponse(ctx, serviceId, secretFiles)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) DeleteSecretFile(ctx context.Context, serviceId string, name string) error {
+	resp, err := s.client.DeleteSecretFileWithResponse(ctx, serviceId, name)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) DeployService(ctx context.Context, serviceId string, body client.CreateDeployJSONRequestBody) (*client.Deploy, error) {
+	resp, err := s.client.CreateDeployWithResponse(ctx, serviceId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +233,98 @@ func (s *Repo) DeployService(ctx context.Context, serviceId string) (*client.Cre
 		return nil, err
 	}
 
-	return resp, nil
+	return resp.JSON201, nil
+}
+
+func (s *Repo) RestartService(ctx context.Context, serviceId string) error {
+	resp, err := s.client.RestartServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) SuspendService(ctx context.Context, serviceId string) error {
+	resp, err := s.client.SuspendServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) ResumeService(ctx context.Context, serviceId string) error {
+	resp, err := s.client.ResumeServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) ScaleService(ctx context.Context, serviceId string, numInstances int) error {
+	resp, err := s.client.ScaleServiceWithResponse(ctx, serviceId, client.ScaleServiceJSONRequestBody{
+		NumInstances: numInstances,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) DeleteService(ctx context.Context, serviceId string) error {
+	resp, err := s.client.DeleteServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) SetAutoscaling(ctx context.Context, serviceId string, config autoscalingtypes.AutoscalingConfig) (*autoscalingtypes.AutoscalingConfig, error) {
+	resp, err := s.client.AutoscaleServiceWithResponse(ctx, serviceId, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (s *Repo) RemoveAutoscaling(ctx context.Context, serviceId string) error {
+	resp, err := s.client.DeleteAutoscalingConfigWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) RunCronJob(ctx context.Context, serviceId string) (*client.CronJobRun, error) {
+	resp, err := s.client.RunCronJobWithResponse(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (s *Repo) CancelCronJobRun(ctx context.Context, serviceId string) error {
+	resp, err := s.client.CancelCronJobRunWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
 }
 
 func (s *Repo) CreateService(ctx context.Context, data client.CreateServiceJSONRequestBody) (*client.ServiceAndDeploy, error) {
@@ -165,3 +356,16 @@ func (s *Repo) GetService(ctx context.Context, id string) (*client.Service, erro
 
 	return resp.JSON200, nil
 }
+
+func (s *Repo) UpdateService(ctx context.Context, serviceId string, data client.UpdateServiceJSONRequestBody) (*client.Service, error) {
+	resp, err := s.client.UpdateServiceWithResponse(ctx, serviceId, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+// SecretFileSummary describes a secret file without its contents.
+type SecretFileSummary struct {
+	Name        string `json:"name"`
+	SizeBytes   int    `json:"sizeBytes"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+func summarizeSecretFiles(secretFiles []*client.SecretFile, f *fingerprinter) []SecretFileSummary {
+	summaries := make([]SecretFileSummary, 0, len(secretFiles))
+	for _, secretFile := range secretFiles {
+		summaries = append(summaries, SecretFileSummary{
+			Name:        secretFile.Name,
+			SizeBytes:   len(secretFile.Content),
+			Fingerprint: f.fingerprint(secretFile.Content),
+		})
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries
+}
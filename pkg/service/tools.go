@@ -3,12 +3,22 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	autoscalingtypes "github.com/render-oss/render-mcp-server/pkg/client/autoscaling"
 	"github.com/render-oss/render-mcp-server/pkg/config"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+	"github.com/render-oss/render-mcp-server/pkg/envgroup"
+	"github.com/render-oss/render-mcp-server/pkg/environment"
 	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/metrics"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
 	"github.com/render-oss/render-mcp-server/pkg/session"
 	"github.com/render-oss/render-mcp-server/pkg/validate"
@@ -16,8 +26,12 @@ import (
 
 func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
 	serviceRepo := NewRepo(c)
+	envGroupRepo := envgroup.NewRepo(c)
+	environmentRepo := environment.NewRepo(c)
+	metricsRepo := metrics.NewRepo(c)
+	f := newFingerprinter()
 
-	tool, handler := listServices(serviceRepo)
+	tool, handler := listServices(serviceRepo, environmentRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = getService(serviceRepo)
 	s.AddTool(*tool, handler)
@@ -25,15 +39,55 @@ func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
 	s.AddTool(*tool, handler)
 	tool, handler = createStaticSite(serviceRepo)
 	s.AddTool(*tool, handler)
-	tool, handler = updateWebService()
+	tool, handler = createBackgroundWorker(serviceRepo)
 	s.AddTool(*tool, handler)
-	tool, handler = updateStaticSite()
+	tool, handler = createPrivateService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = createCronJob(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = runCronJob(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = cancelCronJobRun(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateWebService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateStaticSite(serviceRepo)
 	s.AddTool(*tool, handler)
 	tool, handler = updateEnvVars(serviceRepo)
 	s.AddTool(*tool, handler)
+	tool, handler = listEnvVars(serviceRepo, f, revealEnvVarValuesFromEnv())
+	s.AddTool(*tool, handler)
+	tool, handler = deleteEnvVar(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = diffEnvVars(serviceRepo, envGroupRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listSecretFiles(serviceRepo, f)
+	s.AddTool(*tool, handler)
+	tool, handler = getSecretFile(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = updateSecretFiles(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteSecretFile(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = restartService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = suspendService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = resumeService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = scaleService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteService(serviceRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = getAutoscaling(serviceRepo, metricsRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = setAutoscaling(serviceRepo, metricsRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = removeAutoscaling(serviceRepo)
+	s.AddTool(*tool, handler)
 }
 
-func listServices(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+func listServices(serviceRepo *Repo, environmentRepo *environment.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("list_services",
 		mcp.WithDescription("List all services in your Render account"),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -45,11 +99,22 @@ func listServices(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 			mcp.Description("Whether to include preview services in the response. Defaults to false."),
 			mcp.DefaultBool(false),
 		),
+		environment.WithProjectFilter(),
+		environment.WithEnvironmentFilter(),
 	)
 	return &tool,
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			params := &client.ListServicesParams{}
 
+			if environmentIds, ok, err := environmentRepo.EnvironmentFilter(ctx, request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				if len(environmentIds) == 0 {
+					return mcp.NewToolResultText("No services found"), nil
+				}
+				params.EnvironmentId = &environmentIds
+			}
+
 			if includePreviews, ok, err := validate.OptionalToolParam[bool](request, "includePreviews"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			} else if ok {
@@ -110,8 +175,9 @@ func createWebService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 			"A web service is a public-facing service that can be accessed by users on the internet. "+
 			"By default, these services are automatically deployed when the specified branch is updated "+
 			"and do not require a manual trigger of a deploy. The user should only be prompted to manually trigger a deploy if auto-deploy is disabled."+
+			"A service can be built from source with a native runtime, built from a Dockerfile with the 'docker' runtime, "+
+			"or deploy a prebuilt image from a container registry with the 'image' runtime."+
 			"This tool is currently limited to support only a subset of the web service configuration parameters."+
-			"It also only supports web services which don't use Docker, or a container registry."+
 			"To create a service without those limitations, please use the dashboard at: "+config.DashboardURL()+"/web/new"),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
 			Title:          "Create web service",
@@ -136,8 +202,9 @@ func createWebService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 		),
 		mcp.WithString("runtime",
 			mcp.Required(),
-			mcp.Description("The runtime environment for your service. This determines how your service is built and run."),
-			mcp.Enum("node", "python", "go", "rust", "ruby", "elixir", "docker"),
+			mcp.Description("The runtime environment for your service. This determines how your service is built and run. "+
+				"Use 'docker' to build the repository's Dockerfile, or 'image' to deploy the prebuilt image at 'imageUrl'."),
+			mcp.Enum("node", "python", "go", "rust", "ruby", "elixir", "docker", "image"),
 		),
 		mcp.WithString("plan",
 			mcp.Description("The pricing plan for your service. Different plans offer different levels of resources and features."),
@@ -145,18 +212,42 @@ func createWebService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 			mcp.DefaultString(string(client.PaidPlanStarter)),
 		),
 		mcp.WithString("buildCommand",
-			mcp.Required(),
-			mcp.Description("The command used to build your service. For example, 'npm run build' for Node.js or 'pip install -r requirements.txt' for Python."),
+			mcp.Description("The command used to build your service. For example, 'npm run build' for Node.js or 'pip install -r requirements.txt' for Python. "+
+				"Required for native runtimes and not used by the docker and image runtimes."),
 		),
 		mcp.WithString("startCommand",
-			mcp.Required(),
-			mcp.Description("The command used to start your service. For example, 'npm start' for Node.js or 'gunicorn app:app' for Python."),
+			mcp.Description("The command used to start your service. For example, 'npm start' for Node.js or 'gunicorn app:app' for Python. "+
+				"Required for native runtimes. For the docker runtime, it overrides the Dockerfile's CMD."),
+		),
+		mcp.WithString("dockerfilePath",
+			mcp.Description("The path of the Dockerfile to build, relative to the repository root. Defaults to './Dockerfile'. Only used by the docker runtime."),
+		),
+		mcp.WithString("dockerContext",
+			mcp.Description("The path of the Docker build context, relative to the repository root. Defaults to the repository root. Only used by the docker runtime."),
+		),
+		mcp.WithString("imageUrl",
+			mcp.Description("The image to deploy, for example 'docker.io/library/nginx:latest' or 'ghcr.io/acme/api:v1.2.0'. Required for the image runtime."),
+		),
+		mcp.WithString("registryCredentialId",
+			mcp.Description("The ID of the registry credential used to pull a private image or the private base images of a Dockerfile. "+
+				"Only used by the docker and image runtimes. Use list_registry_credentials to find one."),
 		),
 		mcp.WithString("region",
 			mcp.Description("The geographic region where your service will be deployed. Defaults to Oregon. Choose the region closest to your users for best performance."),
 			mcp.Enum(mcpserver.RegionEnumValues()...),
 			mcp.DefaultString(string(client.Oregon)),
 		),
+		mcp.WithString("preDeployCommand",
+			mcp.Description("A command that runs after each build and before the service starts, such as a database migration. If it fails, the deploy is canceled."),
+		),
+		mcp.WithString("healthCheckPath",
+			mcp.Description("The path Render requests to check that the service is healthy before routing traffic to it, for example '/healthz'. Must start with '/'."),
+		),
+		mcp.WithNumber("numInstances",
+			mcp.Description("The number of instances to run. Defaults to 1. A service with a disk can only run one instance."),
+			mcp.Min(1),
+		),
+		diskToolParam(),
 		mcp.WithArray("envVars",
 			mcp.Description("Environment variables to set for your service. These are exposed during builds and at runtime."),
 			mcp.Items(
@@ -200,34 +291,253 @@ func createWebService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 }
 
 func createValidatedWebServiceRequest(ctx context.Context, request mcp.CallToolRequest) (*client.CreateServiceJSONRequestBody, error) {
-	runtime, err := validate.RequiredToolParam[string](request, "runtime")
+	details, err := createValidatedServiceDetails(request)
 	if err != nil {
 		return nil, err
 	}
 
-	buildCommand, err := validate.RequiredToolParam[string](request, "buildCommand")
-	if err != nil {
+	webServiceDetailsPOST := client.WebServiceDetailsPOST{
+		Runtime:            details.Runtime,
+		EnvSpecificDetails: details.EnvSpecificDetails,
+		Plan:               details.Plan,
+		Region:             details.Region,
+		NumInstances:       details.NumInstances,
+		Disk:               details.Disk,
+		PreDeployCommand:   details.PreDeployCommand,
+	}
+
+	if healthCheckPath, ok, err := validate.OptionalToolParam[string](request, "healthCheckPath"); err != nil {
 		return nil, err
+	} else if ok {
+		if err := validate.HealthCheckPath(healthCheckPath); err != nil {
+			return nil, err
+		}
+		webServiceDetailsPOST.HealthCheckPath = &healthCheckPath
 	}
 
-	startCommand, err := validate.RequiredToolParam[string](request, "startCommand")
+	serviceDetails := client.ServicePOST_ServiceDetails{}
+	if err = serviceDetails.FromWebServiceDetailsPOST(webServiceDetailsPOST); err != nil {
+		return nil, err
+	}
+
+	requestBody, err := validatedCreateServiceRequest(ctx, request, client.WebService, &serviceDetails)
 	if err != nil {
 		return nil, err
 	}
 
-	nativeEnvironmentDetails := client.NativeEnvironmentDetailsPOST{
-		BuildCommand: buildCommand,
-		StartCommand: startCommand,
+	if err := setValidatedImage(request, requestBody); err != nil {
+		return nil, err
+	}
+
+	return requestBody, nil
+}
+
+func createBackgroundWorker(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := newCreateInstanceServiceTool("create_background_worker", "Create background worker", "background worker",
+		"Create a new background worker in your Render account. "+
+			"A background worker runs continuously, like a queue consumer, and does not receive any network traffic. "+
+			"By default, workers are automatically deployed when the specified branch is updated. "+
+			"Like web services, workers can be built from source, built from a Dockerfile or deploy a prebuilt image."+
+			"This tool is currently limited to support only a subset of the background worker configuration parameters."+
+			"To create a worker without those limitations, please use the dashboard at: "+config.DashboardURL()+"/worker/new")
+
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			details, err := createValidatedServiceDetails(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// The worker and private service details have the same fields, so one converts to the other.
+			serviceDetails := client.ServicePOST_ServiceDetails{}
+			if err = serviceDetails.FromBackgroundWorkerDetailsPOST(client.BackgroundWorkerDetailsPOST(*details)); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return createInstanceService(ctx, serviceRepo, request, client.BackgroundWorker, &serviceDetails)
+		}
+}
+
+func createPrivateService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := newCreateInstanceServiceTool("create_private_service", "Create private service", "private service",
+		"Create a new private service in your Render account. "+
+			"A private service is reachable by your other Render services on the private network, but not from the internet. "+
+			"By default, private services are automatically deployed when the specified branch is updated. "+
+			"Like web services, private services can be built from source, built from a Dockerfile or deploy a prebuilt image."+
+			"This tool is currently limited to support only a subset of the private service configuration parameters."+
+			"To create a private service without those limitations, please use the dashboard at: "+config.DashboardURL()+"/pserv/new")
+
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			details, err := createValidatedServiceDetails(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			serviceDetails := client.ServicePOST_ServiceDetails{}
+			if err = serviceDetails.FromPrivateServiceDetailsPOST(*details); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return createInstanceService(ctx, serviceRepo, request, client.PrivateService, &serviceDetails)
+		}
+}
+
+func createInstanceService(ctx context.Context, serviceRepo *Repo, request mcp.CallToolRequest, serviceType client.ServiceType, serviceDetails *client.ServicePOST_ServiceDetails) (*mcp.CallToolResult, error) {
+	requestBody, err := validatedCreateServiceRequest(ctx, request, serviceType, serviceDetails)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := setValidatedImage(request, requestBody); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	response, err := serviceRepo.CreateService(ctx, *requestBody)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	respJSON, err := json.Marshal(response)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(string(respJSON)), nil
+}
+
+// newCreateInstanceServiceTool returns a tool that creates a background worker or private service,
+// which are configured the same way.
+func newCreateInstanceServiceTool(name, title, noun, description string) mcp.Tool {
+	return mcp.NewTool(name,
+		mcp.WithDescription(description),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          title,
+			ReadOnlyHint:   pointers.From(false),
+			IdempotentHint: pointers.From(false),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("A unique name for your %s.", noun)),
+		),
+		mcp.WithString("repo",
+			mcp.Description(fmt.Sprintf("The repository containing the source code for your %s. Must be a valid Git URL that Render can clone and deploy. Do not include the branch in the repo string. You can instead supply a 'branch' parameter.", noun)),
+		),
+		mcp.WithString("branch",
+			mcp.Description("The repository branch to deploy. This branch will be deployed when you manually trigger deploys and when auto-deploy is enabled. If left empty, this will fall back to the default branch of the repository."),
+		),
+		mcp.WithString("autoDeploy",
+			mcp.Description(fmt.Sprintf("Whether to automatically deploy the %s when the specified branch is updated. Defaults to 'yes'.", noun)),
+			mcp.Enum(string(client.AutoDeployYes), string(client.AutoDeployNo)),
+			mcp.DefaultString(string(client.AutoDeployYes)),
+		),
+		mcp.WithString("runtime",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("The runtime environment for your %s. This determines how it is built and run. ", noun)+
+				"Use 'docker' to build the repository's Dockerfile, or 'image' to deploy the prebuilt image at 'imageUrl'."),
+			mcp.Enum("node", "python", "go", "rust", "ruby", "elixir", "docker", "image"),
+		),
+		mcp.WithString("plan",
+			mcp.Description(fmt.Sprintf("The pricing plan for your %s. Different plans offer different levels of resources and features.", noun)),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.PaidPlanStarter, client.PaidPlanStandard, client.PaidPlanPro, client.PaidPlanProMax, client.PaidPlanProPlus, client.PaidPlanProUltra)...),
+			mcp.DefaultString(string(client.PaidPlanStarter)),
+		),
+		mcp.WithString("buildCommand",
+			mcp.Description(fmt.Sprintf("The command used to build your %s. For example, 'npm ci' for Node.js or 'pip install -r requirements.txt' for Python. ", noun)+
+				"Required for native runtimes and not used by the docker and image runtimes."),
+		),
+		mcp.WithString("startCommand",
+			mcp.Description(fmt.Sprintf("The command used to start your %s. For example, 'node worker.js' for Node.js or 'celery -A tasks worker' for Python. ", noun)+
+				"Required for native runtimes. For the docker runtime, it overrides the Dockerfile's CMD."),
+		),
+		mcp.WithString("dockerfilePath",
+			mcp.Description("The path of the Dockerfile to build, relative to the repository root. Defaults to './Dockerfile'. Only used by the docker runtime."),
+		),
+		mcp.WithString("dockerContext",
+			mcp.Description("The path of the Docker build context, relative to the repository root. Defaults to the repository root. Only used by the docker runtime."),
+		),
+		mcp.WithString("imageUrl",
+			mcp.Description("The image to deploy, for example 'docker.io/library/nginx:latest' or 'ghcr.io/acme/api:v1.2.0'. Required for the image runtime."),
+		),
+		mcp.WithString("registryCredentialId",
+			mcp.Description("The ID of the registry credential used to pull a private image or the private base images of a Dockerfile. "+
+				"Only used by the docker and image runtimes. Use list_registry_credentials to find one."),
+		),
+		mcp.WithString("preDeployCommand",
+			mcp.Description("A command that runs after each build and before the new version starts, such as a database migration. If it fails, the deploy is canceled."),
+		),
+		mcp.WithString("region",
+			mcp.Description(fmt.Sprintf("The geographic region where your %s will run. Defaults to Oregon. Choose the region of the services it works with.", noun)),
+			mcp.Enum(mcpserver.RegionEnumValues()...),
+			mcp.DefaultString(string(client.Oregon)),
+		),
+		mcp.WithNumber("numInstances",
+			mcp.Description(fmt.Sprintf("The number of instances of the %s to run. Defaults to 1. A service with a disk can only run one instance.", noun)),
+			mcp.Min(1),
+		),
+		diskToolParam(),
+		mcp.WithArray("envVars",
+			mcp.Description(fmt.Sprintf("Environment variables to set for your %s. These are exposed during builds and at runtime.", noun)),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"key", "value"},
+					"properties": map[string]interface{}{
+						"key": map[string]interface{}{
+							"type":        "string",
+							"description": "The name of the environment variable",
+						},
+						"value": map[string]interface{}{
+							"type":        "string",
+							"description": "The value of the environment variable",
+						},
+					},
+				},
+			),
+		),
+	)
+}
+
+func diskToolParam() mcp.ToolOption {
+	return mcp.WithObject("disk",
+		mcp.Description("A persistent disk to attach to the service. Files outside the disk's mount path are lost on each deploy."),
+		mcp.Properties(map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The name of the disk",
+			},
+			"mountPath": map[string]interface{}{
+				"type":        "string",
+				"description": "The absolute path where the disk is mounted, for example '/var/data'",
+			},
+			"sizeGB": map[string]interface{}{
+				"type":        "number",
+				"description": "The size of the disk in GB. Defaults to 1. Disks can be grown later, but never shrunk.",
+				"minimum":     1,
+			},
+		}),
+	)
+}
+
+// createValidatedServiceDetails reads the details that web services, private services and background
+// workers have in common. Their POST types only differ in the web service's extra fields, so the
+// private service type holds the shared ones.
+func createValidatedServiceDetails(request mcp.CallToolRequest) (*client.PrivateServiceDetailsPOST, error) {
+	runtime, err := validate.RequiredToolParam[string](request, "runtime")
+	if err != nil {
+		return nil, err
 	}
 
-	envSpecificDetails := client.EnvSpecificDetailsPOST{}
-	if err = envSpecificDetails.FromNativeEnvironmentDetailsPOST(nativeEnvironmentDetails); err != nil {
+	envSpecificDetails, err := createValidatedEnvSpecificDetails(request, client.ServiceRuntime(runtime))
+	if err != nil {
 		return nil, err
 	}
 
-	webServiceDetailsPOST := client.WebServiceDetailsPOST{
+	details := &client.PrivateServiceDetailsPOST{
 		Runtime:            client.ServiceRuntime(runtime),
-		EnvSpecificDetails: &envSpecificDetails,
+		EnvSpecificDetails: envSpecificDetails,
 	}
 
 	if plan, ok, err := validate.OptionalToolParam[string](request, "plan"); err != nil {
@@ -237,21 +547,139 @@ func createValidatedWebServiceRequest(ctx context.Context, request mcp.CallToolR
 		if err != nil {
 			return nil, err
 		}
-		webServiceDetailsPOST.Plan = paidPlan
+		details.Plan = paidPlan
 	}
 
 	if region, ok, err := validate.OptionalToolParam[string](request, "region"); err != nil {
 		return nil, err
 	} else if ok {
-		webServiceDetailsPOST.Region = (*client.Region)(&region)
+		details.Region = (*client.Region)(&region)
 	}
 
-	serviceDetails := client.ServicePOST_ServiceDetails{}
-	if err = serviceDetails.FromWebServiceDetailsPOST(webServiceDetailsPOST); err != nil {
+	if preDeployCommand, ok, err := validate.OptionalToolParam[string](request, "preDeployCommand"); err != nil {
+		return nil, err
+	} else if ok {
+		details.PreDeployCommand = &preDeployCommand
+	}
+
+	if numInstances, ok, err := validate.OptionalToolParam[float64](request, "numInstances"); err != nil {
+		return nil, err
+	} else if ok {
+		if numInstances < 1 || numInstances != math.Trunc(numInstances) {
+			return nil, errors.New("numInstances must be a whole number of at least 1")
+		}
+		details.NumInstances = pointers.From(int(numInstances))
+	}
+
+	if disk, ok, err := validate.ServiceDisk(request); err != nil {
+		return nil, err
+	} else if ok {
+		details.Disk = disk
+	}
+
+	if details.Disk != nil && details.NumInstances != nil && *details.NumInstances > 1 {
+		return nil, errors.New("a service with a disk can only run one instance")
+	}
+
+	return details, nil
+}
+
+// createValidatedEnvSpecificDetails reads how a service is built and started, which depends on its
+// runtime. Native runtimes use build and start commands, Docker services build a Dockerfile, and
+// image-backed services have no build at all, so they have no details.
+func createValidatedEnvSpecificDetails(request mcp.CallToolRequest, runtime client.ServiceRuntime) (*client.EnvSpecificDetailsPOST, error) {
+	if err := validateRuntimeParams(request, runtime); err != nil {
 		return nil, err
 	}
 
-	return validatedCreateServiceRequest(ctx, request, client.WebService, &serviceDetails)
+	envSpecificDetails := &client.EnvSpecificDetailsPOST{}
+	switch runtime {
+	case client.ServiceRuntimeImage:
+		return nil, nil
+	case client.ServiceRuntimeDocker:
+		dockerDetails := client.DockerDetailsPOST{}
+		for param, field := range map[string]**string{
+			"dockerfilePath":       &dockerDetails.DockerfilePath,
+			"dockerContext":        &dockerDetails.DockerContext,
+			"startCommand":         &dockerDetails.DockerCommand,
+			"registryCredentialId": &dockerDetails.RegistryCredentialId,
+		} {
+			if value, ok, err := validate.OptionalToolParam[string](request, param); err != nil {
+				return nil, err
+			} else if ok {
+				*field = &value
+			}
+		}
+		if err := envSpecificDetails.FromDockerDetailsPOST(dockerDetails); err != nil {
+			return nil, err
+		}
+	default:
+		buildCommand, err := validate.RequiredToolParam[string](request, "buildCommand")
+		if err != nil {
+			return nil, err
+		}
+
+		startCommand, err := validate.RequiredToolParam[string](request, "startCommand")
+		if err != nil {
+			return nil, err
+		}
+
+		if err := envSpecificDetails.FromNativeEnvironmentDetailsPOST(client.NativeEnvironmentDetailsPOST{
+			BuildCommand: buildCommand,
+			StartCommand: startCommand,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return envSpecificDetails, nil
+}
+
+// setValidatedImage points an image-backed service at the image it deploys. The image belongs to the
+// same workspace as the service, as does its registry credential, if the image is private.
+func setValidatedImage(request mcp.CallToolRequest, requestBody *client.CreateServiceJSONRequestBody) error {
+	imageUrl, ok, err := validate.OptionalToolParam[string](request, "imageUrl")
+	if err != nil || !ok {
+		return err
+	}
+
+	image := &client.Image{
+		ImagePath: imageUrl,
+		OwnerId:   requestBody.OwnerId,
+	}
+	if registryCredentialId, ok, err := validate.OptionalToolParam[string](request, "registryCredentialId"); err != nil {
+		return err
+	} else if ok {
+		image.RegistryCredentialId = &registryCredentialId
+	}
+
+	requestBody.Image = image
+	return nil
+}
+
+// validateRuntimeParams rejects parameters that don't apply to the runtime, instead of silently
+// ignoring them.
+func validateRuntimeParams(request mcp.CallToolRequest, runtime client.ServiceRuntime) error {
+	var unsupported []string
+	switch runtime {
+	case client.ServiceRuntimeImage:
+		if _, ok := request.GetArguments()["imageUrl"]; !ok {
+			return errors.New("imageUrl is required for the image runtime")
+		}
+		unsupported = []string{"repo", "branch", "buildCommand", "startCommand", "dockerfilePath", "dockerContext"}
+	case client.ServiceRuntimeDocker:
+		unsupported = []string{"buildCommand", "imageUrl"}
+	default:
+		unsupported = []string{"dockerfilePath", "dockerContext", "imageUrl", "registryCredentialId"}
+	}
+
+	for _, param := range unsupported {
+		if _, ok := request.GetArguments()[param]; ok {
+			return fmt.Errorf("%s can't be used with the %s runtime", param, runtime)
+		}
+	}
+
+	return nil
 }
 
 func validatedCreateServiceRequest(ctx context.Context, request mcp.CallToolRequest, serviceType client.ServiceType, serviceDetails *client.ServicePOST_ServiceDetails) (*client.CreateServiceJSONRequestBody, error) {
@@ -401,84 +829,72 @@ func createValidatedStaticSiteRequest(ctx context.Context, request mcp.CallToolR
 	return validatedCreateServiceRequest(ctx, request, client.StaticSite, &serviceDetails)
 }
 
-func updateWebService() (*mcp.Tool, server.ToolHandlerFunc) {
-	tool := mcp.NewTool("update_web_service",
-		mcp.WithDescription("Update an existing web service in your Render account."),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:          "Update web service",
-			ReadOnlyHint:   pointers.From(true),
-			IdempotentHint: pointers.From(true),
-		}),
-		mcp.WithString("serviceId",
-			mcp.Required(),
-			mcp.Description("The ID of the service to update"),
-		),
-	)
-	return &tool,
-		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Return a message indicating direct updates are not supported via MCP server
-			return mcp.NewToolResultText(
-				"Updating a service directly is not supported. Please make changes using the dashboard or the API.\n\n" +
-					"Dashboard URL: " + config.DashboardURL() + "/web/" + serviceId + "/settings"), nil
-		}
+// CreateCronJobResult is the created cron job along with when it will next run. Service is nil for a dry run.
+type CreateCronJobResult struct {
+	Service  *client.ServiceAndDeploy `json:"service,omitempty"`
+	Schedule *CronSchedulePreview     `json:"schedule"`
 }
 
-func updateStaticSite() (*mcp.Tool, server.ToolHandlerFunc) {
-	tool := mcp.NewTool("update_static_site",
-		mcp.WithDescription("Update an existing static site in your Render account."),
+func createCronJob(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_cron_job",
+		mcp.WithDescription("Create a new cron job in your Render account. "+
+			"A cron job builds your code and runs a command on a schedule, then exits. "+
+			"The result includes the next times the job will run, so the schedule can be checked. "+
+			"Set 'dryRun' to 'true' to validate the schedule and preview its run times without creating the cron job. "+
+			"This tool is currently limited to support only a subset of the cron job configuration parameters."+
+			"It also only supports cron jobs which don't use Docker, or a container registry."+
+			"To create a cron job without those limitations, please use the dashboard at: "+config.DashboardURL()+"/cron/new"),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:          "Update static site",
-			ReadOnlyHint:   pointers.From(true),
-			IdempotentHint: pointers.From(true),
+			Title:          "Create cron job",
+			ReadOnlyHint:   pointers.From(false),
+			IdempotentHint: pointers.From(false),
+			OpenWorldHint:  pointers.From(true),
 		}),
-		mcp.WithString("serviceId",
+		mcp.WithString("name",
 			mcp.Required(),
-			mcp.Description("The ID of the service to update"),
+			mcp.Description("A unique name for your cron job."),
 		),
-	)
-	return &tool,
-		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Return a message indicating direct updates are not supported via MCP server
-			return mcp.NewToolResultText(
-				"Updating a static site directly is not supported. Please make changes using the dashboard or the API.\n\n" +
-					"Dashboard URL: " + config.DashboardURL() + "/static/" + serviceId + "/settings"), nil
-		}
-}
-
-func updateEnvVars(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
-	tool := mcp.NewTool("update_environment_variables",
-		mcp.WithDescription("Update environment variables for a service. "+
-			"By default, environment variables passed in will be merged with the service's "+
-			"existing environment variables. This makes it safe to update environment variables"+
-			"without pulling the existing ones into the MCP host's context. "+
-			"To replace all existing environment variables, set the 'replace' parameter to 'true'."),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:           "Update environment variables",
-			DestructiveHint: pointers.From(true),
-			OpenWorldHint:   pointers.From(true),
-		}),
-		mcp.WithString("serviceId",
+		mcp.WithString("schedule",
 			mcp.Required(),
-			mcp.Description("The ID of the service to update"),
+			mcp.Description("When to run the job, as a five field cron expression in UTC: minute, hour, day of month, month and day of week. "+
+				"For example, '*/15 * * * *' runs every 15 minutes and '0 9 * * MON-FRI' runs at 09:00 UTC on weekdays."),
 		),
-		mcp.WithBoolean("replace",
-			mcp.Description("Whether to replace all existing environment variables with the "+
-				"provided list, or merge with the existing ones. Defaults to false."),
-			mcp.DefaultBool(false),
+		mcp.WithString("repo",
+			mcp.Description("The repository containing the source code for your cron job. Must be a valid Git URL that Render can clone and deploy. Do not include the branch in the repo string. You can instead supply a 'branch' parameter."),
 		),
-		mcp.WithArray("envVars",
+		mcp.WithString("branch",
+			mcp.Description("The repository branch to deploy. This branch will be deployed when you manually trigger deploys and when auto-deploy is enabled. If left empty, this will fall back to the default branch of the repository."),
+		),
+		mcp.WithString("autoDeploy",
+			mcp.Description("Whether to automatically deploy the cron job when the specified branch is updated. Defaults to 'yes'."),
+			mcp.Enum(string(client.AutoDeployYes), string(client.AutoDeployNo)),
+			mcp.DefaultString(string(client.AutoDeployYes)),
+		),
+		mcp.WithString("runtime",
 			mcp.Required(),
-			mcp.Description("The list of environment variables to update or set for the service."),
+			mcp.Description("The runtime environment for your cron job. This determines how your cron job is built and run."),
+			mcp.Enum("node", "python", "go", "rust", "ruby", "elixir"),
+		),
+		mcp.WithString("plan",
+			mcp.Description("The pricing plan for your cron job. Different plans offer different levels of resources and features."),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.PaidPlanStarter, client.PaidPlanStandard, client.PaidPlanPro, client.PaidPlanProMax, client.PaidPlanProPlus, client.PaidPlanProUltra)...),
+			mcp.DefaultString(string(client.PaidPlanStarter)),
+		),
+		mcp.WithString("buildCommand",
+			mcp.Required(),
+			mcp.Description("The command used to build your cron job. For example, 'npm install' for Node.js or 'pip install -r requirements.txt' for Python."),
+		),
+		mcp.WithString("startCommand",
+			mcp.Required(),
+			mcp.Description("The command to run on each scheduled run. For example, 'node scripts/cleanup.js' or 'python report.py'."),
+		),
+		mcp.WithString("region",
+			mcp.Description("The geographic region where your cron job will run. Defaults to Oregon."),
+			mcp.Enum(mcpserver.RegionEnumValues()...),
+			mcp.DefaultString(string(client.Oregon)),
+		),
+		mcp.WithArray("envVars",
+			mcp.Description("Environment variables to set for your cron job. These are exposed during builds and at runtime."),
 			mcp.Items(
 				map[string]interface{}{
 					"type":                 "object",
@@ -497,87 +913,1515 @@ func updateEnvVars(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
 				},
 			),
 		),
+		mcp.WithNumber("previewRuns",
+			mcp.Description(fmt.Sprintf("How many upcoming run times to list. Defaults to %d.", defaultCronPreviewRuns)),
+			mcp.Min(1),
+			mcp.Max(maxCronPreviewRuns),
+		),
+		mcp.WithBoolean("dryRun",
+			mcp.Description("If true, validate the schedule and list its upcoming run times without creating the cron job. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
 	)
 	return &tool,
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			schedule, err := validate.RequiredToolParam[string](request, "schedule")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			var envVars []client.EnvVarInput
-			var ok bool
-			if envVars, ok, err = validate.EnvVars(request); err != nil {
+			previewRuns := defaultCronPreviewRuns
+			if n, ok, err := validate.OptionalToolParam[float64](request, "previewRuns"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			} else if !ok {
-				return mcp.NewToolResultError("Environment variables are required"), nil
+			} else if ok {
+				if n < 1 || n > maxCronPreviewRuns || n != math.Trunc(n) {
+					return mcp.NewToolResultError(fmt.Sprintf("previewRuns must be a whole number between 1 and %d", maxCronPreviewRuns)), nil
+				}
+				previewRuns = int(n)
 			}
 
-			var envVarsToSet []client.EnvVarInput
+			preview, err := previewCronSchedule(schedule, time.Now(), previewRuns)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-			replace, _, err := validate.OptionalToolParam[bool](request, "replace")
+			requestBody, err := createValidatedCronJobRequest(ctx, request, schedule)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			if replace {
-				envVarsToSet = envVars
-			} else {
-				oldEnvVars, err := serviceRepo.ListEnvVars(ctx, serviceId, &client.GetEnvVarsForServiceParams{})
-				if err != nil {
-					return mcp.NewToolResultError(err.Error()), nil
-				}
-				envVarsToSet, err = mergeEnvVars(oldEnvVars, envVars)
+			result := CreateCronJobResult{Schedule: preview}
+			if dryRun, _, err := validate.OptionalToolParam[bool](request, "dryRun"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !dryRun {
+				result.Service, err = serviceRepo.CreateService(ctx, *requestBody)
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
 			}
 
-			_, err = serviceRepo.UpdateEnvVars(ctx, serviceId, envVarsToSet)
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func createValidatedCronJobRequest(ctx context.Context, request mcp.CallToolRequest, schedule string) (*client.CreateServiceJSONRequestBody, error) {
+	runtime, err := validate.RequiredToolParam[string](request, "runtime")
+	if err != nil {
+		return nil, err
+	}
+
+	// Cron jobs are only created from build and start commands, so runtimes that build a Dockerfile
+	// or pull an image aren't supported.
+	if runtime := client.ServiceRuntime(runtime); runtime == client.ServiceRuntimeDocker || runtime == client.ServiceRuntimeImage {
+		return nil, fmt.Errorf("cron jobs with the %s runtime can't be created with this tool", runtime)
+	}
+
+	buildCommand, err := validate.RequiredToolParam[string](request, "buildCommand")
+	if err != nil {
+		return nil, err
+	}
+
+	startCommand, err := validate.RequiredToolParam[string](request, "startCommand")
+	if err != nil {
+		return nil, err
+	}
+
+	envSpecificDetails := client.EnvSpecificDetails{}
+	if err = envSpecificDetails.FromNativeEnvironmentDetails(client.NativeEnvironmentDetails{
+		BuildCommand: buildCommand,
+		StartCommand: startCommand,
+	}); err != nil {
+		return nil, err
+	}
+
+	cronJobDetailsPOST := client.CronJobDetailsPOST{
+		Runtime:            client.ServiceRuntime(runtime),
+		Schedule:           schedule,
+		EnvSpecificDetails: &envSpecificDetails,
+	}
+
+	if plan, ok, err := validate.OptionalToolParam[string](request, "plan"); err != nil {
+		return nil, err
+	} else if ok {
+		paidPlan, err := validate.PaidPlan(plan)
+		if err != nil {
+			return nil, err
+		}
+		cronJobDetailsPOST.Plan = paidPlan
+	}
+
+	if region, ok, err := validate.OptionalToolParam[string](request, "region"); err != nil {
+		return nil, err
+	} else if ok {
+		cronJobDetailsPOST.Region = (*client.Region)(&region)
+	}
+
+	serviceDetails := client.ServicePOST_ServiceDetails{}
+	if err = serviceDetails.FromCronJobDetailsPOST(cronJobDetailsPOST); err != nil {
+		return nil, err
+	}
+
+	return validatedCreateServiceRequest(ctx, request, client.CronJob, &serviceDetails)
+}
+
+// cronJobInWorkspace returns a service after checking that it belongs to the current workspace and
+// is a cron job.
+func cronJobInWorkspace(ctx context.Context, serviceRepo *Repo, serviceId string) (*client.Service, error) {
+	service, err := serviceInWorkspace(ctx, serviceRepo, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if service.Type != client.CronJob {
+		return nil, fmt.Errorf("service %s is a %s, not a cron job", serviceId, service.Type)
+	}
+
+	return service, nil
+}
+
+func runCronJob(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("run_cron_job",
+		mcp.WithDescription("Run a cron job now, outside of its schedule. "+
+			"The run uses the cron job's latest successful build. Its logs can be fetched with the list_logs tool."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Run cron job",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the cron job to run"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Now trigger a deploy so that the updated environment variables are picked up
-			deployResponse, err := serviceRepo.DeployService(ctx, serviceId)
+			if _, err := cronJobInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			run, err := serviceRepo.RunCronJob(ctx, serviceId)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			responseText := "Environment variables updated. A new deploy has been triggered to pick up the changes.\n\n"
-			responseText += "Response from deploying service: " + string(deployResponse.Body)
+			respJSON, err := json.Marshal(run)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-			return mcp.NewToolResultText(responseText), nil
+			return mcp.NewToolResultText(string(respJSON)), nil
 		}
 }
 
-func mergeEnvVars(oldEnvVars []*client.EnvVar, envVarInputs []client.EnvVarInput) ([]client.EnvVarInput, error) {
-	envVarMap := make(map[string]string)
-	for _, envVar := range oldEnvVars {
-		envVarMap[envVar.Key] = envVar.Value
-	}
+func cancelCronJobRun(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("cancel_cron_job_run",
+		mcp.WithDescription("Cancel the run of a cron job that is in progress, whether it was started by its schedule or by the run_cron_job tool. "+
+			"Later scheduled runs are not affected."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Cancel cron job run",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the cron job whose run to cancel"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-	for _, envVarInput := range envVarInputs {
-		envVar, err := envVarInput.AsEnvVarKeyValue()
-		if err != nil {
-			return nil, err
+			if _, err := cronJobInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.CancelCronJobRun(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("The active run of cron job %s has been canceled", serviceId)), nil
 		}
-		envVarMap[envVar.Key] = envVar.Value
-	}
+}
 
-	// Convert map back to list
-	var mergedEnvVars []client.EnvVarInput
-	for k, v := range envVarMap {
-		var envVarInput client.EnvVarInput
-		err := envVarInput.FromEnvVarKeyValue(client.EnvVarKeyValue{
-			Key:   k,
-			Value: v,
-		})
-		if err != nil {
-			return nil, err
+func updateWebService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_web_service",
+		mcp.WithDescription("Update an existing web service in your Render account. "+
+			"Only the fields that are provided will be changed. "+
+			"The result lists each field that changes along with its current and new value. "+
+			"Set 'dryRun' to 'true' to preview the changes without applying them; this is "+
+			"recommended so the user can confirm the changes before they are made. "+
+			"Changes to the build or start command are picked up by the next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update web service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to update"),
+		),
+		mcp.WithBoolean("dryRun",
+			mcp.Description("If true, return the changes that would be made without applying them. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		mcp.WithString("branch",
+			mcp.Description("The repository branch to deploy."),
+		),
+		mcp.WithString("autoDeploy",
+			mcp.Description("Whether to automatically deploy the service when the specified branch is updated."),
+			mcp.Enum(string(client.AutoDeployYes), string(client.AutoDeployNo)),
+		),
+		mcp.WithString("plan",
+			mcp.Description("The pricing plan for your service. Changing the plan changes the resources available to the service and its cost."),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.PaidPlanStarter, client.PaidPlanStandard, client.PaidPlanPro, client.PaidPlanProMax, client.PaidPlanProPlus, client.PaidPlanProUltra)...),
+		),
+		mcp.WithString("buildCommand",
+			mcp.Description("The command used to build your service. Only supported for services that don't use Docker."),
+		),
+		mcp.WithString("startCommand",
+			mcp.Description("The command used to start your service. Only supported for services that don't use Docker."),
+		),
+		mcp.WithString("preDeployCommand",
+			mcp.Description("A command that runs after the build and before each deploy, for example to run database migrations. Set to the empty string to remove it."),
+		),
+		mcp.WithString("healthCheckPath",
+			mcp.Description("The path Render uses to check the health of the service, for example '/healthz'. Set to the empty string to disable health checks."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			current, err := serviceRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := validate.WorkspaceMatches(ctx, current.OwnerId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if current.Type != client.WebService {
+				return mcp.NewToolResultError(fmt.Sprintf("service %s is a %s, not a web service", serviceId, current.Type)), nil
+			}
+
+			requestBody, changes, err := updateValidatedWebServiceRequest(request, current)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return applyServiceUpdate(ctx, serviceRepo, request, current, requestBody, changes)
 		}
-		mergedEnvVars = append(mergedEnvVars, envVarInput)
+}
+
+func updateValidatedWebServiceRequest(request mcp.CallToolRequest, current *client.Service) (*client.UpdateServiceJSONRequestBody, []diff.FieldChange, error) {
+	currentDetails, err := current.ServiceDetails.AsWebServiceDetails()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return mergedEnvVars, nil
-}
\ No newline at end of file
+	// Docker and native environments both expose the pre-deploy command under the same key, so
+	// reading the native details works for either runtime.
+	currentEnvDetails, err := currentDetails.EnvSpecificDetails.AsNativeEnvironmentDetails()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []diff.FieldChange
+	webServiceDetailsPATCH := client.WebServiceDetailsPATCH{}
+
+	buildCommand, hasBuildCommand, err := validate.OptionalToolParam[string](request, "buildCommand")
+	if err != nil {
+		return nil, nil, err
+	}
+	startCommand, hasStartCommand, err := validate.OptionalToolParam[string](request, "startCommand")
+	if err != nil {
+		return nil, nil, err
+	}
+	if hasBuildCommand || hasStartCommand {
+		if currentDetails.Runtime == client.ServiceRuntimeDocker || currentDetails.Runtime == client.ServiceRuntimeImage {
+			return nil, nil, fmt.Errorf("buildCommand and startCommand are not supported for services with the %s runtime", currentDetails.Runtime)
+		}
+
+		nativeEnvironmentDetails := client.NativeEnvironmentDetailsPATCH{}
+		if hasBuildCommand {
+			if buildCommand == "" {
+				return nil, nil, fmt.Errorf("buildCommand cannot be empty")
+			}
+			nativeEnvironmentDetails.BuildCommand = &buildCommand
+			changes = diff.AppendChange(changes, "buildCommand", currentEnvDetails.BuildCommand, &buildCommand)
+		}
+		if hasStartCommand {
+			if startCommand == "" {
+				return nil, nil, fmt.Errorf("startCommand cannot be empty")
+			}
+			nativeEnvironmentDetails.StartCommand = &startCommand
+			changes = diff.AppendChange(changes, "startCommand", currentEnvDetails.StartCommand, &startCommand)
+		}
+
+		envSpecificDetails := client.EnvSpecificDetailsPATCH{}
+		if err = envSpecificDetails.FromNativeEnvironmentDetailsPATCH(nativeEnvironmentDetails); err != nil {
+			return nil, nil, err
+		}
+		webServiceDetailsPATCH.EnvSpecificDetails = &envSpecificDetails
+	}
+
+	if plan, ok, err := validate.OptionalToolParam[string](request, "plan"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		paidPlan, err := validate.PaidPlan(plan)
+		if err != nil {
+			return nil, nil, err
+		}
+		webServiceDetailsPATCH.Plan = paidPlan
+		changes = diff.AppendChange(changes, "plan", string(currentDetails.Plan), &plan)
+	}
+
+	if preDeployCommand, ok, err := validate.OptionalToolParam[string](request, "preDeployCommand"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		webServiceDetailsPATCH.PreDeployCommand = &preDeployCommand
+		changes = diff.AppendChange(changes, "preDeployCommand", diff.DerefString(currentEnvDetails.PreDeployCommand), &preDeployCommand)
+	}
+
+	if healthCheckPath, ok, err := validate.OptionalToolParam[string](request, "healthCheckPath"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		if err := validate.HealthCheckPath(healthCheckPath); err != nil {
+			return nil, nil, err
+		}
+		webServiceDetailsPATCH.HealthCheckPath = &healthCheckPath
+		changes = diff.AppendChange(changes, "healthCheckPath", currentDetails.HealthCheckPath, &healthCheckPath)
+	}
+
+	serviceDetails := client.ServicePATCH_ServiceDetails{}
+	if err = serviceDetails.FromWebServiceDetailsPATCH(webServiceDetailsPATCH); err != nil {
+		return nil, nil, err
+	}
+
+	return validatedUpdateServiceRequest(request, current, &serviceDetails, changes)
+}
+
+func validatedUpdateServiceRequest(request mcp.CallToolRequest, current *client.Service, serviceDetails *client.ServicePATCH_ServiceDetails, changes []diff.FieldChange) (*client.UpdateServiceJSONRequestBody, []diff.FieldChange, error) {
+	requestBody := &client.UpdateServiceJSONRequestBody{
+		ServiceDetails: serviceDetails,
+	}
+
+	if branch, ok, err := validate.OptionalToolParam[string](request, "branch"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		requestBody.Branch = &branch
+		changes = diff.AppendChange(changes, "branch", diff.DerefString(current.Branch), &branch)
+	}
+
+	if autoDeploy, ok, err := validate.OptionalToolParam[string](request, "autoDeploy"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		autoDeployValue, err := validate.AutoDeploy(autoDeploy)
+		if err != nil {
+			return nil, nil, err
+		}
+		requestBody.AutoDeploy = autoDeployValue
+		changes = diff.AppendChange(changes, "autoDeploy", string(current.AutoDeploy), &autoDeploy)
+	}
+
+	return requestBody, changes, nil
+}
+
+// applyServiceUpdate sends the update unless there is nothing to change or the caller asked for a
+// dry run, and reports the field-by-field changes either way.
+func applyServiceUpdate(ctx context.Context, serviceRepo *Repo, request mcp.CallToolRequest, current *client.Service, requestBody *client.UpdateServiceJSONRequestBody, changes []diff.FieldChange) (*mcp.CallToolResult, error) {
+	if len(changes) == 0 {
+		return mcp.NewToolResultText("No changes to apply. The provided values match the current configuration of the service."), nil
+	}
+
+	dryRun, _, err := validate.OptionalToolParam[bool](request, "dryRun")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result := UpdateResult{
+		DryRun:  dryRun,
+		Changes: changes,
+	}
+
+	if !dryRun {
+		result.Service, err = serviceRepo.UpdateService(ctx, current.Id, *requestBody)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	respJSON, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(string(respJSON)), nil
+}
+
+func updateStaticSite(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_static_site",
+		mcp.WithDescription("Update an existing static site in your Render account. "+
+			"Only the fields that are provided will be changed. "+
+			"The result lists each field that changes along with its current and new value. "+
+			"Set 'dryRun' to 'true' to preview the changes without applying them; this is "+
+			"recommended so the user can confirm the changes before they are made. "+
+			"Changes to the build command or publish path are picked up by the next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update static site",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to update"),
+		),
+		mcp.WithBoolean("dryRun",
+			mcp.Description("If true, return the changes that would be made without applying them. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		mcp.WithString("branch",
+			mcp.Description("The repository branch to deploy."),
+		),
+		mcp.WithString("autoDeploy",
+			mcp.Description("Whether to automatically deploy the static site when the specified branch is updated."),
+			mcp.Enum(string(client.AutoDeployYes), string(client.AutoDeployNo)),
+		),
+		mcp.WithString("buildCommand",
+			mcp.Description("Render runs this command to build your app before each deploy."),
+		),
+		mcp.WithString("publishPath",
+			mcp.Description("The relative path of the directory containing built assets to publish. Examples: ./, ./build, dist and frontend/build."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			current, err := serviceRepo.GetService(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := validate.WorkspaceMatches(ctx, current.OwnerId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if current.Type != client.StaticSite {
+				return mcp.NewToolResultError(fmt.Sprintf("service %s is a %s, not a static site", serviceId, current.Type)), nil
+			}
+
+			requestBody, changes, err := updateValidatedStaticSiteRequest(request, current)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return applyServiceUpdate(ctx, serviceRepo, request, current, requestBody, changes)
+		}
+}
+
+func updateValidatedStaticSiteRequest(request mcp.CallToolRequest, current *client.Service) (*client.UpdateServiceJSONRequestBody, []diff.FieldChange, error) {
+	currentDetails, err := current.ServiceDetails.AsStaticSiteDetails()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []diff.FieldChange
+	staticSiteDetailsPATCH := client.StaticSiteDetailsPATCH{}
+
+	if buildCommand, ok, err := validate.OptionalToolParam[string](request, "buildCommand"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		staticSiteDetailsPATCH.BuildCommand = &buildCommand
+		changes = diff.AppendChange(changes, "buildCommand", currentDetails.BuildCommand, &buildCommand)
+	}
+
+	if publishPath, ok, err := validate.OptionalToolParam[string](request, "publishPath"); err != nil {
+		return nil, nil, err
+	} else if ok {
+		if publishPath == "" {
+			return nil, nil, fmt.Errorf("publishPath cannot be empty")
+		}
+		staticSiteDetailsPATCH.PublishPath = &publishPath
+		changes = diff.AppendChange(changes, "publishPath", currentDetails.PublishPath, &publishPath)
+	}
+
+	serviceDetails := client.ServicePATCH_ServiceDetails{}
+	if err = serviceDetails.FromStaticSiteDetailsPATCH(staticSiteDetailsPATCH); err != nil {
+		return nil, nil, err
+	}
+
+	return validatedUpdateServiceRequest(request, current, &serviceDetails, changes)
+}
+
+func updateEnvVars(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_environment_variables",
+		mcp.WithDescription("Update environment variables for a service. "+
+			"By default, environment variables passed in will be merged with the service's "+
+			"existing environment variables. This makes it safe to update environment variables"+
+			"without pulling the existing ones into the MCP host's context. "+
+			"To replace all existing environment variables, set the 'replace' parameter to 'true'. "+
+			"By default, a new deploy is triggered to pick up the changes. Set 'deployMode' to 'none' "+
+			"when batching several edits, and deploy once the last edit is made."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update environment variables",
+			DestructiveHint: pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to update"),
+		),
+		mcp.WithBoolean("replace",
+			mcp.Description("Whether to replace all existing environment variables with the "+
+				"provided list, or merge with the existing ones. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		withDeployMode(),
+		mcp.WithArray("envVars",
+			mcp.Required(),
+			mcp.Description("The list of environment variables to update or set for the service."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"key", "value"},
+					"properties": map[string]interface{}{
+						"key": map[string]interface{}{
+							"type":        "string",
+							"description": "The name of the environment variable",
+						},
+						"value": map[string]interface{}{
+							"type":        "string",
+							"description": "The value of the environment variable",
+						},
+					},
+				},
+			),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var envVars []client.EnvVarInput
+			var ok bool
+			if envVars, ok, err = validate.EnvVars(request); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("Environment variables are required"), nil
+			}
+
+			deployMode, err := deployModeParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var envVarsToSet []client.EnvVarInput
+
+			replace, _, err := validate.OptionalToolParam[bool](request, "replace")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if replace {
+				envVarsToSet = envVars
+			} else {
+				oldEnvVars, err := serviceRepo.ListEnvVars(ctx, serviceId, &client.GetEnvVarsForServiceParams{})
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				envVarsToSet, err = mergeEnvVars(oldEnvVars, envVars)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			_, err = serviceRepo.UpdateEnvVars(ctx, serviceId, envVarsToSet)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result, err := applyDeployMode(ctx, serviceRepo, serviceId, deployMode, "Environment variables")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func mergeEnvVars(oldEnvVars []*client.EnvVar, envVarInputs []client.EnvVarInput) ([]client.EnvVarInput, error) {
+	envVarMap := make(map[string]string)
+	for _, envVar := range oldEnvVars {
+		envVarMap[envVar.Key] = envVar.Value
+	}
+
+	for _, envVarInput := range envVarInputs {
+		envVar, err := envVarInput.AsEnvVarKeyValue()
+		if err != nil {
+			return nil, err
+		}
+		envVarMap[envVar.Key] = envVar.Value
+	}
+
+	// Convert map back to list
+	var mergedEnvVars []client.EnvVarInput
+	for k, v := range envVarMap {
+		var envVarInput client.EnvVarInput
+		err := envVarInput.FromEnvVarKeyValue(client.EnvVarKeyValue{
+			Key:   k,
+			Value: v,
+		})
+		if err != nil {
+			return nil, err
+		}
+		mergedEnvVars = append(mergedEnvVars, envVarInput)
+	}
+
+	return mergedEnvVars, nil
+}
+
+// serviceInWorkspace returns a service, or an error if it isn't in the current workspace.
+func serviceInWorkspace(ctx context.Context, serviceRepo *Repo, serviceId string) (*client.Service, error) {
+	service, err := serviceRepo.GetService(ctx, serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, service.OwnerId); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func listEnvVars(serviceRepo *Repo, f *fingerprinter, reveal bool) (*mcp.Tool, server.ToolHandlerFunc) {
+	description := "List the environment variables of a service. " +
+		"Each variable is returned with the length of its value and a fingerprint of the value instead of the value itself. " +
+		"Two values with the same fingerprint are the same, so fingerprints can be used to compare values without seeing them."
+	if reveal {
+		description += " The server is configured to also return the values."
+	}
+
+	tool := mcp.NewTool("list_environment_variables",
+		mcp.WithDescription(description),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List environment variables",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			envVars, err := serviceRepo.ListEnvVars(ctx, serviceId, &client.GetEnvVarsForServiceParams{})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(summarizeEnvVars(envVars, f, reveal))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteEnvVar(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_environment_variable",
+		mcp.WithDescription("Delete an environment variable from a service. "+
+			"The service keeps using the variable until its next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete environment variable",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("key",
+			mcp.Required(),
+			mcp.Description("The name of the environment variable to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			key, err := validate.RequiredToolParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.DeleteEnvVar(ctx, serviceId, key); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Environment variable %s has been deleted from service %s. "+
+				"Deploy the service for the change to take effect.", key, serviceId)), nil
+		}
+}
+
+func diffEnvVars(serviceRepo *Repo, envGroupRepo *envgroup.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("diff_environment_variables",
+		mcp.WithDescription("Compare the environment variables of a service with those of another service or an environment group. "+
+			"Returns the keys that only one side has, the keys whose values differ, and the keys whose values are the same. "+
+			"Values are compared by the server and are never returned."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Diff environment variables",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to compare"),
+		),
+		mcp.WithString("compareServiceId",
+			mcp.Description("The ID of the service to compare against. Provide either compareServiceId or envGroupId."),
+		),
+		mcp.WithString("envGroupId",
+			mcp.Description("The ID of the environment group to compare against. Provide either compareServiceId or envGroupId."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			compareServiceId, hasCompareService, err := validate.OptionalToolParam[string](request, "compareServiceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			envGroupId, hasEnvGroup, err := validate.OptionalToolParam[string](request, "envGroupId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if hasCompareService == hasEnvGroup {
+				return mcp.NewToolResultError("Provide exactly one of compareServiceId or envGroupId"), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			source, err := serviceRepo.ListEnvVars(ctx, serviceId, &client.GetEnvVarsForServiceParams{})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var target []*client.EnvVar
+			var targetName string
+			if hasCompareService {
+				if _, err := serviceInWorkspace(ctx, serviceRepo, compareServiceId); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+
+				target, err = serviceRepo.ListEnvVars(ctx, compareServiceId, &client.GetEnvVarsForServiceParams{})
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				targetName = "service " + compareServiceId
+			} else {
+				envGroup, err := envGroupRepo.GetEnvGroup(ctx, envGroupId)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+
+				for i := range envGroup.EnvVars {
+					target = append(target, &envGroup.EnvVars[i])
+				}
+				targetName = "environment group " + envGroupId
+			}
+
+			diff := compareEnvVars(source, target)
+			diff.Source = "service " + serviceId
+			diff.Target = targetName
+
+			respJSON, err := json.Marshal(diff)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func listSecretFiles(serviceRepo *Repo, f *fingerprinter) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_secret_files",
+		mcp.WithDescription("List the secret files of a service. "+
+			"Each file is returned with its size and a fingerprint of its contents instead of the contents themselves. "+
+			"Two files with the same fingerprint have the same contents. "+
+			"Use get_secret_file to read the contents of a file when they are needed."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List secret files",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			secretFiles, err := serviceRepo.ListSecretFiles(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(summarizeSecretFiles(secretFiles, f))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func getSecretFile(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_secret_file",
+		mcp.WithDescription("Get the contents of a secret file of a service. "+
+			"Secret files usually hold credentials, so only read a file when its contents are needed."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get secret file",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the secret file"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			secretFile, err := serviceRepo.GetSecretFile(ctx, serviceId, name)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(secretFile)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func updateSecretFiles(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_secret_files",
+		mcp.WithDescription("Update secret files for a service. "+
+			"By default, secret files passed in are added to the service's existing secret files, "+
+			"overwriting files with the same name. "+
+			"To replace all existing secret files, set the 'replace' parameter to 'true'. "+
+			"By default, a new deploy is triggered to pick up the changes."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Update secret files",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to update"),
+		),
+		mcp.WithBoolean("replace",
+			mcp.Description("Whether to replace all existing secret files with the provided list, "+
+				"or merge with the existing ones. Defaults to false."),
+			mcp.DefaultBool(false),
+		),
+		withDeployMode(),
+		mcp.WithArray("secretFiles",
+			mcp.Required(),
+			mcp.Description("The secret files to add or update. Secret files are available to the service at runtime in /etc/secrets/<name>."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"name", "content"},
+					"properties": map[string]interface{}{
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "The name of the secret file",
+						},
+						"content": map[string]interface{}{
+							"type":        "string",
+							"description": "The contents of the secret file",
+						},
+					},
+				},
+			),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			secretFiles, ok, err := validate.SecretFiles(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("Secret files are required"), nil
+			}
+
+			replace, _, err := validate.OptionalToolParam[bool](request, "replace")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			deployMode, err := deployModeParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if replace {
+				if err := serviceRepo.ReplaceSecretFiles(ctx, serviceId, secretFiles); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			} else {
+				// Each file is set with its own request, so track which were set in case a later
+				// request fails. They aren't rolled back, so the MCP host needs to know about them.
+				var applied []string
+				for _, secretFile := range secretFiles {
+					if err := serviceRepo.SetSecretFile(ctx, serviceId, secretFile); err != nil {
+						message := fmt.Sprintf("error setting secret file %s: %s", secretFile.Name, err)
+						if len(applied) > 0 {
+							message += fmt.Sprintf(". These secret files were set before the error and were not rolled back: %s. No deploy was triggered",
+								strings.Join(applied, ", "))
+						}
+						return mcp.NewToolResultError(message), nil
+					}
+					applied = append(applied, secretFile.Name)
+				}
+			}
+
+			result, err := applyDeployMode(ctx, serviceRepo, serviceId, deployMode, "Secret files")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteSecretFile(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_secret_file",
+		mcp.WithDescription("Delete a secret file from a service. "+
+			"The service keeps the file until its next deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete secret file",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The name of the secret file to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.DeleteSecretFile(ctx, serviceId, name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Secret file %s has been deleted from service %s. "+
+				"Deploy the service for the change to take effect.", name, serviceId)), nil
+		}
+}
+
+func restartService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("restart_service",
+		mcp.WithDescription("Restart the running instances of a service without building or deploying it. "+
+			"Instances are replaced one at a time, so a service with more than one instance stays available."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Restart service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to restart"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.RestartService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s is restarting", serviceId)), nil
+		}
+}
+
+func suspendService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("suspend_service",
+		mcp.WithDescription("Suspend a service. A suspended service stops running and serving requests, "+
+			"and isn't billed, until it is resumed with the resume_service tool. "+
+			"Always ask the user to confirm before suspending a service."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Suspend service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to suspend"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.SuspendService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s has been suspended", serviceId)), nil
+		}
+}
+
+func resumeService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("resume_service",
+		mcp.WithDescription("Resume a suspended service. The service starts running again with its latest successful deploy."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Resume service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to resume"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.ResumeService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s has been resumed", serviceId)), nil
+		}
+}
+
+func scaleService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("scale_service",
+		mcp.WithDescription("Set the number of instances of a manually scaled service. "+
+			"Scaling changes the cost of the service, so confirm the number of instances with the user first. "+
+			"Services with autoscaling enabled ignore this setting."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Scale service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to scale"),
+		),
+		mcp.WithNumber("numInstances",
+			mcp.Required(),
+			mcp.Description("The number of instances to run"),
+			mcp.Min(1),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			numInstances, err := validate.RequiredToolParam[float64](request, "numInstances")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if numInstances < 1 || numInstances != float64(int(numInstances)) {
+				return mcp.NewToolResultError("numInstances must be a whole number of at least 1"), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.ScaleService(ctx, serviceId, int(numInstances)); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s is scaling to %d instances", serviceId, int(numInstances))), nil
+		}
+}
+
+func deleteService(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_service",
+		mcp.WithDescription("Permanently delete a service, along with its deploys, environment variables and disk. This cannot be undone. "+
+			"To confirm the deletion, confirmName must exactly match the name of the service, which you can look up with the get_service tool. "+
+			"Always ask the user to confirm before deleting a service."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete service",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service to delete"),
+		),
+		mcp.WithString("confirmName",
+			mcp.Required(),
+			mcp.Description("The name of the service, to confirm that it is the one to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			confirmName, err := validate.RequiredToolParam[string](request, "confirmName")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := serviceInWorkspace(ctx, serviceRepo, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if confirmName != service.Name {
+				return mcp.NewToolResultError(fmt.Sprintf("confirmation name %q does not match the name of service %s", confirmName, serviceId)), nil
+			}
+
+			if err := serviceRepo.DeleteService(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Service %s has been deleted", serviceId)), nil
+		}
+}
+
+func getAutoscaling(serviceRepo *Repo, metricsRepo *metrics.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_autoscaling",
+		mcp.WithDescription("Get the autoscaling configuration of a service, along with its CPU and memory utilization over the last hour. "+
+			"Utilization is reported as average and peak percentages of the instance's limit, next to the autoscaling target percentages, "+
+			"so you can explain whether the service is likely to scale up or down."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get autoscaling",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := serviceInWorkspace(ctx, serviceRepo, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			config, numInstances, err := serviceScaling(service)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(autoscalingStatus(ctx, metricsRepo, serviceId, config, numInstances))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func setAutoscaling(serviceRepo *Repo, metricsRepo *metrics.Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("set_autoscaling",
+		mcp.WithDescription("Enable or update autoscaling for a web service, private service or background worker. "+
+			"Render adds instances when the average utilization of a resource is above its target percentage, and removes them when it is below. "+
+			"At least one of cpuTargetPercentage or memoryTargetPercentage is required. "+
+			"Autoscaling changes the cost of the service, so confirm the settings with the user first. "+
+			"Returns the new configuration along with the service's utilization over the last hour."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Set autoscaling",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+		mcp.WithNumber("minInstances",
+			mcp.Required(),
+			mcp.Description("The minimum number of instances"),
+			mcp.Min(minAutoscalingInstances),
+			mcp.Max(maxAutoscalingInstances),
+		),
+		mcp.WithNumber("maxInstances",
+			mcp.Required(),
+			mcp.Description("The maximum number of instances"),
+			mcp.Min(minAutoscalingInstances),
+			mcp.Max(maxAutoscalingInstances),
+		),
+		mcp.WithNumber("cpuTargetPercentage",
+			mcp.Description("The target average CPU utilization, as a percentage of the instance's CPU limit. Leave out to not scale on CPU."),
+			mcp.Min(minAutoscalingTargetPercentage),
+			mcp.Max(maxAutoscalingTargetPercentage),
+		),
+		mcp.WithNumber("memoryTargetPercentage",
+			mcp.Description("The target average memory utilization, as a percentage of the instance's memory limit. Leave out to not scale on memory."),
+			mcp.Min(minAutoscalingTargetPercentage),
+			mcp.Max(maxAutoscalingTargetPercentage),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			config, err := autoscalingConfigFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			service, err := serviceInWorkspace(ctx, serviceRepo, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, _, err := serviceScaling(service); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updated, err := serviceRepo.SetAutoscaling(ctx, serviceId, config)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if updated == nil {
+				updated = &config
+			}
+
+			respJSON, err := json.Marshal(autoscalingStatus(ctx, metricsRepo, serviceId, updated, 0))
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func autoscalingConfigFromRequest(request mcp.CallToolRequest) (autoscalingtypes.AutoscalingConfig, error) {
+	config := autoscalingtypes.AutoscalingConfig{Enabled: true}
+
+	counts := []struct {
+		name  string
+		count *int
+	}{
+		{"minInstances", &config.Min},
+		{"maxInstances", &config.Max},
+	}
+	for _, c := range counts {
+		value, err := validate.RequiredToolParam[float64](request, c.name)
+		if err != nil {
+			return config, err
+		}
+		if value != float64(int(value)) {
+			return config, fmt.Errorf("%s must be a whole number", c.name)
+		}
+		*c.count = int(value)
+	}
+
+	targets := []struct {
+		name     string
+		criteria *autoscalingtypes.AutoscalingCriteriaPercentage
+	}{
+		{"cpuTargetPercentage", &config.Criteria.Cpu},
+		{"memoryTargetPercentage", &config.Criteria.Memory},
+	}
+	for _, target := range targets {
+		name, criteria := target.name, target.criteria
+		value, ok, err := validate.OptionalToolParam[float64](request, name)
+		if err != nil {
+			return config, err
+		}
+		if !ok {
+			continue
+		}
+		if value != float64(int(value)) {
+			return config, fmt.Errorf("%s must be a whole number", name)
+		}
+		criteria.Enabled = true
+		criteria.Percentage = int(value)
+	}
+
+	return config, validateAutoscalingConfig(config)
+}
+
+func removeAutoscaling(serviceRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("remove_autoscaling",
+		mcp.WithDescription("Turn off autoscaling for a service. The service goes back to running the number of instances it is manually scaled to, "+
+			"which can be changed with the scale_service tool."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Remove autoscaling",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		mcp.WithString("serviceId",
+			mcp.Required(),
+			mcp.Description("The ID of the service"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if _, err := serviceInWorkspace(ctx, serviceRepo, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := serviceRepo.RemoveAutoscaling(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Autoscaling has been removed from service %s", serviceId)), nil
+		}
+}
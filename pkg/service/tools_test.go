@@ -1,15 +1,19 @@
 package service
 
 import (
-	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/diff"
+	"github.com/render-oss/render-mcp-server/pkg/environment"
 	"github.com/render-oss/render-mcp-server/pkg/fakes"
 	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/session"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,7 +28,7 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 		envVarInput("KEY3", "new_value3"),
 	}
 	expectedResponseIncludes := "Environment variables updated. A new deploy has been triggered to pick up the changes."
-	expectedDeployResponse := "create deploy response"
+	expectedDeployId := "dep-123456"
 	sensitiveInfo := "sensitive information"
 
 	tests := []struct {
@@ -54,7 +58,7 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 			repo := NewRepo(fakeClient)
 
 			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
-				JSON200: &client.Service{},
+				JSON200: &client.Service{OwnerId: "own-123"},
 				HTTPResponse: &http.Response{
 					StatusCode: 200,
 				},
@@ -80,7 +84,7 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 				HTTPResponse: &http.Response{
 					StatusCode: 201,
 				},
-				Body: []byte(expectedDeployResponse),
+				JSON201: &client.Deploy{Id: expectedDeployId},
 			}, nil)
 
 			request := mcp.CallToolRequest{}
@@ -92,7 +96,7 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 
 			tool, handler := updateEnvVars(repo)
 			assert.NotNil(t, tool)
-			result, err := handler(context.Background(), request)
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -100,7 +104,7 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 			for _, content := range result.Content {
 				if textContent, ok := content.(mcp.TextContent); ok {
 					assert.Contains(t, textContent.Text, expectedResponseIncludes)
-					assert.Contains(t, textContent.Text, expectedDeployResponse)
+					assert.Contains(t, textContent.Text, expectedDeployId)
 					// Verify that we don't include sensitive info
 					assert.NotContains(t, textContent.Text, sensitiveInfo)
 				}
@@ -121,6 +125,133 @@ func TestUpdateEnvVarsTool(t *testing.T) {
 	}
 }
 
+func TestUpdateEnvVarsToolDeployMode(t *testing.T) {
+	tests := []struct {
+		name               string
+		deployMode         string
+		expectedDeploys    int
+		expectedRestarts   int
+		expectedClearCache *client.CreateDeployJSONBodyClearCache
+		expectedDeploy     bool
+		expectedError      string
+	}{
+		{
+			name:            "Deploys by default",
+			expectedDeploys: 1,
+			expectedDeploy:  true,
+		},
+		{
+			name:               "Clears the build cache before deploying",
+			deployMode:         "clearCacheDeploy",
+			expectedDeploys:    1,
+			expectedClearCache: pointers.From(client.Clear),
+			expectedDeploy:     true,
+		},
+		{
+			name:             "Restarts the service without deploying",
+			deployMode:       "restart",
+			expectedRestarts: 1,
+		},
+		{
+			name:       "Leaves the service alone",
+			deployMode: "none",
+		},
+		{
+			name:          "Rejects unknown deploy modes before updating",
+			deployMode:    "redeploy",
+			expectedError: "deployMode must be one of deploy, clearCacheDeploy, restart, none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      &client.Service{OwnerId: "own-123"},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.UpdateEnvVarsForServiceWithResponseReturns(&client.UpdateEnvVarsForServiceResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.CreateDeployWithResponseReturns(&client.CreateDeployResponse{
+				JSON201:      &client.Deploy{Id: "dep-123456"},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+			fakeClient.RestartServiceWithResponseReturns(&client.RestartServiceResponse{
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			arguments := map[string]interface{}{
+				"serviceId": "srv-123456",
+				"replace":   true,
+				"envVars":   envVarInputsAsParams([]client.EnvVarInput{envVarInput("KEY1", "value1")}),
+			}
+			if tt.deployMode != "" {
+				arguments["deployMode"] = tt.deployMode
+			}
+			request.Params.Arguments = arguments
+
+			_, handler := updateEnvVars(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.UpdateEnvVarsForServiceWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, 1, fakeClient.UpdateEnvVarsForServiceWithResponseCallCount())
+			assert.Equal(t, tt.expectedDeploys, fakeClient.CreateDeployWithResponseCallCount())
+			assert.Equal(t, tt.expectedRestarts, fakeClient.RestartServiceWithResponseCallCount())
+			if tt.expectedDeploys > 0 {
+				_, _, body, _ := fakeClient.CreateDeployWithResponseArgsForCall(0)
+				assert.Equal(t, tt.expectedClearCache, body.ClearCache)
+			}
+
+			var updateResult DeployModeResult
+			assert.NoError(t, json.Unmarshal([]byte(text), &updateResult))
+			expectedMode := tt.deployMode
+			if expectedMode == "" {
+				expectedMode = "deploy"
+			}
+			assert.Equal(t, expectedMode, updateResult.DeployMode)
+			if tt.expectedDeploy {
+				assert.Equal(t, &client.Deploy{Id: "dep-123456"}, updateResult.Deploy)
+			} else {
+				assert.Nil(t, updateResult.Deploy)
+			}
+		})
+	}
+}
+
+func TestUpdateEnvVarsToolChecksWorkspace(t *testing.T) {
+	for _, replace := range []bool{true, false} {
+		fakeClient := &fakes.FakeServiceRepoClient{}
+		fakeClient.RetrieveServiceWithResponseReturns(serviceResponse("srv-123456", "own-other"), nil)
+
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{
+			"serviceId": "srv-123456",
+			"replace":   replace,
+			"envVars":   envVarInputsAsParams([]client.EnvVarInput{envVarInput("KEY1", "value1")}),
+		}
+
+		_, handler := updateEnvVars(NewRepo(fakeClient))
+		result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+		assert.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "resource in workspace does not match")
+		assert.Equal(t, 0, fakeClient.GetEnvVarsForServiceWithResponseCallCount())
+		assert.Equal(t, 0, fakeClient.UpdateEnvVarsForServiceWithResponseCallCount())
+		assert.Equal(t, 0, fakeClient.CreateDeployWithResponseCallCount())
+	}
+}
+
 func envVarInput(key, value string) client.EnvVarInput {
 	var input client.EnvVarInput
 	input.FromEnvVarKeyValue(client.EnvVarKeyValue{
@@ -294,3 +425,834 @@ func TestMergeEnvVars(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateWebServiceTool(t *testing.T) {
+	ownerId := "own-123456"
+
+	tests := []struct {
+		name            string
+		arguments       map[string]interface{}
+		service         *client.Service
+		expectUpdate    bool
+		expectedChanges []diff.FieldChange
+		expectedError   string
+	}{
+		{
+			name: "Applies changed fields and reports a diff",
+			arguments: map[string]interface{}{
+				"startCommand":    "npm run serve",
+				"buildCommand":    "npm ci",
+				"plan":            "standard",
+				"healthCheckPath": "/healthz",
+				"branch":          "release",
+			},
+			service:      webService(t, ownerId, client.ServiceRuntimeNode),
+			expectUpdate: true,
+			expectedChanges: []diff.FieldChange{
+				{Field: "startCommand", Before: "npm start", After: "npm run serve"},
+				{Field: "plan", Before: "starter", After: "standard"},
+				{Field: "healthCheckPath", Before: "", After: "/healthz"},
+				{Field: "branch", Before: "main", After: "release"},
+			},
+		},
+		{
+			name: "Dry run reports a diff without updating",
+			arguments: map[string]interface{}{
+				"dryRun":           true,
+				"preDeployCommand": "npm run migrate",
+			},
+			service:      webService(t, ownerId, client.ServiceRuntimeNode),
+			expectUpdate: false,
+			expectedChanges: []diff.FieldChange{
+				{Field: "preDeployCommand", Before: "", After: "npm run migrate"},
+			},
+		},
+		{
+			name: "No changes does not update",
+			arguments: map[string]interface{}{
+				"branch": "main",
+			},
+			service:      webService(t, ownerId, client.ServiceRuntimeNode),
+			expectUpdate: false,
+		},
+		{
+			name: "Rejects invalid plan",
+			arguments: map[string]interface{}{
+				"plan": "free",
+			},
+			service:       webService(t, ownerId, client.ServiceRuntimeNode),
+			expectedError: "MCP server doesn't support free plans",
+		},
+		{
+			name: "Rejects invalid health check path",
+			arguments: map[string]interface{}{
+				"healthCheckPath": "healthz",
+			},
+			service:       webService(t, ownerId, client.ServiceRuntimeNode),
+			expectedError: "healthCheckPath must start with a '/'",
+		},
+		{
+			name: "Rejects start command for Docker services",
+			arguments: map[string]interface{}{
+				"startCommand": "npm start",
+			},
+			service:       webService(t, ownerId, client.ServiceRuntimeDocker),
+			expectedError: "not supported for services with the docker runtime",
+		},
+		{
+			name: "Rejects services outside the current workspace",
+			arguments: map[string]interface{}{
+				"branch": "release",
+			},
+			service:       webService(t, "own-other", client.ServiceRuntimeNode),
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := session.ContextWithTestWorkspace(t, ownerId)
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			repo := NewRepo(fakeClient)
+
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      tt.service,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.UpdateServiceWithResponseReturns(&client.UpdateServiceResponse{
+				JSON200:      tt.service,
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"serviceId": tt.service.Id}
+			for k, v := range tt.arguments {
+				request.Params.Arguments.(map[string]interface{})[k] = v
+			}
+
+			_, handler := updateWebService(repo)
+			result, err := handler(ctx, request)
+			assert.NoError(t, err)
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.UpdateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError)
+			if tt.expectedChanges == nil {
+				assert.Contains(t, text, "No changes to apply")
+				assert.Equal(t, 0, fakeClient.UpdateServiceWithResponseCallCount())
+				return
+			}
+
+			var updateResult UpdateResult
+			assert.NoError(t, json.Unmarshal([]byte(text), &updateResult))
+			assert.Equal(t, tt.expectedChanges, updateResult.Changes)
+
+			if !tt.expectUpdate {
+				assert.True(t, updateResult.DryRun)
+				assert.Equal(t, 0, fakeClient.UpdateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.Equal(t, 1, fakeClient.UpdateServiceWithResponseCallCount())
+			_, updatedServiceId, body, _ := fakeClient.UpdateServiceWithResponseArgsForCall(0)
+			assert.Equal(t, tt.service.Id, updatedServiceId)
+			assert.Equal(t, "release", *body.Branch)
+
+			details, err := body.ServiceDetails.AsWebServiceDetailsPATCH()
+			assert.NoError(t, err)
+			assert.Equal(t, client.PaidPlanStandard, *details.Plan)
+			assert.Equal(t, "/healthz", *details.HealthCheckPath)
+			envDetails, err := details.EnvSpecificDetails.AsNativeEnvironmentDetailsPATCH()
+			assert.NoError(t, err)
+			assert.Equal(t, "npm run serve", *envDetails.StartCommand)
+		})
+	}
+}
+
+func TestUpdateStaticSiteToolRejectsWebService(t *testing.T) {
+	ownerId := "own-123456"
+	ctx := session.ContextWithTestWorkspace(t, ownerId)
+	fakeClient := &fakes.FakeServiceRepoClient{}
+	service := webService(t, ownerId, client.ServiceRuntimeNode)
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200:      service,
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId":   service.Id,
+		"publishPath": "dist",
+	}
+
+	_, handler := updateStaticSite(NewRepo(fakeClient))
+	result, err := handler(ctx, request)
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "not a static site")
+	assert.Equal(t, 0, fakeClient.UpdateServiceWithResponseCallCount())
+}
+
+func webService(t *testing.T, ownerId string, runtime client.ServiceRuntime) *client.Service {
+	var envSpecificDetails client.EnvSpecificDetails
+	assert.NoError(t, envSpecificDetails.FromNativeEnvironmentDetails(client.NativeEnvironmentDetails{
+		BuildCommand: "npm ci",
+		StartCommand: "npm start",
+	}))
+
+	var serviceDetails client.Service_ServiceDetails
+	assert.NoError(t, serviceDetails.FromWebServiceDetails(client.WebServiceDetails{
+		EnvSpecificDetails: envSpecificDetails,
+		Plan:               client.Plan(client.PaidPlanStarter),
+		Runtime:            runtime,
+	}))
+
+	return &client.Service{
+		Id:             "srv-123456",
+		OwnerId:        ownerId,
+		Type:           client.WebService,
+		Branch:         pointers.From("main"),
+		AutoDeploy:     client.AutoDeployYes,
+		ServiceDetails: serviceDetails,
+	}
+}
+
+func TestServiceControlTools(t *testing.T) {
+	fakeClientWithOwner := func(ownerId string) *fakes.FakeServiceRepoClient {
+		fakeClient := &fakes.FakeServiceRepoClient{}
+		fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+			JSON200:      &client.Service{Id: "srv-123", Name: "my-api", OwnerId: ownerId},
+			HTTPResponse: &http.Response{StatusCode: 200},
+		}, nil)
+		fakeClient.RestartServiceWithResponseReturns(&client.RestartServiceResponse{HTTPResponse: &http.Response{StatusCode: 200}}, nil)
+		fakeClient.SuspendServiceWithResponseReturns(&client.SuspendServiceResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+		fakeClient.ResumeServiceWithResponseReturns(&client.ResumeServiceResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+		fakeClient.ScaleServiceWithResponseReturns(&client.ScaleServiceResponse{HTTPResponse: &http.Response{StatusCode: 202}}, nil)
+		fakeClient.DeleteServiceWithResponseReturns(&client.DeleteServiceResponse{HTTPResponse: &http.Response{StatusCode: 204}}, nil)
+		return fakeClient
+	}
+	callCounts := func(fakeClient *fakes.FakeServiceRepoClient) map[string]int {
+		return map[string]int{
+			"restart": fakeClient.RestartServiceWithResponseCallCount(),
+			"suspend": fakeClient.SuspendServiceWithResponseCallCount(),
+			"resume":  fakeClient.ResumeServiceWithResponseCallCount(),
+			"scale":   fakeClient.ScaleServiceWithResponseCallCount(),
+			"delete":  fakeClient.DeleteServiceWithResponseCallCount(),
+		}
+	}
+	noCalls := map[string]int{"restart": 0, "suspend": 0, "resume": 0, "scale": 0, "delete": 0}
+
+	tests := []struct {
+		name          string
+		tool          func(*Repo) (*mcp.Tool, server.ToolHandlerFunc)
+		arguments     map[string]interface{}
+		ownerId       string
+		expectedCall  string
+		expectedError string
+	}{
+		{name: "Restarts a service", tool: restartService, expectedCall: "restart"},
+		{name: "Suspends a service", tool: suspendService, expectedCall: "suspend"},
+		{name: "Resumes a service", tool: resumeService, expectedCall: "resume"},
+		{
+			name:         "Scales a service",
+			tool:         scaleService,
+			arguments:    map[string]interface{}{"numInstances": float64(3)},
+			expectedCall: "scale",
+		},
+		{
+			name:          "Rejects fractional instance counts",
+			tool:          scaleService,
+			arguments:     map[string]interface{}{"numInstances": 1.5},
+			expectedError: "numInstances must be a whole number of at least 1",
+		},
+		{
+			name:         "Deletes a service when the name is confirmed",
+			tool:         deleteService,
+			arguments:    map[string]interface{}{"confirmName": "my-api"},
+			expectedCall: "delete",
+		},
+		{
+			name:          "Does not delete a service when the name doesn't match",
+			tool:          deleteService,
+			arguments:     map[string]interface{}{"confirmName": "my-other-api"},
+			expectedError: `confirmation name "my-other-api" does not match the name of service srv-123`,
+		},
+		{
+			name:          "Does not suspend a service in another workspace",
+			tool:          suspendService,
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+		{
+			name:          "Does not delete a service in another workspace",
+			tool:          deleteService,
+			arguments:     map[string]interface{}{"confirmName": "my-api"},
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ownerId := tt.ownerId
+			if ownerId == "" {
+				ownerId = "own-123"
+			}
+			fakeClient := fakeClientWithOwner(ownerId)
+
+			request := mcp.CallToolRequest{}
+			arguments := map[string]interface{}{"serviceId": "srv-123"}
+			for k, v := range tt.arguments {
+				arguments[k] = v
+			}
+			request.Params.Arguments = arguments
+
+			_, handler := tt.tool(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, noCalls, callCounts(fakeClient))
+				return
+			}
+
+			assert.False(t, result.IsError)
+			expectedCalls := map[string]int{"restart": 0, "suspend": 0, "resume": 0, "scale": 0, "delete": 0}
+			expectedCalls[tt.expectedCall] = 1
+			assert.Equal(t, expectedCalls, callCounts(fakeClient))
+
+			if tt.expectedCall == "scale" {
+				_, serviceId, body, _ := fakeClient.ScaleServiceWithResponseArgsForCall(0)
+				assert.Equal(t, "srv-123", serviceId)
+				assert.Equal(t, 3, body.NumInstances)
+			}
+		})
+	}
+}
+
+func TestListServicesToolFiltersByProject(t *testing.T) {
+	fakeClient := &fakes.FakeServiceRepoClient{}
+	fakeClient.ListServicesWithResponseReturns(&client.ListServicesResponse{
+		JSON200:      &[]client.ServiceWithCursor{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	fakeEnvironmentClient := &fakes.FakeEnvironmentRepoClient{}
+	fakeEnvironmentClient.RetrieveProjectWithResponseReturns(&client.RetrieveProjectResponse{
+		JSON200: &client.Project{
+			Id:             "prj-123",
+			Owner:          client.Owner{Id: "own-123"},
+			EnvironmentIds: []string{"evm-1", "evm-2"},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := listServices(NewRepo(fakeClient), environment.NewRepo(fakeEnvironmentClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"projectId": "prj-123",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	assert.Equal(t, 1, fakeClient.ListServicesWithResponseCallCount())
+	_, params, _ := fakeClient.ListServicesWithResponseArgsForCall(0)
+	assert.Equal(t, &client.EnvironmentIdParam{"evm-1", "evm-2"}, params.EnvironmentId)
+}
+
+func TestListServicesToolProjectWithoutEnvironments(t *testing.T) {
+	fakeClient := &fakes.FakeServiceRepoClient{}
+
+	fakeEnvironmentClient := &fakes.FakeEnvironmentRepoClient{}
+	fakeEnvironmentClient.RetrieveProjectWithResponseReturns(&client.RetrieveProjectResponse{
+		JSON200: &client.Project{
+			Id:             "prj-123",
+			Owner:          client.Owner{Id: "own-123"},
+			EnvironmentIds: []string{},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := listServices(NewRepo(fakeClient), environment.NewRepo(fakeEnvironmentClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"projectId": "prj-123",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "No services found", result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, 0, fakeClient.ListServicesWithResponseCallCount())
+}
+
+func TestCreateCronJobTool(t *testing.T) {
+	arguments := func(overrides map[string]interface{}) map[string]interface{} {
+		arguments := map[string]interface{}{
+			"name":         "nightly-report",
+			"schedule":     "0 3 * * *",
+			"runtime":      "python",
+			"buildCommand": "pip install -r requirements.txt",
+			"startCommand": "python report.py",
+			"envVars": []interface{}{
+				map[string]interface{}{"key": "REPORT_BUCKET", "value": "reports"},
+			},
+			"previewRuns": float64(2),
+		}
+		for k, v := range overrides {
+			arguments[k] = v
+		}
+		return arguments
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectCreate  bool
+		expectedError string
+	}{
+		{
+			name:         "Creates a cron job",
+			arguments:    arguments(nil),
+			expectCreate: true,
+		},
+		{
+			name:      "Previews the schedule without creating on a dry run",
+			arguments: arguments(map[string]interface{}{"dryRun": true}),
+		},
+		{
+			name:          "Rejects an invalid schedule",
+			arguments:     arguments(map[string]interface{}{"schedule": "0 25 * * *"}),
+			expectedError: "value 25 out of range 0-23 in hour field",
+		},
+		{
+			name:          "Rejects too many preview runs",
+			arguments:     arguments(map[string]interface{}{"previewRuns": float64(maxCronPreviewRuns + 1)}),
+			expectedError: "previewRuns must be a whole number between 1 and 20",
+		},
+		{
+			name:          "Rejects the docker runtime",
+			arguments:     arguments(map[string]interface{}{"runtime": "docker"}),
+			expectedError: "cron jobs with the docker runtime can't be created with this tool",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.CreateServiceWithResponseReturns(&client.CreateServiceResponse{
+				JSON201:      &client.ServiceAndDeploy{Service: &client.Service{Id: "crn-123"}},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+
+			_, handler := createCronJob(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.CreateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError, result.Content)
+			var response CreateCronJobResult
+			assert.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &response))
+			assert.Equal(t, "0 3 * * *", response.Schedule.Schedule)
+			assert.Len(t, response.Schedule.NextRuns, 2)
+
+			if !tt.expectCreate {
+				assert.Nil(t, response.Service)
+				assert.Equal(t, 0, fakeClient.CreateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.Equal(t, "crn-123", response.Service.Service.Id)
+			assert.Equal(t, 1, fakeClient.CreateServiceWithResponseCallCount())
+			_, body, _ := fakeClient.CreateServiceWithResponseArgsForCall(0)
+			assert.Equal(t, "nightly-report", body.Name)
+			assert.Equal(t, "own-123", body.OwnerId)
+			assert.Equal(t, client.CronJob, body.Type)
+			assert.Equal(t, []client.EnvVarInput{envVarInput("REPORT_BUCKET", "reports")}, *body.EnvVars)
+
+			details, err := body.ServiceDetails.AsCronJobDetailsPOST()
+			assert.NoError(t, err)
+			assert.Equal(t, "0 3 * * *", details.Schedule)
+			assert.Equal(t, client.ServiceRuntime("python"), details.Runtime)
+			native, err := details.EnvSpecificDetails.AsNativeEnvironmentDetails()
+			assert.NoError(t, err)
+			assert.Equal(t, "python report.py", native.StartCommand)
+		})
+	}
+}
+
+func TestCronJobRunTools(t *testing.T) {
+	tests := []struct {
+		name          string
+		tool          func(*Repo) (*mcp.Tool, server.ToolHandlerFunc)
+		serviceType   client.ServiceType
+		ownerId       string
+		expectedError string
+	}{
+		{name: "Runs a cron job", tool: runCronJob},
+		{name: "Cancels a cron job run", tool: cancelCronJobRun},
+		{
+			name:          "Does not run a web service",
+			tool:          runCronJob,
+			serviceType:   client.WebService,
+			expectedError: "service crn-123 is a web_service, not a cron job",
+		},
+		{
+			name:          "Does not cancel a run in another workspace",
+			tool:          cancelCronJobRun,
+			ownerId:       "own-other",
+			expectedError: "resource in workspace does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceType := tt.serviceType
+			if serviceType == "" {
+				serviceType = client.CronJob
+			}
+			ownerId := tt.ownerId
+			if ownerId == "" {
+				ownerId = "own-123"
+			}
+
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+				JSON200:      &client.Service{Id: "crn-123", OwnerId: ownerId, Type: serviceType},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.RunCronJobWithResponseReturns(&client.RunCronJobResponse{
+				JSON200:      &client.CronJobRun{Id: "crn-run-1", Status: client.CronJobRunStatusPending},
+				HTTPResponse: &http.Response{StatusCode: 200},
+			}, nil)
+			fakeClient.CancelCronJobRunWithResponseReturns(&client.CancelCronJobRunResponse{
+				HTTPResponse: &http.Response{StatusCode: 204},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"serviceId": "crn-123"}
+
+			_, handler := tt.tool(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			calls := fakeClient.RunCronJobWithResponseCallCount() + fakeClient.CancelCronJobRunWithResponseCallCount()
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, calls)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestCreateInstanceServiceTools(t *testing.T) {
+	arguments := func(overrides map[string]interface{}) map[string]interface{} {
+		arguments := map[string]interface{}{
+			"name":             "queue-consumer",
+			"runtime":          "node",
+			"buildCommand":     "npm ci",
+			"startCommand":     "node worker.js",
+			"preDeployCommand": "npm run migrate",
+			"numInstances":     float64(2),
+			"plan":             "standard",
+		}
+		for k, v := range overrides {
+			arguments[k] = v
+		}
+		return arguments
+	}
+
+	tests := []struct {
+		name                string
+		tool                func(*Repo) (*mcp.Tool, server.ToolHandlerFunc)
+		arguments           map[string]interface{}
+		expectedServiceType client.ServiceType
+		expectedDisk        *client.ServiceDisk
+		expectedError       string
+	}{
+		{
+			name:                "Creates a background worker",
+			tool:                createBackgroundWorker,
+			arguments:           arguments(nil),
+			expectedServiceType: client.BackgroundWorker,
+		},
+		{
+			name:                "Creates a private service",
+			tool:                createPrivateService,
+			arguments:           arguments(nil),
+			expectedServiceType: client.PrivateService,
+		},
+		{
+			name: "Creates a private service with a disk",
+			tool: createPrivateService,
+			arguments: arguments(map[string]interface{}{
+				"numInstances": float64(1),
+				"disk":         map[string]interface{}{"name": "data", "mountPath": "/var/data", "sizeGB": float64(10)},
+			}),
+			expectedServiceType: client.PrivateService,
+			expectedDisk:        &client.ServiceDisk{Name: "data", MountPath: "/var/data", SizeGB: pointers.From(10)},
+		},
+		{
+			name:          "Rejects fractional instance counts",
+			tool:          createBackgroundWorker,
+			arguments:     arguments(map[string]interface{}{"numInstances": 1.5}),
+			expectedError: "numInstances must be a whole number of at least 1",
+		},
+		{
+			name: "Rejects a disk on more than one instance",
+			tool: createBackgroundWorker,
+			arguments: arguments(map[string]interface{}{
+				"disk": map[string]interface{}{"name": "data", "mountPath": "/var/data"},
+			}),
+			expectedError: "a service with a disk can only run one instance",
+		},
+		{
+			name: "Rejects a relative disk mount path",
+			tool: createPrivateService,
+			arguments: arguments(map[string]interface{}{
+				"numInstances": float64(1),
+				"disk":         map[string]interface{}{"name": "data", "mountPath": "var/data"},
+			}),
+			expectedError: `disk mountPath "var/data" must be an absolute path`,
+		},
+		{
+			name: "Rejects a disk without a name",
+			tool: createPrivateService,
+			arguments: arguments(map[string]interface{}{
+				"numInstances": float64(1),
+				"disk":         map[string]interface{}{"mountPath": "/var/data"},
+			}),
+			expectedError: "disk name is required",
+		},
+		{
+			name:          "Rejects free plans",
+			tool:          createPrivateService,
+			arguments:     arguments(map[string]interface{}{"plan": "free"}),
+			expectedError: "MCP server doesn't support free plans",
+		},
+		{
+			name: "Requires a start command",
+			tool: createBackgroundWorker,
+			arguments: map[string]interface{}{
+				"name":         "queue-consumer",
+				"runtime":      "node",
+				"buildCommand": "npm ci",
+			},
+			expectedError: "required parameter not present: startCommand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.CreateServiceWithResponseReturns(&client.CreateServiceResponse{
+				JSON201:      &client.ServiceAndDeploy{Service: &client.Service{Id: "srv-123"}},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+
+			_, handler := tt.tool(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.CreateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError, result.Content)
+			assert.Equal(t, 1, fakeClient.CreateServiceWithResponseCallCount())
+			_, body, _ := fakeClient.CreateServiceWithResponseArgsForCall(0)
+			assert.Equal(t, "queue-consumer", body.Name)
+			assert.Equal(t, "own-123", body.OwnerId)
+			assert.Equal(t, tt.expectedServiceType, body.Type)
+
+			// Both detail types have the same fields, so either can be used to check the request.
+			details, err := body.ServiceDetails.AsPrivateServiceDetailsPOST()
+			assert.NoError(t, err)
+			assert.Equal(t, client.ServiceRuntimeNode, details.Runtime)
+			assert.Equal(t, pointers.From(client.PaidPlanStandard), details.Plan)
+			assert.Equal(t, pointers.From("npm run migrate"), details.PreDeployCommand)
+			assert.Equal(t, tt.expectedDisk, details.Disk)
+			native, err := details.EnvSpecificDetails.AsNativeEnvironmentDetailsPOST()
+			assert.NoError(t, err)
+			assert.Equal(t, "node worker.js", native.StartCommand)
+		})
+	}
+}
+
+func TestCreateWebServiceToolValidatesHealthCheckPath(t *testing.T) {
+	fakeClient := &fakes.FakeServiceRepoClient{}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"name":            "my-api",
+		"runtime":         "node",
+		"buildCommand":    "npm ci",
+		"startCommand":    "npm start",
+		"healthCheckPath": "healthz",
+	}
+
+	_, handler := createWebService(NewRepo(fakeClient))
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "healthCheckPath must start with a '/'")
+	assert.Equal(t, 0, fakeClient.CreateServiceWithResponseCallCount())
+}
+
+func TestCreateWebServiceToolRuntimes(t *testing.T) {
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		check         func(t *testing.T, body client.CreateServiceJSONRequestBody)
+		expectedError string
+	}{
+		{
+			name: "Builds a Dockerfile",
+			arguments: map[string]interface{}{
+				"runtime":              "docker",
+				"repo":                 "https://github.com/acme/api",
+				"dockerfilePath":       "./docker/Dockerfile.prod",
+				"dockerContext":        "./api",
+				"startCommand":         "./bin/server --port 10000",
+				"registryCredentialId": "rgc-123",
+			},
+			check: func(t *testing.T, body client.CreateServiceJSONRequestBody) {
+				assert.Nil(t, body.Image)
+				details, err := body.ServiceDetails.AsWebServiceDetailsPOST()
+				assert.NoError(t, err)
+				assert.Equal(t, client.ServiceRuntimeDocker, details.Runtime)
+				docker, err := details.EnvSpecificDetails.AsDockerDetailsPOST()
+				assert.NoError(t, err)
+				assert.Equal(t, client.DockerDetailsPOST{
+					DockerfilePath:       pointers.From("./docker/Dockerfile.prod"),
+					DockerContext:        pointers.From("./api"),
+					DockerCommand:        pointers.From("./bin/server --port 10000"),
+					RegistryCredentialId: pointers.From("rgc-123"),
+				}, docker)
+			},
+		},
+		{
+			name: "Deploys a prebuilt image",
+			arguments: map[string]interface{}{
+				"runtime":              "image",
+				"imageUrl":             "ghcr.io/acme/api:v1.2.0",
+				"registryCredentialId": "rgc-123",
+			},
+			check: func(t *testing.T, body client.CreateServiceJSONRequestBody) {
+				assert.Nil(t, body.Repo)
+				assert.Equal(t, &client.Image{
+					ImagePath:            "ghcr.io/acme/api:v1.2.0",
+					OwnerId:              "own-123",
+					RegistryCredentialId: pointers.From("rgc-123"),
+				}, body.Image)
+				details, err := body.ServiceDetails.AsWebServiceDetailsPOST()
+				assert.NoError(t, err)
+				assert.Equal(t, client.ServiceRuntimeImage, details.Runtime)
+				assert.Nil(t, details.EnvSpecificDetails)
+			},
+		},
+		{
+			name:          "Requires an image URL for the image runtime",
+			arguments:     map[string]interface{}{"runtime": "image"},
+			expectedError: "imageUrl is required for the image runtime",
+		},
+		{
+			name: "Rejects a repo for the image runtime",
+			arguments: map[string]interface{}{
+				"runtime":  "image",
+				"imageUrl": "ghcr.io/acme/api:v1.2.0",
+				"repo":     "https://github.com/acme/api",
+			},
+			expectedError: "repo can't be used with the image runtime",
+		},
+		{
+			name: "Rejects a build command for the docker runtime",
+			arguments: map[string]interface{}{
+				"runtime":      "docker",
+				"buildCommand": "npm ci",
+			},
+			expectedError: "buildCommand can't be used with the docker runtime",
+		},
+		{
+			name: "Rejects Docker options for native runtimes",
+			arguments: map[string]interface{}{
+				"runtime":        "node",
+				"buildCommand":   "npm ci",
+				"startCommand":   "npm start",
+				"dockerfilePath": "./Dockerfile",
+			},
+			expectedError: "dockerfilePath can't be used with the node runtime",
+		},
+		{
+			name: "Requires a build command for native runtimes",
+			arguments: map[string]interface{}{
+				"runtime":      "python",
+				"startCommand": "gunicorn app:app",
+			},
+			expectedError: "required parameter not present: buildCommand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &fakes.FakeServiceRepoClient{}
+			fakeClient.CreateServiceWithResponseReturns(&client.CreateServiceResponse{
+				JSON201:      &client.ServiceAndDeploy{Service: &client.Service{Id: "srv-123"}},
+				HTTPResponse: &http.Response{StatusCode: 201},
+			}, nil)
+
+			request := mcp.CallToolRequest{}
+			arguments := map[string]interface{}{"name": "my-api"}
+			for k, v := range tt.arguments {
+				arguments[k] = v
+			}
+			request.Params.Arguments = arguments
+
+			_, handler := createWebService(NewRepo(fakeClient))
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			assert.NoError(t, err)
+
+			if tt.expectedError != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expectedError)
+				assert.Equal(t, 0, fakeClient.CreateServiceWithResponseCallCount())
+				return
+			}
+
+			assert.False(t, result.IsError, result.Content)
+			assert.Equal(t, 1, fakeClient.CreateServiceWithResponseCallCount())
+			_, body, _ := fakeClient.CreateServiceWithResponseArgsForCall(0)
+			tt.check(t, body)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package session
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ContextWithTestWorkspace returns a context with a stdio session that has the given workspace
+// selected. The session's config is written to a temporary directory for the duration of the test.
+func ContextWithTestWorkspace(t *testing.T, workspace string) context.Context {
+	t.Helper()
+	t.Setenv("RENDER_CONFIG_PATH", filepath.Join(t.TempDir(), "mcp-server.yaml"))
+	ctx := ContextWithStdioSession(context.Background())
+	require.NoError(t, FromContext(ctx).SetWorkspace(ctx, workspace))
+	return ctx
+}
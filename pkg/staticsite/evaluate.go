@@ -0,0 +1,128 @@
+package staticsite
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+)
+
+// evaluationNote explains the limits of a dry run, since it can't see which files a site has published.
+const evaluationNote = "Routes only apply when no published file matches the request path, so a file at this path " +
+	"would be served instead of the matched route. Header rules apply to every matching response."
+
+type MatchedRoute struct {
+	Route client.Route `json:"route"`
+	// Destination is the route's destination with the path's wildcard and placeholder values substituted.
+	Destination string `json:"destination"`
+}
+
+type Evaluation struct {
+	Path    string          `json:"path"`
+	Route   *MatchedRoute   `json:"route"`
+	Headers []client.Header `json:"headers"`
+	Note    string          `json:"note"`
+}
+
+// evaluate reports which route and header rules would apply to a request path. Routes must be in
+// priority order: the first matching route wins, while every matching header rule applies.
+func evaluate(path string, routes []*client.Route, headers []*client.Header) (*Evaluation, error) {
+	path, _, _ = strings.Cut(path, "?")
+	path, _, _ = strings.Cut(path, "#")
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q must start with /", path)
+	}
+
+	result := &Evaluation{
+		Path:    path,
+		Headers: []client.Header{},
+		Note:    evaluationNote,
+	}
+
+	for _, route := range routes {
+		values, ok := match(route.Source, path)
+		if !ok {
+			continue
+		}
+		result.Route = &MatchedRoute{
+			Route:       *route,
+			Destination: substitute(route.Destination, values),
+		}
+		break
+	}
+
+	for _, header := range headers {
+		if _, ok := match(header.Path, path); ok {
+			result.Headers = append(result.Headers, *header)
+		}
+	}
+
+	return result, nil
+}
+
+// match reports whether path matches a route source or header path pattern. A * matches any
+// remaining characters and a :name segment matches a single path segment. It returns the matched
+// values keyed by placeholder name, with the wildcard under "*".
+func match(pattern string, path string) (map[string]string, bool) {
+	// A trailing slash is optional, so /blog/ matches /blog and the other way around.
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	var expr strings.Builder
+	var names []string
+	expr.WriteString("^")
+
+	for i := 0; i < len(pattern); {
+		switch {
+		case pattern[i] == '*':
+			expr.WriteString("(.*)")
+			names = append(names, "*")
+			i++
+		case pattern[i] == ':' && (i == 0 || pattern[i-1] == '/'):
+			end := i + 1
+			for end < len(pattern) && pattern[end] != '/' {
+				end++
+			}
+			expr.WriteString("([^/]+)")
+			names = append(names, pattern[i:end])
+			i = end
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			i++
+		}
+	}
+	expr.WriteString("/?$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, false
+	}
+	matches := re.FindStringSubmatch(path)
+	if matches == nil {
+		return nil, false
+	}
+
+	values := make(map[string]string, len(names))
+	for i, name := range names {
+		values[name] = matches[i+1]
+	}
+	return values, true
+}
+
+// substitute fills a route destination's wildcard and placeholders with the values matched from the source.
+func substitute(destination string, values map[string]string) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	// Replace longer names first so :id doesn't clobber part of :identifier.
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+
+	for _, name := range names {
+		destination = strings.ReplaceAll(destination, name, values[name])
+	}
+	return destination
+}
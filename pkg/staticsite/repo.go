@@ -0,0 +1,219 @@
+package staticsite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+// The generated PatchRoute takes a priority but no route ID, so it can't say which route to move.
+// Routes are moved by replacing the whole list with PutRoutes instead.
+//
+//go:generate go tool counterfeiter -o ../fakes/fakestaticsiterepoclient_gen.go . staticSiteRepoClient
+type staticSiteRepoClient interface {
+	ListRoutesWithResponse(ctx context.Context, serviceId string, params *client.ListRoutesParams, reqEditors ...client.RequestEditorFn) (*client.ListRoutesResponse, error)
+	AddRouteWithResponse(ctx context.Context, serviceId string, body client.AddRouteJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AddRouteResponse, error)
+	PutRoutesWithResponse(ctx context.Context, serviceId string, body client.PutRoutesJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.PutRoutesResponse, error)
+	DeleteRouteWithResponse(ctx context.Context, serviceId string, routeId string, reqEditors ...client.RequestEditorFn) (*client.DeleteRouteResponse, error)
+	ListHeadersWithResponse(ctx context.Context, serviceId string, params *client.ListHeadersParams, reqEditors ...client.RequestEditorFn) (*client.ListHeadersResponse, error)
+	AddHeadersWithResponse(ctx context.Context, serviceId string, body client.AddHeadersJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.AddHeadersResponse, error)
+	UpdateHeadersWithResponse(ctx context.Context, serviceId string, body client.UpdateHeadersJSONRequestBody, reqEditors ...client.RequestEditorFn) (*client.UpdateHeadersResponse, error)
+	DeleteHeaderWithResponse(ctx context.Context, serviceId string, headerId string, reqEditors ...client.RequestEditorFn) (*client.DeleteHeaderResponse, error)
+	RetrieveServiceWithResponse(ctx context.Context, id string, reqEditors ...client.RequestEditorFn) (*client.RetrieveServiceResponse, error)
+}
+
+// Repo calls the static site API. Methods that take a service ID don't check that it's a static site
+// in the current workspace, so callers must check it first with ValidateStaticSite.
+type Repo struct {
+	client staticSiteRepoClient
+}
+
+func NewRepo(c staticSiteRepoClient) *Repo {
+	return &Repo{
+		client: c,
+	}
+}
+
+// ValidateStaticSite returns an error if a service isn't a static site in the current workspace.
+func (r *Repo) ValidateStaticSite(ctx context.Context, serviceId string) error {
+	resp, err := r.client.RetrieveServiceWithResponse(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return err
+	}
+
+	if err := validate.WorkspaceMatches(ctx, resp.JSON200.OwnerId); err != nil {
+		return err
+	}
+
+	if resp.JSON200.Type != client.StaticSite {
+		return fmt.Errorf("service %s is a %s, routes and headers are only available for static sites", serviceId, resp.JSON200.Type)
+	}
+
+	return nil
+}
+
+type ListRoutesParams struct {
+	*client.ListRoutesParams
+	serviceId string
+}
+
+// ListRoutes returns the routes of a static site in priority order.
+func (r *Repo) ListRoutes(ctx context.Context, serviceId string) ([]*client.Route, error) {
+	params := &ListRoutesParams{
+		ListRoutesParams: &client.ListRoutesParams{},
+		serviceId:        serviceId,
+	}
+	routes, err := client.ListAll(ctx, params, r.listRoutesPage)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Priority < routes[j].Priority
+	})
+	return routes, nil
+}
+
+func (r *Repo) listRoutesPage(ctx context.Context, params *ListRoutesParams) ([]*client.Route, *client.Cursor, error) {
+	resp, err := r.client.ListRoutesWithResponse(ctx, params.serviceId, params.ListRoutesParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	routes := make([]*client.Route, 0, len(res))
+	for _, routeWithCursor := range res {
+		routes = append(routes, &routeWithCursor.Route)
+	}
+
+	return routes, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) AddRoute(ctx context.Context, serviceId string, route client.RoutePost) (*client.Route, error) {
+	resp, err := r.client.AddRouteWithResponse(ctx, serviceId, route)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
+
+// ReplaceRoutes replaces all routes of a static site. Routes are given priorities in list order.
+func (r *Repo) ReplaceRoutes(ctx context.Context, serviceId string, routes []client.RoutePut) ([]client.Route, error) {
+	resp, err := r.client.PutRoutesWithResponse(ctx, serviceId, routes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []client.Route{}, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) DeleteRoute(ctx context.Context, serviceId string, routeId string) error {
+	resp, err := r.client.DeleteRouteWithResponse(ctx, serviceId, routeId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+type ListHeadersParams struct {
+	*client.ListHeadersParams
+	serviceId string
+}
+
+func (r *Repo) ListHeaders(ctx context.Context, serviceId string) ([]*client.Header, error) {
+	params := &ListHeadersParams{
+		ListHeadersParams: &client.ListHeadersParams{},
+		serviceId:         serviceId,
+	}
+	return client.ListAll(ctx, params, r.listHeadersPage)
+}
+
+func (r *Repo) listHeadersPage(ctx context.Context, params *ListHeadersParams) ([]*client.Header, *client.Cursor, error) {
+	resp, err := r.client.ListHeadersWithResponse(ctx, params.serviceId, params.ListHeadersParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	headers := make([]*client.Header, 0, len(res))
+	for _, headerWithCursor := range res {
+		headers = append(headers, &headerWithCursor.Header)
+	}
+
+	return headers, &res[len(res)-1].Cursor, nil
+}
+
+func (r *Repo) AddHeader(ctx context.Context, serviceId string, header client.HeaderInput) (*client.Header, error) {
+	resp, err := r.client.AddHeadersWithResponse(ctx, serviceId, header)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, nil
+	}
+
+	return resp.JSON201.Headers, nil
+}
+
+func (r *Repo) ReplaceHeaders(ctx context.Context, serviceId string, headers []client.HeaderInput) ([]client.Header, error) {
+	resp, err := r.client.UpdateHeadersWithResponse(ctx, serviceId, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []client.Header{}, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) DeleteHeader(ctx context.Context, serviceId string, headerId string) error {
+	resp, err := r.client.DeleteHeaderWithResponse(ctx, serviceId, headerId)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,676 @@
+package staticsite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/mcpserver"
+	"github.com/render-oss/render-mcp-server/pkg/pointers"
+	"github.com/render-oss/render-mcp-server/pkg/validate"
+)
+
+func AddTools(s *server.MCPServer, c *client.ClientWithResponses) {
+	staticSiteRepo := NewRepo(c)
+
+	tool, handler := listRoutes(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = addRoute(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = moveRoute(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = replaceRoutes(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteRoute(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = listHeaders(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = addHeader(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = replaceHeaders(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = deleteHeader(staticSiteRepo)
+	s.AddTool(*tool, handler)
+	tool, handler = evaluateRequest(staticSiteRepo)
+	s.AddTool(*tool, handler)
+}
+
+func withServiceId() mcp.ToolOption {
+	return mcp.WithString("serviceId",
+		mcp.Required(),
+		mcp.Description("The ID of the static site"),
+	)
+}
+
+const routeSourceDescription = "The request path to match, starting with /. Use * to match any remaining " +
+	"characters and :name to match a single path segment, for example /blog/* or /users/:id."
+
+const routeDestinationDescription = "The path or URL to redirect or rewrite to. A * or :name from the " +
+	"source is replaced with the value it matched."
+
+const headerPathDescription = "The request path to add the header to, starting with /. Use * to match " +
+	"any remaining characters, for example /assets/*."
+
+func listRoutes(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_static_site_routes",
+		mcp.WithDescription("List the redirect and rewrite rules of a static site in priority order. "+
+			"The first rule whose source matches a request is applied."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List static site routes",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		withServiceId(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routes, err := staticSiteRepo.ListRoutes(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(routes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func addRoute(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("add_static_site_route",
+		mcp.WithDescription("Add a redirect or rewrite rule to a static site. A redirect sends the client "+
+			"to the destination, while a rewrite serves the destination's content at the original path."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Add static site route",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("type",
+			mcp.Required(),
+			mcp.Description("Whether to redirect or rewrite matching requests"),
+			mcp.Enum(mcpserver.EnumValuesFromClientType(client.RouteTypeRedirect, client.RouteTypeRewrite)...),
+		),
+		mcp.WithString("source",
+			mcp.Required(),
+			mcp.Description(routeSourceDescription),
+		),
+		mcp.WithString("destination",
+			mcp.Required(),
+			mcp.Description(routeDestinationDescription),
+		),
+		mcp.WithNumber("priority",
+			mcp.Description("The position of the rule, starting at 0 for the rule checked first. Defaults to last."),
+			mcp.Min(0),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routeType, err := validate.RequiredToolParam[string](request, "type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			source, err := validate.RequiredToolParam[string](request, "source")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			destination, err := validate.RequiredToolParam[string](request, "destination")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			route := client.RoutePost{
+				Type:        client.RouteType(routeType),
+				Source:      source,
+				Destination: destination,
+			}
+			if err := validate.Route(route.Type, route.Source, route.Destination); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if priority, ok, err := priorityParam(request, false); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				route.Priority = &priority
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			added, err := staticSiteRepo.AddRoute(ctx, serviceId, route)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(added)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+// priorityParam reads a route priority, which must be a whole number of at least 0.
+func priorityParam(request mcp.CallToolRequest, required bool) (int, bool, error) {
+	var priority float64
+	if required {
+		p, err := validate.RequiredToolParam[float64](request, "priority")
+		if err != nil {
+			return 0, false, err
+		}
+		priority = p
+	} else {
+		p, ok, err := validate.OptionalToolParam[float64](request, "priority")
+		if err != nil || !ok {
+			return 0, false, err
+		}
+		priority = p
+	}
+
+	if priority < 0 || priority != math.Trunc(priority) {
+		return 0, false, fmt.Errorf("priority must be a whole number of at least 0, got %v", priority)
+	}
+	return int(priority), true, nil
+}
+
+func moveRoute(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("move_static_site_route",
+		mcp.WithDescription("Change the priority of a static site's redirect or rewrite rule. "+
+			"The other rules keep their relative order."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Move static site route",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("routeId",
+			mcp.Required(),
+			mcp.Description("The ID of the route to move"),
+		),
+		mcp.WithNumber("priority",
+			mcp.Required(),
+			mcp.Description("The new position of the rule, starting at 0 for the rule checked first. "+
+				"Positions past the end move the rule to last."),
+			mcp.Min(0),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routeId, err := validate.RequiredToolParam[string](request, "routeId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			priority, _, err := priorityParam(request, true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routes, err := staticSiteRepo.ListRoutes(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			reordered, err := moveRouteTo(routes, routeId, priority)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updated, err := staticSiteRepo.ReplaceRoutes(ctx, serviceId, reordered)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(updated)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+// moveRouteTo returns routes, which must be in priority order, with routeId moved to priority.
+func moveRouteTo(routes []*client.Route, routeId string, priority int) ([]client.RoutePut, error) {
+	var moved *client.Route
+	rest := make([]client.RoutePut, 0, len(routes))
+	for _, route := range routes {
+		if route.Id == routeId {
+			moved = route
+			continue
+		}
+		rest = append(rest, client.RoutePut{Type: route.Type, Source: route.Source, Destination: route.Destination})
+	}
+	if moved == nil {
+		return nil, fmt.Errorf("route %s not found", routeId)
+	}
+
+	priority = min(priority, len(rest))
+	reordered := make([]client.RoutePut, 0, len(routes))
+	reordered = append(reordered, rest[:priority]...)
+	reordered = append(reordered, client.RoutePut{Type: moved.Type, Source: moved.Source, Destination: moved.Destination})
+	reordered = append(reordered, rest[priority:]...)
+	return reordered, nil
+}
+
+func replaceRoutes(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("replace_static_site_routes",
+		mcp.WithDescription("Replace all redirect and rewrite rules of a static site. Rules are given "+
+			"priorities in the order they're listed. Any existing rule not in the list is removed."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Replace static site routes",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithArray("routes",
+			mcp.Required(),
+			mcp.Description("The rules in priority order. Pass an empty list to remove all rules."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"type", "source", "destination"},
+					"properties": map[string]interface{}{
+						"type": map[string]interface{}{
+							"type":        "string",
+							"enum":        mcpserver.EnumValuesFromClientType(client.RouteTypeRedirect, client.RouteTypeRewrite),
+							"description": "Whether to redirect or rewrite matching requests",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": routeSourceDescription,
+						},
+						"destination": map[string]interface{}{
+							"type":        "string",
+							"description": routeDestinationDescription,
+						},
+					},
+				},
+			),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routes, ok, err := validate.Routes(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("routes parameter is required"), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updated, err := staticSiteRepo.ReplaceRoutes(ctx, serviceId, routes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(updated)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteRoute(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_static_site_route",
+		mcp.WithDescription("Delete a redirect or rewrite rule from a static site."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete static site route",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("routeId",
+			mcp.Required(),
+			mcp.Description("The ID of the route to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routeId, err := validate.RequiredToolParam[string](request, "routeId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.DeleteRoute(ctx, serviceId, routeId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Route %s deleted", routeId)), nil
+		}
+}
+
+func listHeaders(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_static_site_headers",
+		mcp.WithDescription("List the response header rules of a static site."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "List static site headers",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		withServiceId(),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			headers, err := staticSiteRepo.ListHeaders(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(headers)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func addHeader(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("add_static_site_header",
+		mcp.WithDescription("Add a response header rule to a static site."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Add static site header",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(false),
+			IdempotentHint:  pointers.From(false),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description(headerPathDescription),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("The header name, for example Cache-Control"),
+		),
+		mcp.WithString("value",
+			mcp.Required(),
+			mcp.Description("The header value"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			path, err := validate.RequiredToolParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			name, err := validate.RequiredToolParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			value, err := validate.RequiredToolParam[string](request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			header := client.HeaderInput{Path: path, Name: name, Value: value}
+			if err := validate.Header(header); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			added, err := staticSiteRepo.AddHeader(ctx, serviceId, header)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(added)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func replaceHeaders(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("replace_static_site_headers",
+		mcp.WithDescription("Replace all response header rules of a static site. "+
+			"Any existing rule not in the list is removed."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Replace static site headers",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithArray("headers",
+			mcp.Required(),
+			mcp.Description("The header rules. Pass an empty list to remove all rules."),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"path", "name", "value"},
+					"properties": map[string]interface{}{
+						"path": map[string]interface{}{
+							"type":        "string",
+							"description": headerPathDescription,
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "The header name, for example Cache-Control",
+						},
+						"value": map[string]interface{}{
+							"type":        "string",
+							"description": "The header value",
+						},
+					},
+				},
+			),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			headers, ok, err := validate.Headers(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if !ok {
+				return mcp.NewToolResultError("headers parameter is required"), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updated, err := staticSiteRepo.ReplaceHeaders(ctx, serviceId, headers)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(updated)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
+
+func deleteHeader(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_static_site_header",
+		mcp.WithDescription("Delete a response header rule from a static site."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Delete static site header",
+			ReadOnlyHint:    pointers.From(false),
+			DestructiveHint: pointers.From(true),
+			IdempotentHint:  pointers.From(true),
+			OpenWorldHint:   pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("headerId",
+			mcp.Required(),
+			mcp.Description("The ID of the header rule to delete"),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			headerId, err := validate.RequiredToolParam[string](request, "headerId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.DeleteHeader(ctx, serviceId, headerId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Header %s deleted", headerId)), nil
+		}
+}
+
+func evaluateRequest(staticSiteRepo *Repo) (*mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("evaluate_static_site_request",
+		mcp.WithDescription("Dry run a request path against a static site's rules without making a request. "+
+			"Reports the redirect or rewrite rule that would apply, checked in the priority order the API "+
+			"returns, along with the resolved destination and every response header rule that matches."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Evaluate static site request",
+			ReadOnlyHint:   pointers.From(true),
+			IdempotentHint: pointers.From(true),
+			OpenWorldHint:  pointers.From(true),
+		}),
+		withServiceId(),
+		mcp.WithString("path",
+			mcp.Required(),
+			mcp.Description("The request path to evaluate, for example /blog/hello-world. "+
+				"Any query string is ignored."),
+		),
+	)
+	return &tool,
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			serviceId, err := validate.RequiredToolParam[string](request, "serviceId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			path, err := validate.RequiredToolParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if err := staticSiteRepo.ValidateStaticSite(ctx, serviceId); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			routes, err := staticSiteRepo.ListRoutes(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			headers, err := staticSiteRepo.ListHeaders(ctx, serviceId)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			evaluation, err := evaluate(path, routes, headers)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			respJSON, err := json.Marshal(evaluation)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(respJSON)), nil
+		}
+}
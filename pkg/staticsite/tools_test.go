@@ -0,0 +1,238 @@
+package staticsite
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/render-oss/render-mcp-server/pkg/client"
+	"github.com/render-oss/render-mcp-server/pkg/fakes"
+	"github.com/render-oss/render-mcp-server/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	routes := []*client.Route{
+		{Id: "rdr-1", Priority: 0, Type: client.RouteTypeRedirect, Source: "/old-blog/*", Destination: "/blog/*"},
+		{Id: "rdr-2", Priority: 1, Type: client.RouteTypeRewrite, Source: "/users/:id/profile", Destination: "/profile.html?user=:id"},
+		{Id: "rdr-3", Priority: 2, Type: client.RouteTypeRewrite, Source: "/*", Destination: "/index.html"},
+	}
+	headers := []*client.Header{
+		{Id: "hdr-1", Path: "/*", Name: "X-Frame-Options", Value: "DENY"},
+		{Id: "hdr-2", Path: "/old-blog/*", Name: "Cache-Control", Value: "no-store"},
+		{Id: "hdr-3", Path: "/users/:id/profile", Name: "X-Robots-Tag", Value: "noindex"},
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		routeId     string
+		destination string
+		headerIds   []string
+	}{
+		{
+			name:        "wildcard redirect",
+			path:        "/old-blog/2024/hello?ref=home",
+			routeId:     "rdr-1",
+			destination: "/blog/2024/hello",
+			headerIds:   []string{"hdr-1", "hdr-2"},
+		},
+		{
+			name:        "placeholder rewrite",
+			path:        "/users/42/profile/",
+			routeId:     "rdr-2",
+			destination: "/profile.html?user=42",
+			headerIds:   []string{"hdr-1", "hdr-3"},
+		},
+		{
+			name:        "catch-all",
+			path:        "/users/42",
+			routeId:     "rdr-3",
+			destination: "/index.html",
+			headerIds:   []string{"hdr-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := evaluate(tt.path, routes, headers)
+			require.NoError(t, err)
+			require.NotNil(t, result.Route)
+			assert.Equal(t, tt.routeId, result.Route.Route.Id)
+			assert.Equal(t, tt.destination, result.Route.Destination)
+
+			var headerIds []string
+			for _, header := range result.Headers {
+				headerIds = append(headerIds, header.Id)
+			}
+			assert.Equal(t, tt.headerIds, headerIds)
+		})
+	}
+
+	t.Run("no match", func(t *testing.T) {
+		result, err := evaluate("/about", routes[:2], nil)
+		require.NoError(t, err)
+		assert.Nil(t, result.Route)
+		assert.Empty(t, result.Headers)
+	})
+
+	t.Run("relative path", func(t *testing.T) {
+		_, err := evaluate("about", routes, headers)
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluateRequestToolUsesPriorityOrder(t *testing.T) {
+	fakeClient := fakeClientWithStaticSite("own-123", client.StaticSite)
+	// The API's order isn't trusted, so the lower priority catch-all must not win.
+	fakeClient.ListRoutesWithResponseReturnsOnCall(0, &client.ListRoutesResponse{
+		JSON200: &[]client.RouteWithCursor{
+			{Cursor: "c1", Route: client.Route{Id: "rdr-2", Priority: 1, Type: client.RouteTypeRewrite, Source: "/*", Destination: "/index.html"}},
+			{Cursor: "c2", Route: client.Route{Id: "rdr-1", Priority: 0, Type: client.RouteTypeRedirect, Source: "/docs", Destination: "https://docs.example.com"}},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListRoutesWithResponseReturnsOnCall(1, &client.ListRoutesResponse{
+		JSON200:      &[]client.RouteWithCursor{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListHeadersWithResponseReturns(&client.ListHeadersResponse{
+		JSON200:      &[]client.HeaderWithCursor{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := evaluateRequest(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId": "srv-123",
+		"path":      "/docs",
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	var evaluation Evaluation
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &evaluation))
+	require.NotNil(t, evaluation.Route)
+	assert.Equal(t, "rdr-1", evaluation.Route.Route.Id)
+	assert.Equal(t, "https://docs.example.com", evaluation.Route.Destination)
+}
+
+func TestMoveRouteTool(t *testing.T) {
+	fakeClient := fakeClientWithStaticSite("own-123", client.StaticSite)
+	fakeClient.ListRoutesWithResponseReturnsOnCall(0, &client.ListRoutesResponse{
+		JSON200: &[]client.RouteWithCursor{
+			{Cursor: "c1", Route: client.Route{Id: "rdr-1", Priority: 0, Type: client.RouteTypeRedirect, Source: "/a", Destination: "/b"}},
+			{Cursor: "c2", Route: client.Route{Id: "rdr-2", Priority: 1, Type: client.RouteTypeRedirect, Source: "/c", Destination: "/d"}},
+			{Cursor: "c3", Route: client.Route{Id: "rdr-3", Priority: 2, Type: client.RouteTypeRewrite, Source: "/*", Destination: "/index.html"}},
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.ListRoutesWithResponseReturnsOnCall(1, &client.ListRoutesResponse{
+		JSON200:      &[]client.RouteWithCursor{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	fakeClient.PutRoutesWithResponseReturns(&client.PutRoutesResponse{
+		JSON200:      &[]client.Route{},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+
+	_, handler := moveRoute(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId": "srv-123",
+		"routeId":   "rdr-3",
+		"priority":  float64(0),
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	require.Equal(t, 1, fakeClient.PutRoutesWithResponseCallCount())
+	_, serviceId, body, _ := fakeClient.PutRoutesWithResponseArgsForCall(0)
+	assert.Equal(t, "srv-123", serviceId)
+	assert.Equal(t, client.PutRoutesJSONRequestBody{
+		{Type: client.RouteTypeRewrite, Source: "/*", Destination: "/index.html"},
+		{Type: client.RouteTypeRedirect, Source: "/a", Destination: "/b"},
+		{Type: client.RouteTypeRedirect, Source: "/c", Destination: "/d"},
+	}, body)
+}
+
+func TestReplaceHeadersToolValidatesHeaders(t *testing.T) {
+	fakeClient := fakeClientWithStaticSite("own-123", client.StaticSite)
+
+	_, handler := replaceHeaders(NewRepo(fakeClient))
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"serviceId": "srv-123",
+		"headers": []interface{}{
+			map[string]interface{}{"path": "assets/*", "name": "Cache-Control", "value": "max-age=3600"},
+		},
+	}
+
+	result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "must start with /")
+	assert.Equal(t, 0, fakeClient.UpdateHeadersWithResponseCallCount())
+}
+
+func TestStaticSiteToolsCheckService(t *testing.T) {
+	tests := []struct {
+		name        string
+		ownerId     string
+		serviceType client.ServiceType
+		errContains string
+	}{
+		{
+			name:        "other workspace",
+			ownerId:     "own-456",
+			serviceType: client.StaticSite,
+			errContains: "resource in workspace does not match",
+		},
+		{
+			name:        "not a static site",
+			ownerId:     "own-123",
+			serviceType: client.WebService,
+			errContains: "only available for static sites",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fakeClientWithStaticSite(tt.ownerId, tt.serviceType)
+
+			_, handler := addRoute(NewRepo(fakeClient))
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{
+				"serviceId":   "srv-123",
+				"type":        "redirect",
+				"source":      "/old",
+				"destination": "/new",
+			}
+
+			result, err := handler(session.ContextWithTestWorkspace(t, "own-123"), request)
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.errContains)
+			assert.Equal(t, 0, fakeClient.AddRouteWithResponseCallCount())
+		})
+	}
+}
+
+func fakeClientWithStaticSite(ownerId string, serviceType client.ServiceType) *fakes.FakeStaticSiteRepoClient {
+	fakeClient := &fakes.FakeStaticSiteRepoClient{}
+	fakeClient.RetrieveServiceWithResponseReturns(&client.RetrieveServiceResponse{
+		JSON200: &client.Service{
+			Id:      "srv-123",
+			OwnerId: ownerId,
+			Type:    serviceType,
+		},
+		HTTPResponse: &http.Response{StatusCode: 200},
+	}, nil)
+	return fakeClient
+}
@@ -3,6 +3,8 @@ package validate
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/render-oss/render-mcp-server/pkg/client"
@@ -139,6 +141,67 @@ func PaidPlan(plan string) (*client.PaidPlan, error) {
 	}
 }
 
+func AutoDeploy(autoDeploy string) (*client.AutoDeploy, error) {
+	switch client.AutoDeploy(autoDeploy) {
+	case client.AutoDeployYes, client.AutoDeployNo:
+		return pointers.From(client.AutoDeploy(autoDeploy)), nil
+	default:
+		return nil, fmt.Errorf("invalid autoDeploy value: %s. Must be one of: %s, %s", autoDeploy, client.AutoDeployYes, client.AutoDeployNo)
+	}
+}
+
+func HealthCheckPath(path string) error {
+	if path == "" {
+		// An empty path disables health checks
+		return nil
+	}
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("healthCheckPath must start with a '/', got: %s", path)
+	}
+	return nil
+}
+
+// ServiceDisk reads the persistent disk to attach to a new service. The mount path must be absolute
+// and the size, if given, a whole number of gigabytes.
+func ServiceDisk(request mcp.CallToolRequest) (*client.ServiceDisk, bool, error) {
+	diskRaw, ok := request.GetArguments()["disk"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	invalidErr := errors.New("parameter disk is not of expected type")
+	diskMap, ok := diskRaw.(map[string]interface{})
+	if !ok {
+		return nil, false, invalidErr
+	}
+
+	name, ok := diskMap["name"].(string)
+	if !ok || name == "" {
+		return nil, false, errors.New("disk name is required")
+	}
+	mountPath, ok := diskMap["mountPath"].(string)
+	if !ok {
+		return nil, false, errors.New("disk mountPath is required")
+	}
+	if !strings.HasPrefix(mountPath, "/") {
+		return nil, false, fmt.Errorf("disk mountPath %q must be an absolute path", mountPath)
+	}
+
+	disk := &client.ServiceDisk{
+		Name:      name,
+		MountPath: mountPath,
+	}
+	if sizeRaw, ok := diskMap["sizeGB"]; ok {
+		sizeGB, ok := sizeRaw.(float64)
+		if !ok || sizeGB < 1 || sizeGB != float64(int(sizeGB)) {
+			return nil, false, fmt.Errorf("disk sizeGB must be a whole number of at least 1, got %v", sizeRaw)
+		}
+		disk.SizeGB = pointers.From(int(sizeGB))
+	}
+
+	return disk, true, nil
+}
+
 func KeyValuePlan(plan string) (*client.KeyValuePlan, error) {
 	switch client.KeyValuePlan(plan) {
 	case client.KeyValuePlanFree, client.KeyValuePlanStarter, client.KeyValuePlanStandard, client.KeyValuePlanPro, client.KeyValuePlanProPlus:
@@ -195,3 +258,193 @@ func PostgresDiskSizeGb(diskSizeGb int) error {
 	}
 	return fmt.Errorf("diskSizeGb can be 0 for the free plan, otherwise it must be either 1, or a multiple of 5")
 }
+
+func IPAllowList(request mcp.CallToolRequest) ([]client.CidrBlockAndDescription, bool, error) {
+	ipAllowListRaw, ok := request.GetArguments()["ipAllowList"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	invalidErr := errors.New("parameter ipAllowList is not of expected type")
+	ipAllowListSlice, ok := ipAllowListRaw.([]interface{})
+	if !ok {
+		return nil, false, invalidErr
+	}
+
+	ipAllowList := make([]client.CidrBlockAndDescription, 0, len(ipAllowListSlice))
+	for _, item := range ipAllowListSlice {
+		entryMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		cidrBlock, ok := entryMap["cidrBlock"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+		if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
+			return nil, false, fmt.Errorf("invalid CIDR block in ipAllowList: %s", cidrBlock)
+		}
+
+		// The description is optional.
+		description, _ := entryMap["description"].(string)
+
+		ipAllowList = append(ipAllowList, client.CidrBlockAndDescription{
+			CidrBlock:   cidrBlock,
+			Description: description,
+		})
+	}
+
+	return ipAllowList, true, nil
+}
+
+func SecretFiles(request mcp.CallToolRequest) ([]client.SecretFileInput, bool, error) {
+	secretFilesRaw, ok := request.GetArguments()["secretFiles"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	invalidErr := errors.New("parameter secretFiles is not of expected type")
+	secretFilesSlice, ok := secretFilesRaw.([]interface{})
+	if !ok {
+		return nil, false, invalidErr
+	}
+
+	secretFiles := make([]client.SecretFileInput, 0, len(secretFilesSlice))
+	for _, item := range secretFilesSlice {
+		secretFileMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		name, ok := secretFileMap["name"].(string)
+		if !ok || name == "" {
+			return nil, false, invalidErr
+		}
+
+		content, ok := secretFileMap["content"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		secretFiles = append(secretFiles, client.SecretFileInput{
+			Name:    name,
+			Content: content,
+		})
+	}
+
+	return secretFiles, true, nil
+}
+
+func Routes(request mcp.CallToolRequest) ([]client.RoutePut, bool, error) {
+	routesRaw, ok := request.GetArguments()["routes"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	invalidErr := errors.New("parameter routes is not of expected type")
+	routesSlice, ok := routesRaw.([]interface{})
+	if !ok {
+		return nil, false, invalidErr
+	}
+
+	routes := make([]client.RoutePut, 0, len(routesSlice))
+	for _, item := range routesSlice {
+		routeMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		routeType, ok := routeMap["type"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+		source, ok := routeMap["source"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+		destination, ok := routeMap["destination"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		route := client.RoutePut{
+			Type:        client.RouteType(routeType),
+			Source:      source,
+			Destination: destination,
+		}
+		if err := Route(route.Type, route.Source, route.Destination); err != nil {
+			return nil, false, err
+		}
+		routes = append(routes, route)
+	}
+
+	return routes, true, nil
+}
+
+// Route checks a redirect or rewrite rule of a static site.
+func Route(routeType client.RouteType, source string, destination string) error {
+	if routeType != client.RouteTypeRedirect && routeType != client.RouteTypeRewrite {
+		return fmt.Errorf("invalid route type %q, must be redirect or rewrite", routeType)
+	}
+	if !strings.HasPrefix(source, "/") {
+		return fmt.Errorf("route source %q must start with /", source)
+	}
+	if destination == "" {
+		return fmt.Errorf("route destination for %s is required", source)
+	}
+	return nil
+}
+
+func Headers(request mcp.CallToolRequest) ([]client.HeaderInput, bool, error) {
+	headersRaw, ok := request.GetArguments()["headers"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	invalidErr := errors.New("parameter headers is not of expected type")
+	headersSlice, ok := headersRaw.([]interface{})
+	if !ok {
+		return nil, false, invalidErr
+	}
+
+	headers := make([]client.HeaderInput, 0, len(headersSlice))
+	for _, item := range headersSlice {
+		headerMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		path, ok := headerMap["path"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+		name, ok := headerMap["name"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+		value, ok := headerMap["value"].(string)
+		if !ok {
+			return nil, false, invalidErr
+		}
+
+		header := client.HeaderInput{Path: path, Name: name, Value: value}
+		if err := Header(header); err != nil {
+			return nil, false, err
+		}
+		headers = append(headers, header)
+	}
+
+	return headers, true, nil
+}
+
+// Header checks a response header rule of a static site.
+func Header(header client.HeaderInput) error {
+	if !strings.HasPrefix(header.Path, "/") {
+		return fmt.Errorf("header path %q must start with /", header.Path)
+	}
+	if header.Name == "" {
+		return fmt.Errorf("header name for %s is required", header.Path)
+	}
+	return nil
+}